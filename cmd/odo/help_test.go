@@ -29,22 +29,24 @@ Examples:
 
 	mainCommands = `Main Commands:
   build-images Build images
-  deploy       Deploy components
+  deploy       Deploy components (history, rollback)
   dev          Deploy component to development cluster
   init         Init bootstraps a new project
   logs         Show logs of all containers of the component
-  registry     List all components from the Devfile registry
+  registry     List all components from the Devfile registry (mirror)
 
 `
 
 	managementCommands = `Management Commands:
-  add          Add resources to devfile (binding)
-  create       Perform create operation (namespace)
+  add          Add resources to devfile (binding, command, container, endpoint, env, volume)
+  binding      Manage the bindings of the component (check)
+  create       Perform create operation (namespace, service)
   delete       Delete resources (component, namespace)
   describe     Describe resource (binding, component)
+  devfile      Manage the devfile of the component (migrate, upgrade, validate)
   list         List all components in the current namespace (binding, component, namespace, services)
-  remove       Remove resources from devfile (binding)
-  set          Perform set operation (namespace)
+  remove       Remove resources from devfile (binding, command, container, endpoint, env, volume)
+  set          Perform set operation (command, env, namespace)
 
 `
 
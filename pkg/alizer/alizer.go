@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/redhat-developer/alizer/go/pkg/apis/model"
 	"github.com/redhat-developer/alizer/go/pkg/apis/recognizer"
@@ -31,7 +32,7 @@ func NewAlizerClient(registryClient registry.Client) *Alizer {
 // to use depending on the files in the path
 func (o *Alizer) DetectFramework(ctx context.Context, path string) (_ model.DevFileType, defaultVersion string, _ api.Registry, _ error) {
 	types := []model.DevFileType{}
-	components, err := o.registryClient.ListDevfileStacks(ctx, "", "", "", false)
+	components, err := o.registryClient.ListDevfileStacks(ctx, "", "", registry.Filter{}, false)
 	if err != nil {
 		return model.DevFileType{}, defaultVersion, api.Registry{}, err
 	}
@@ -138,6 +139,87 @@ func (o *Alizer) DetectPorts(path string) ([]int, error) {
 	return components[0].Ports, nil
 }
 
+// DetectLanguages returns the names and aliases of the programming languages detected in the path,
+// the most used languages first
+func (o *Alizer) DetectLanguages(path string) ([]string, error) {
+	languages, err := recognizer.Analyze(path)
+	if err != nil {
+		return nil, err
+	}
+	klog.V(4).Infof("Found languages: %v", languages)
+	sort.SliceStable(languages, func(i, j int) bool {
+		return languages[i].Weight > languages[j].Weight
+	})
+	var result []string
+	for _, language := range languages {
+		result = append(result, language.Name)
+		result = append(result, language.Aliases...)
+	}
+	return result, nil
+}
+
+// DetectComponents detects all the components in the path and its sub-directories, and selects the devfile
+// to use for each component, based on its languages.
+// The paths of the components are relative to path. Components for which no devfile can be selected are ignored.
+func (o *Alizer) DetectComponents(ctx context.Context, path string) ([]api.DetectionResult, error) {
+	detected, err := recognizer.DetectComponents(path)
+	if err != nil {
+		return nil, err
+	}
+	klog.V(4).Infof("Found components: %v", detected)
+	if len(detected) == 0 {
+		return nil, nil
+	}
+
+	stacks, err := o.registryClient.ListDevfileStacks(ctx, "", "", registry.Filter{}, false)
+	if err != nil {
+		return nil, err
+	}
+	types := make([]model.DevFileType, 0, len(stacks.Items))
+	for _, stack := range stacks.Items {
+		types = append(types, model.DevFileType{
+			Name:        stack.Name,
+			Language:    stack.Language,
+			ProjectType: stack.ProjectType,
+			Tags:        stack.Tags,
+		})
+	}
+
+	result := make([]api.DetectionResult, 0, len(detected))
+	for _, component := range detected {
+		relPath, err := filepath.Rel(path, component.Path)
+		if err != nil {
+			return nil, err
+		}
+		typ, err := recognizer.SelectDevFileUsingLanguagesFromTypes(component.Languages, types)
+		if err != nil {
+			klog.V(4).Infof("no devfile found for component at path %q: %v", component.Path, err)
+			continue
+		}
+		stack := stacks.Items[typ]
+		var defaultVersion string
+		for _, version := range stack.Versions {
+			if version.IsDefault {
+				defaultVersion = version.Version
+			}
+		}
+
+		name := util.GetDNS1123Name(component.Name)
+		if name == "" {
+			name = util.GetDNS1123Name(filepath.Base(component.Path))
+		}
+
+		detection := NewDetectionResult(types[typ], stack.Registry, component.Ports, defaultVersion)
+		detection.Name = name
+		detection.Path = filepath.ToSlash(relPath)
+		result = append(result, *detection)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+	return result, nil
+}
+
 func NewDetectionResult(typ model.DevFileType, registry api.Registry, appPorts []int, devfileVersion string) *api.DetectionResult {
 	return &api.DetectionResult{
 		Devfile:          typ.Name,
@@ -10,6 +10,8 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/registry"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/util"
 )
 
 // Below functions are from:
@@ -116,7 +118,7 @@ func TestDetectFramework(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			registryClient := registry.NewMockClient(ctrl)
 			ctx := context.Background()
-			registryClient.EXPECT().ListDevfileStacks(ctx, "", "", "", false).Return(list, nil)
+			registryClient.EXPECT().ListDevfileStacks(ctx, "", "", registry.Filter{}, false).Return(list, nil)
 			alizerClient := NewAlizerClient(registryClient)
 			// Run function DetectFramework
 			detected, _, registry, err := alizerClient.DetectFramework(ctx, tt.args.path)
@@ -200,3 +202,77 @@ func TestDetectName(t *testing.T) {
 		})
 	}
 }
+
+func TestDetectLanguages(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{
+			name: "Node.js project",
+			path: GetTestProjectPath("nodejs"),
+			want: "JavaScript",
+		},
+		{
+			name: "Java project",
+			path: GetTestProjectPath("wildfly"),
+			want: "Java",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			alizerClient := NewAlizerClient(registry.NewMockClient(ctrl))
+			languages, err := alizerClient.DetectLanguages(tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(languages) == 0 || languages[0] != tt.want {
+				t.Errorf("unexpected languages %v, wanted %q first", languages, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectComponents(t *testing.T) {
+	root := t.TempDir()
+	fsys := filesystem.DefaultFs{}
+	for src, dst := range map[string]string{
+		"nodejs":  filepath.Join("services", "web"),
+		"openjdk": filepath.Join("services", "api"),
+	} {
+		if err := util.CopyDirWithFS(GetTestProjectPath(src), filepath.Join(root, dst), fsys); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctrl := gomock.NewController(t)
+	registryClient := registry.NewMockClient(ctrl)
+	ctx := context.Background()
+	registryClient.EXPECT().ListDevfileStacks(ctx, "", "", registry.Filter{}, false).Return(list, nil)
+	alizerClient := NewAlizerClient(registryClient)
+
+	got, err := alizerClient.DetectComponents(ctx, root)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	want := []struct {
+		path, devfile, registry string
+	}{
+		{path: "services/api", devfile: "java-maven", registry: "registry1"},
+		{path: "services/web", devfile: "nodejs", registry: "registry2"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("unexpected components %+v, want %d components", got, len(want))
+	}
+	for i := range want {
+		if got[i].Path != want[i].path || got[i].Devfile != want[i].devfile || got[i].DevfileRegistry != want[i].registry {
+			t.Errorf("unexpected component %+v, want %+v", got[i], want[i])
+		}
+		if got[i].Name == "" {
+			t.Errorf("no name detected for component %+v", got[i])
+		}
+	}
+}
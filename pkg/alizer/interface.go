@@ -12,4 +12,9 @@ type Client interface {
 	DetectFramework(ctx context.Context, path string) (_ model.DevFileType, defaultVersion string, _ api.Registry, _ error)
 	DetectName(path string) (string, error)
 	DetectPorts(path string) ([]int, error)
+	// DetectLanguages returns the names and aliases of the languages detected in the directory, the most used first
+	DetectLanguages(path string) ([]string, error)
+	// DetectComponents detects all the components in the directory and its sub-directories,
+	// with the devfile to use, the name and the ports of each component
+	DetectComponents(ctx context.Context, path string) ([]api.DetectionResult, error)
 }
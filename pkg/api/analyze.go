@@ -5,6 +5,12 @@ package api
 // It contains detection analysis information such as the location of a devfile,
 // either in a devfile registry or using a path or a URI or the application ports if any.
 type DetectionResult struct {
+	// name of the component detected, when several components are detected under a root directory
+	Name string `json:"name,omitempty"`
+
+	// path of the directory of the component, relative to the root directory, when several components are detected under a root directory
+	Path string `json:"path,omitempty"`
+
 	// name of the Devfile in Devfile registry (required if DevfilePath is not defined)
 	Devfile string `json:"devfile,omitempty"`
 
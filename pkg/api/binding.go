@@ -17,10 +17,59 @@ type ServiceBindingSpec struct {
 	DetectBindingResources bool                     `json:"detectBindingResources"`
 	BindAsFiles            bool                     `json:"bindAsFiles"`
 	NamingStrategy         string                   `json:"namingStrategy,omitempty"`
+	// Native indicates that the binding is projected into the component by odo, without the Service Binding Operator
+	Native bool `json:"native,omitempty"`
 }
 
 type ServiceBindingStatus struct {
 	BindingFiles   []string     `json:"bindingFiles,omitempty"`
 	BindingEnvVars []string     `json:"bindingEnvVars,omitempty"`
 	RunningIn      RunningModes `json:"runningIn,omitempty"`
+	// ValuesContainer is the container of the component from which the values have been read, if requested
+	ValuesContainer string         `json:"valuesContainer,omitempty"`
+	Values          []BindingValue `json:"values,omitempty"`
+}
+
+// BindingValue is the value of a binding file or environment variable, as resolved in the running container of the component
+type BindingValue struct {
+	// Name is the path of the file or the name of the environment variable, as listed in BindingFiles or BindingEnvVars
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+	// Masked indicates the value has been hidden
+	Masked bool `json:"masked,omitempty"`
+	// Missing indicates the file or environment variable is declared by the binding, but not found in the container
+	Missing bool `json:"missing,omitempty"`
+}
+
+// BindingCheckStatus is the result of a probe of a binding
+type BindingCheckStatus string
+
+const (
+	BindingCheckOK      BindingCheckStatus = "ok"
+	BindingCheckFailed  BindingCheckStatus = "failed"
+	BindingCheckSkipped BindingCheckStatus = "skipped"
+)
+
+// BindingChecks is the result of the connectivity checks of the bindings of a component
+type BindingChecks struct {
+	// Container is the container of the component from which the probes are run
+	Container string `json:"container"`
+	// Healthy indicates that no probe failed
+	Healthy  bool           `json:"healthy"`
+	Bindings []BindingCheck `json:"bindings"`
+}
+
+// BindingCheck is the result of the connectivity check of a binding, from the container of the component
+type BindingCheck struct {
+	Name string `json:"name"`
+	// Type is the type of the binding, used to choose the protocol handshake
+	Type string `json:"type,omitempty"`
+	Host string `json:"host,omitempty"`
+	Port int    `json:"port,omitempty"`
+	// Reachability is the result of the TCP connection to the host and port of the binding
+	Reachability BindingCheckStatus `json:"reachability"`
+	// Auth is the result of the protocol handshake, authenticated with the credentials of the binding
+	Auth BindingCheckStatus `json:"auth"`
+	// Message explains the failed or skipped probes
+	Message string `json:"message,omitempty"`
 }
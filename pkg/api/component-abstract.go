@@ -1,5 +1,7 @@
 package api
 
+import "time"
+
 // ComponentAbstract represents a component as part of a list of components
 type ComponentAbstract struct {
 	Name             string `json:"name"`
@@ -10,6 +12,10 @@ type ComponentAbstract struct {
 	Type      string       `json:"projectType"`
 	// RunningOn is the platform the component is running on, either cluster or podman
 	RunningOn string `json:"runningOn,omitempty"`
+	// Namespace is the namespace the component is running in, set only when listing the components of all namespaces
+	Namespace string `json:"namespace,omitempty"`
+	// CreatedAt is the creation time of the oldest resource of the component, if running
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
 }
 
 const (
@@ -52,6 +52,7 @@ type Component struct {
 	Ingresses         []ConnectionData `json:"ingresses,omitempty"`
 	Routes            []ConnectionData `json:"routes,omitempty"`
 	ManagedBy         string           `json:"managedBy"`
+	RuntimeStatus     *RuntimeStatus   `json:"runtimeStatus,omitempty"`
 }
 
 type ForwardedPort struct {
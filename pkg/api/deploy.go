@@ -0,0 +1,19 @@
+package api
+
+import "time"
+
+// DeployRevision describes a revision of the resources deployed with `odo deploy`
+type DeployRevision struct {
+	// Revision is the number of the revision, starting at 1
+	Revision int `json:"revision"`
+	// Timestamp is the time at which the revision was deployed
+	Timestamp time.Time `json:"timestamp"`
+	// User is the user who deployed the revision
+	User string `json:"user,omitempty"`
+	// DevfileHash is the SHA-256 hash of the Devfile used to deploy the revision
+	DevfileHash string `json:"devfileHash"`
+	// Images maps the names of the images built for the revision with their references by digest, when the images were pushed
+	Images map[string]string `json:"images,omitempty"`
+	// Description explains how the revision was deployed, when not deployed with `odo deploy`
+	Description string `json:"description,omitempty"`
+}
@@ -0,0 +1,27 @@
+package api
+
+const (
+	DiagnosticSeverityError   = "error"
+	DiagnosticSeverityWarning = "warning"
+)
+
+// DevfileDiagnostic is a problem found in a Devfile, located in the Devfile file
+type DevfileDiagnostic struct {
+	// File is the path of the Devfile
+	File string `json:"file"`
+	// Line and Column locate the problem in the file, starting at 1
+	Line   int `json:"line"`
+	Column int `json:"column"`
+	// Severity is either error or warning
+	Severity string `json:"severity"`
+	// Path is the path of the field of the Devfile, for example commands[run].exec.component
+	Path    string `json:"path,omitempty"`
+	Message string `json:"message"`
+}
+
+// DevfileValidation is the result of the validation of a Devfile
+type DevfileValidation struct {
+	// Valid is true if no error has been found in the Devfile; warnings may have been found
+	Valid       bool                `json:"valid"`
+	Diagnostics []DevfileDiagnostic `json:"diagnostics"`
+}
@@ -0,0 +1,31 @@
+package api
+
+// LogRecordType is the type of a record of the logs of a component
+type LogRecordType string
+
+const (
+	// LogRecordTypeLog is a line of the logs of a container
+	LogRecordTypeLog LogRecordType = "log"
+	// LogRecordTypeRestart is a restart of a container
+	LogRecordTypeRestart LogRecordType = "restart"
+)
+
+// LogRecord is a record of the logs of a component, output as a line of JSON by `odo logs -o json`
+type LogRecord struct {
+	Type      LogRecordType `json:"type"`
+	Component string        `json:"component"`
+	// Mode is the mode of the pod of the container, dev or deploy
+	Mode      RunningMode `json:"mode,omitempty"`
+	Platform  string      `json:"platform"`
+	Pod       string      `json:"pod"`
+	Container string      `json:"container"`
+	// Timestamp is the time of the line of logs, or of the termination of the previous instance of a restarted container, in RFC3339 format
+	Timestamp string `json:"timestamp,omitempty"`
+	Message   string `json:"message"`
+	// RestartCount is the number of restarts of the container, for the restart records
+	RestartCount int32 `json:"restartCount,omitempty"`
+	// Reason is the reason of the termination of the previous instance of the container, for the restart records
+	Reason string `json:"reason,omitempty"`
+	// ExitCode is the exit code of the previous instance of the container, for the restart records
+	ExitCode *int32 `json:"exitCode,omitempty"`
+}
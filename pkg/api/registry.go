@@ -18,6 +18,7 @@ type DevfileStack struct {
 	Language    string   `json:"language"`
 	Tags        []string `json:"tags"`
 	ProjectType string   `json:"projectType"`
+	Provider    string   `json:"provider,omitempty"`
 
 	// DefaultVersion is the default version. Marshalled as "version" for backward compatibility.
 	// Deprecated. Use Versions instead.
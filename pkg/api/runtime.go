@@ -0,0 +1,49 @@
+package api
+
+import "time"
+
+// RuntimeStatus describes the live state of a component on the cluster
+type RuntimeStatus struct {
+	// Pods are the pods of the component, in all running modes
+	Pods []PodStatus `json:"pods,omitempty"`
+	// Events are the recent warning events for the objects owned by the component
+	Events []Event `json:"events,omitempty"`
+	// MetricsAvailable indicates if the resources usage of the containers could be fetched from the metrics API
+	MetricsAvailable bool `json:"metricsAvailable"`
+}
+
+type PodStatus struct {
+	Name       string            `json:"name"`
+	Mode       RunningMode       `json:"mode"`
+	Phase      string            `json:"phase"`
+	Ready      bool              `json:"ready"`
+	Containers []ContainerStatus `json:"containers,omitempty"`
+}
+
+type ContainerStatus struct {
+	Name  string `json:"name"`
+	Ready bool   `json:"ready"`
+	// State is the current state of the container: running, waiting or terminated
+	State string `json:"state"`
+	// StateReason is the reason of the current state, for a waiting or terminated container
+	StateReason  string `json:"stateReason,omitempty"`
+	RestartCount int32  `json:"restartCount"`
+	// LastTerminationReason is the reason of the last termination of a restarted container
+	LastTerminationReason string         `json:"lastTerminationReason,omitempty"`
+	Usage                 *ResourceUsage `json:"usage,omitempty"`
+}
+
+// ResourceUsage is the current usage of CPU (in millicores) and memory (in bytes) of a container
+type ResourceUsage struct {
+	CPUMillicores int64 `json:"cpuMillicores"`
+	MemoryBytes   int64 `json:"memoryBytes"`
+}
+
+type Event struct {
+	// Object is the object the event is about, in the form Kind/name
+	Object        string    `json:"object"`
+	Reason        string    `json:"reason"`
+	Message       string    `json:"message"`
+	Count         int32     `json:"count"`
+	LastTimestamp time.Time `json:"lastTimestamp"`
+}
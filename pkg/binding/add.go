@@ -4,13 +4,19 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/devfile/library/pkg/devfile/parser"
+	dfutil "github.com/devfile/library/pkg/util"
 	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	sboApi "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
+	specApi "github.com/redhat-developer/service-binding-operator/apis/spec/v1alpha3"
 
 	"github.com/redhat-developer/odo/pkg/binding/asker"
 	backendpkg "github.com/redhat-developer/odo/pkg/binding/backend"
@@ -29,14 +35,31 @@ func (o *BindingClient) SelectNamespace(flags map[string]string) (string, error)
 }
 
 // ValidateAddBinding calls Validate method of the adequate backend and then checks if the ServiceBinding Operator is installed in the cluster.
+// The Service Binding Operator is not needed by the native bindings, nor by the bindings to components.
 func (o *BindingClient) ValidateAddBinding(flags map[string]string, withDevfile bool) error {
+	if flags[backendpkg.FLAG_COMPONENT] != "" {
+		return o.validateAddComponentBinding(flags, withDevfile)
+	}
+	native, err := IsNativeBindingRequested(flags)
+	if err != nil {
+		return err
+	}
+	if native {
+		return o.validateAddNativeBinding(flags, withDevfile)
+	}
+	for _, flag := range []string{backendpkg.FLAG_SECRET, backendpkg.FLAG_TYPE, backendpkg.FLAG_PROVIDER} {
+		if flags[flag] != "" {
+			return fmt.Errorf("--%s can only be used with --%s", flag, backendpkg.FLAG_NATIVE)
+		}
+	}
+
 	var backend backendpkg.AddBindingBackend
 	if len(flags) == 0 {
 		backend = o.interactiveBackend
 	} else {
 		backend = o.flagsBackend
 	}
-	err := backend.Validate(flags, withDevfile)
+	err = backend.Validate(flags, withDevfile)
 	if err != nil {
 		return err
 	}
@@ -215,3 +238,200 @@ func (o *BindingClient) AddBinding(
 
 	return options, output, filename, nil
 }
+
+// IsNativeBindingRequested returns true if the flags request a binding projected by odo itself
+func IsNativeBindingRequested(flags map[string]string) (bool, error) {
+	if flags[backendpkg.FLAG_NATIVE] == "" {
+		return false, nil
+	}
+	native, err := strconv.ParseBool(flags[backendpkg.FLAG_NATIVE])
+	if err != nil {
+		return false, fmt.Errorf("unable to set %q to --%v, value must be a boolean", flags[backendpkg.FLAG_NATIVE], backendpkg.FLAG_NATIVE)
+	}
+	return native, nil
+}
+
+func (o *BindingClient) validateAddNativeBinding(flags map[string]string, withDevfile bool) error {
+	if !withDevfile {
+		return fmt.Errorf("--%s can only be used from a directory containing a Devfile", backendpkg.FLAG_NATIVE)
+	}
+	if flags[backendpkg.FLAG_SERVICE_NAMESPACE] != "" {
+		return fmt.Errorf("--%s cannot be used with --%s, a native binding binds a resource of the namespace of the component",
+			backendpkg.FLAG_SERVICE_NAMESPACE, backendpkg.FLAG_NATIVE)
+	}
+	switch flags[backendpkg.FLAG_NAMING_STRATEGY] {
+	case "", "none", "lowercase", "uppercase":
+	default:
+		return fmt.Errorf("only the pre-defined naming strategies 'none', 'lowercase' and 'uppercase' can be used with --%s", backendpkg.FLAG_NATIVE)
+	}
+	err := o.flagsBackend.Validate(flags, withDevfile)
+	if err != nil {
+		return err
+	}
+	_, kind := parseNativeServiceName(flags[backendpkg.FLAG_SERVICE])
+	if !contains(nativeBindingKinds, kind) {
+		return fmt.Errorf("a native binding can only bind a %s, not a %s", strings.Join(nativeBindingKinds, ", "), kind)
+	}
+	if flags[backendpkg.FLAG_SECRET] != "" && kind != "Service" {
+		return fmt.Errorf("--%s can only be used when binding a Service", backendpkg.FLAG_SECRET)
+	}
+	return nil
+}
+
+// AddNativeBindingToDevfile adds to the devfile the ServiceBinding manifest of a binding projected by odo itself into the component,
+// binding the Secret, ConfigMap or Service (along with a Secret) passed with the flags.
+// The resources bound are searched in the devfile, then in the cluster.
+func (o *BindingClient) AddNativeBindingToDevfile(
+	componentName string,
+	flags map[string]string,
+	obj parser.DevfileObj,
+	context string,
+) (parser.DevfileObj, NativeBinding, error) {
+	bindAsFiles, err := o.flagsBackend.AskBindAsFiles(flags)
+	if err != nil {
+		return obj, NativeBinding{}, err
+	}
+	name, kind := parseNativeServiceName(flags[backendpkg.FLAG_SERVICE])
+	binding := NativeBinding{
+		Name:      flags[backendpkg.FLAG_NAME],
+		Directory: flags[backendpkg.FLAG_NAME],
+		Workload:  fmt.Sprintf("%s-app", componentName),
+		Service: specApi.ServiceBindingServiceReference{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       kind,
+			Name:       name,
+		},
+		Secret:   flags[backendpkg.FLAG_SECRET],
+		Type:     flags[backendpkg.FLAG_TYPE],
+		Provider: flags[backendpkg.FLAG_PROVIDER],
+	}
+
+	resources, err := getDevfileResources(obj, context)
+	if err != nil {
+		return obj, NativeBinding{}, err
+	}
+	binding.Entries, err = binding.readEntries(resources, o.kubernetesClient)
+	if err != nil {
+		return obj, NativeBinding{}, err
+	}
+	if !bindAsFiles {
+		keys := make([]string, 0, len(binding.Entries))
+		for key := range binding.Entries {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			binding.Env = append(binding.Env, specApi.EnvMapping{
+				Name: envName(key, flags[backendpkg.FLAG_NAMING_STRATEGY]),
+				Key:  key,
+			})
+		}
+	}
+
+	serviceBindingUnstructured, err := kclient.ConvertK8sResourceToUnstructured(newNativeBindingObject(binding))
+	if err != nil {
+		return obj, NativeBinding{}, err
+	}
+	unstructured.RemoveNestedField(serviceBindingUnstructured.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(serviceBindingUnstructured.Object, "status")
+	yamlDesc, err := yaml.Marshal(serviceBindingUnstructured.UnstructuredContent())
+	if err != nil {
+		return obj, NativeBinding{}, err
+	}
+
+	obj, err = libdevfile.AddKubernetesComponentToDevfile(string(yamlDesc), binding.Name, obj)
+	return obj, binding, err
+}
+
+func (o *BindingClient) validateAddComponentBinding(flags map[string]string, withDevfile bool) error {
+	if !withDevfile {
+		return fmt.Errorf("--%s can only be used from a directory containing a Devfile", backendpkg.FLAG_COMPONENT)
+	}
+	for _, flag := range []string{
+		backendpkg.FLAG_SERVICE,
+		backendpkg.FLAG_SERVICE_NAMESPACE,
+		backendpkg.FLAG_WORKLOAD,
+		backendpkg.FLAG_SECRET,
+		backendpkg.FLAG_NAMING_STRATEGY,
+	} {
+		if flags[flag] != "" {
+			return fmt.Errorf("--%s cannot be used with --%s", flag, backendpkg.FLAG_COMPONENT)
+		}
+	}
+	if flags[backendpkg.FLAG_NATIVE] != "" {
+		native, err := IsNativeBindingRequested(flags)
+		if err != nil {
+			return err
+		}
+		if !native {
+			return fmt.Errorf("--%s=false cannot be used with --%s, a binding to a component is projected by odo", backendpkg.FLAG_NATIVE, backendpkg.FLAG_COMPONENT)
+		}
+	}
+	err := dfutil.ValidateK8sResourceName(backendpkg.FLAG_COMPONENT, flags[backendpkg.FLAG_COMPONENT])
+	if err != nil {
+		return err
+	}
+	if flags[backendpkg.FLAG_NAME] != "" {
+		return dfutil.ValidateK8sResourceName(backendpkg.FLAG_NAME, flags[backendpkg.FLAG_NAME])
+	}
+	return nil
+}
+
+// AddComponentBindingToDevfile adds to the devfile the ServiceBinding manifest of a native binding to the component passed with the flags.
+// The host, port and URL of the Service of the component, running in Dev mode or deployed, are projected by odo into the containers
+// as the environment variables <COMPONENT>_HOST, <COMPONENT>_PORT and <COMPONENT>_URL, and are updated when the ports of the component change
+func (o *BindingClient) AddComponentBindingToDevfile(
+	componentName string,
+	flags map[string]string,
+	obj parser.DevfileObj,
+) (parser.DevfileObj, NativeBinding, error) {
+	target := flags[backendpkg.FLAG_COMPONENT]
+	name := flags[backendpkg.FLAG_NAME]
+	if name == "" {
+		name = fmt.Sprintf("%s-%s", componentName, target)
+	}
+	binding := NativeBinding{
+		Name:      name,
+		Directory: name,
+		Workload:  fmt.Sprintf("%s-app", componentName),
+		Service: specApi.ServiceBindingServiceReference{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Service",
+			Name:       fmt.Sprintf("%s-app", target),
+		},
+		Component: target,
+		Type:      flags[backendpkg.FLAG_TYPE],
+		Provider:  flags[backendpkg.FLAG_PROVIDER],
+	}
+	prefix := envName(target, "uppercase")
+	for _, key := range []string{componentHostEntry, componentPortEntry, componentURLEntry} {
+		binding.Env = append(binding.Env, specApi.EnvMapping{
+			Name: prefix + "_" + envName(key, "uppercase"),
+			Key:  key,
+		})
+	}
+
+	serviceBindingUnstructured, err := kclient.ConvertK8sResourceToUnstructured(newNativeBindingObject(binding))
+	if err != nil {
+		return obj, NativeBinding{}, err
+	}
+	unstructured.RemoveNestedField(serviceBindingUnstructured.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(serviceBindingUnstructured.Object, "status")
+	yamlDesc, err := yaml.Marshal(serviceBindingUnstructured.UnstructuredContent())
+	if err != nil {
+		return obj, NativeBinding{}, err
+	}
+
+	obj, err = libdevfile.AddKubernetesComponentToDevfile(string(yamlDesc), binding.Name, obj)
+	return obj, binding, err
+}
+
+// parseNativeServiceName parses the name of the resource bound by a native binding, with the format <name>[/<kind>],
+// the kind being Secret by default
+func parseNativeServiceName(service string) (name, kind string) {
+	name, kind, found := strings.Cut(service, "/")
+	if !found {
+		return service, "Secret"
+	}
+	return name, kind
+}
@@ -21,6 +21,11 @@ const (
 	FLAG_NAME              = "name"
 	FLAG_BIND_AS_FILES     = "bind-as-files"
 	FLAG_NAMING_STRATEGY   = "naming-strategy"
+	FLAG_NATIVE            = "native"
+	FLAG_SECRET            = "secret"
+	FLAG_TYPE              = "type"
+	FLAG_PROVIDER          = "provider"
+	FLAG_COMPONENT         = "component"
 )
 
 // FlagsBackend is a backend that will extract all needed information from flags passed to the command
@@ -14,7 +14,6 @@ import (
 
 	"github.com/devfile/library/pkg/devfile/parser"
 	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
-	"gopkg.in/yaml.v2"
 	appsv1 "k8s.io/api/apps/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -62,7 +61,12 @@ func (o *BindingClient) GetFlags(flags map[string]string) map[string]string {
 			flag == backendpkg.FLAG_SERVICE_NAMESPACE ||
 			flag == backendpkg.FLAG_SERVICE ||
 			flag == backendpkg.FLAG_BIND_AS_FILES ||
-			flag == backendpkg.FLAG_NAMING_STRATEGY {
+			flag == backendpkg.FLAG_NAMING_STRATEGY ||
+			flag == backendpkg.FLAG_NATIVE ||
+			flag == backendpkg.FLAG_SECRET ||
+			flag == backendpkg.FLAG_TYPE ||
+			flag == backendpkg.FLAG_PROVIDER ||
+			flag == backendpkg.FLAG_COMPONENT {
 			bindingFlags[flag] = value
 		}
 	}
@@ -126,15 +130,15 @@ func (o *BindingClient) GetBindingsFromDevfile(devfileObj parser.DevfileObj, con
 	}
 
 	for _, component := range kubeComponents {
-		strCRD, err := libdevfile.GetK8sManifestsWithVariablesSubstituted(devfileObj, component.Name, context, devfilefs.DefaultFs{})
+		// the manifests are decoded into JSON-compatible objects, so nested maps (e.g. annotations) can be converted
+		resources, err := libdevfile.GetK8sComponentAsUnstructuredList(devfileObj, component.Name, context, devfilefs.DefaultFs{})
 		if err != nil {
 			return nil, err
 		}
-
-		u := unstructured.Unstructured{}
-		if err := yaml.Unmarshal([]byte(strCRD), &u.Object); err != nil {
-			return nil, err
+		if len(resources) == 0 {
+			continue
 		}
+		u := resources[0]
 
 		switch u.GetObjectKind().GroupVersionKind() {
 		case bindingApi.GroupVersionKind:
@@ -158,6 +162,19 @@ func (o *BindingClient) GetBindingsFromDevfile(devfileObj parser.DevfileObj, con
 
 		case specApi.GroupVersion.WithKind("ServiceBinding"):
 
+			if IsNativeBinding(u) {
+				binding, err := nativeBindingFromResource(u)
+				if err != nil {
+					return nil, err
+				}
+				status, err := o.getStatusFromNativeBinding(binding)
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, binding.apiServiceBinding(status))
+				continue
+			}
+
 			var sbc specApi.ServiceBinding
 			err := kclient.ConvertUnstructuredToResource(u, &sbc)
 			if err != nil {
@@ -293,6 +310,33 @@ func (o *BindingClient) getStatusFromSpec(name string) (*api.ServiceBindingStatu
 	}, nil
 }
 
+// getStatusFromNativeBinding returns status information from the Secret generated by odo in the cluster
+// to project a native binding into the component
+func (o *BindingClient) getStatusFromNativeBinding(binding NativeBinding) (*api.ServiceBindingStatus, error) {
+	if o.kubernetesClient == nil {
+		return nil, nil
+	}
+	secret, err := o.kubernetesClient.GetSecret(binding.SecretName(), o.kubernetesClient.GetCurrentNamespace())
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	binding.Entries = make(map[string]string, len(secret.Data))
+	for k, v := range secret.Data {
+		binding.Entries[k] = string(v)
+	}
+	bindingEnvVars := make([]string, 0, len(binding.Env))
+	for _, env := range binding.Env {
+		bindingEnvVars = append(bindingEnvVars, env.Name)
+	}
+	return &api.ServiceBindingStatus{
+		BindingFiles:   binding.Files(),
+		BindingEnvVars: bindingEnvVars,
+	}, nil
+}
+
 func (o *BindingClient) checkServiceBindingOperatorInstalled() error {
 	isServiceBindingInstalled, err := o.kubernetesClient.IsServiceBindingSupported()
 	if err != nil {
@@ -0,0 +1,328 @@
+package binding
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/exec"
+)
+
+const (
+	// checkMarker prefixes the lines of the output of the probes: <marker> <probe> <status> [<message>]
+	checkMarker = "@@odo-check@@"
+	// checkTimeout is the timeout of each probe, in seconds
+	checkTimeout = 5
+
+	probeTCP  = "tcp"
+	probeAuth = "auth"
+)
+
+// bindingConnection is the connection information of a binding, read from its entries.
+// The credentials are never part of the probes run in the container: they are read in the container
+// by the shell expressions PasswordRef and URLRef, from the files or environment variables of the binding
+type bindingConnection struct {
+	Type     string
+	Host     string
+	Port     int
+	Username string
+	Database string
+	// HasURL is true if the binding defines a URL, read by URLRef
+	HasURL bool
+	// PasswordRef is the shell expression reading the password in the container, empty if the binding has no password
+	PasswordRef string
+	// URLRef is the shell expression reading the URL in the container
+	URLRef string
+}
+
+// bindingEntry is an entry of a binding, with the shell expression reading its value in the container
+type bindingEntry struct {
+	value string
+	ref   string
+}
+
+// envVarNameRegexp matches the names of environment variables which can be referenced in a shell script
+var envVarNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// urlPasswordSed is the sed expression extracting the password of a URL
+const urlPasswordSed = `s#^[^:]*://[^:/@]*:\([^@]*\)@.*#\1#p`
+
+// urlWithoutUserSed is the sed expression removing the user information of a URL
+const urlWithoutUserSed = `s#^\([^:]*://\)[^@/]*@#\1#`
+
+// defaultPorts are the ports used when a binding of a known type does not define its port
+var defaultPorts = map[string]int{
+	"postgresql": 5432,
+	"mysql":      3306,
+	"redis":      6379,
+	"http":       80,
+	"https":      443,
+}
+
+// typeAliases are the alternative names of the types of bindings
+var typeAliases = map[string]string{
+	"postgres": "postgresql",
+	"mariadb":  "mysql",
+}
+
+// CheckBinding runs, in the container containerName of the pod podName, the probes of the binding:
+// a TCP connection to the host and port of the binding, then a handshake authenticated with the credentials
+// of the binding for the PostgreSQL, MySQL, Redis and HTTP types.
+// The values of the binding must have been read, revealed, with GetBindingValues
+func CheckBinding(execClient exec.Client, podName string, containerName string, binding api.ServiceBinding) (api.BindingCheck, error) {
+	result := api.BindingCheck{
+		Name:         binding.Name,
+		Reachability: api.BindingCheckSkipped,
+		Auth:         api.BindingCheckSkipped,
+	}
+	if binding.Status == nil {
+		result.Reachability = api.BindingCheckFailed
+		result.Message = "the binding is not injected into the component yet"
+		return result, nil
+	}
+
+	conn := getBindingConnection(getBindingEntries(binding.Status.Values))
+	result.Type = conn.Type
+	result.Host = conn.Host
+	result.Port = conn.Port
+	if conn.Host == "" || conn.Port == 0 {
+		result.Message = "no host and port found in the binding"
+		return result, nil
+	}
+
+	stdout, _, err := execClient.ExecuteCommand([]string{"sh", "-c", getCheckScript(conn)}, podName, containerName, false, nil, nil)
+	if err != nil {
+		return api.BindingCheck{}, fmt.Errorf("unable to run the probes of the binding %q in the container %q: %w", binding.Name, containerName, err)
+	}
+	var messages []string
+	for _, line := range stdout {
+		if !strings.HasPrefix(line, checkMarker+" ") {
+			continue
+		}
+		fields := strings.SplitN(strings.TrimPrefix(line, checkMarker+" "), " ", 3)
+		if len(fields) < 2 {
+			continue
+		}
+		status := api.BindingCheckStatus(fields[1])
+		switch fields[0] {
+		case probeTCP:
+			result.Reachability = status
+		case probeAuth:
+			result.Auth = status
+		}
+		if len(fields) == 3 && fields[2] != "" {
+			messages = append(messages, fields[2])
+		}
+	}
+	result.Message = strings.Join(messages, "; ")
+	return result, nil
+}
+
+// IsBindingCheckHealthy returns true if no probe of the check failed
+func IsBindingCheckHealthy(check api.BindingCheck) bool {
+	return check.Reachability != api.BindingCheckFailed && check.Auth != api.BindingCheckFailed
+}
+
+// getBindingEntries returns the values of the binding found in the container, by entry name:
+// the name of the files, and the names of the environment variables in lower case, also without their prefix
+// (DB_HOST is available as db_host and host). The files take precedence over the environment variables
+func getBindingEntries(values []api.BindingValue) map[string]bindingEntry {
+	entries := map[string]bindingEntry{}
+	var envVars []api.BindingValue
+	for _, value := range values {
+		if value.Missing {
+			continue
+		}
+		if strings.HasPrefix(value.Name, serviceBindingRootVar) {
+			entries[path.Base(value.Name)] = bindingEntry{
+				value: strings.TrimSpace(value.Value),
+				ref:   `"$(cat "$` + ServiceBindingRootEnv + `"` + shellQuote(strings.TrimPrefix(value.Name, serviceBindingRootVar)) + `)"`,
+			}
+			continue
+		}
+		envVars = append(envVars, value)
+	}
+	for _, value := range envVars {
+		name := strings.ToLower(value.Name)
+		keys := []string{name}
+		if i := strings.LastIndex(name, "_"); i >= 0 {
+			keys = append(keys, name[i+1:])
+		}
+		entry := bindingEntry{value: strings.TrimSpace(value.Value)}
+		if envVarNameRegexp.MatchString(value.Name) {
+			entry.ref = `"$` + value.Name + `"`
+		}
+		for _, key := range keys {
+			if _, found := entries[key]; !found {
+				entries[key] = entry
+			}
+		}
+	}
+	return entries
+}
+
+// getBindingConnection returns the connection information from the entries of a binding,
+// following the well-known entries of the servicebinding.io specification
+func getBindingConnection(entries map[string]bindingEntry) bindingConnection {
+	lookup := func(keys ...string) bindingEntry {
+		for _, key := range keys {
+			if entry := entries[key]; entry.value != "" {
+				return entry
+			}
+		}
+		return bindingEntry{}
+	}
+
+	conn := bindingConnection{
+		Type:     strings.ToLower(lookup("type").value),
+		Host:     lookup("host", "hostname").value,
+		Username: lookup("username", "user").value,
+		Database: lookup("database", "dbname", "db").value,
+	}
+	conn.Port, _ = strconv.Atoi(lookup("port").value)
+	if password := lookup("password"); password.value != "" {
+		conn.PasswordRef = password.ref
+	}
+
+	urlEntry := lookup("url", "uri")
+	if urlEntry.value != "" && urlEntry.ref != "" {
+		conn.HasURL = true
+		conn.URLRef = urlEntry.ref
+	}
+	if u, err := url.Parse(urlEntry.value); err == nil && u.Host != "" {
+		scheme := strings.ToLower(u.Scheme)
+		if conn.Type == "" {
+			conn.Type = scheme
+		}
+		if conn.Host == "" {
+			conn.Host = u.Hostname()
+			conn.Port, _ = strconv.Atoi(u.Port())
+			if conn.Port == 0 {
+				conn.Port = defaultPorts[scheme]
+			}
+		}
+		if conn.Username == "" && u.User != nil && conn.HasURL {
+			conn.Username = u.User.Username()
+			if _, ok := u.User.Password(); ok {
+				conn.PasswordRef = fmt.Sprintf(`"$(printf '%%s' %s | sed -n '%s')"`, conn.URLRef, urlPasswordSed)
+			}
+		}
+		if conn.Database == "" {
+			conn.Database = strings.TrimPrefix(u.Path, "/")
+		}
+	}
+	if alias, ok := typeAliases[conn.Type]; ok {
+		conn.Type = alias
+	}
+	if conn.Port == 0 {
+		conn.Port = defaultPorts[conn.Type]
+	}
+	return conn
+}
+
+// getCheckScript returns a shell script probing the TCP connection to the host and port of the binding,
+// then authenticating with the client of the type of the binding, if found in the container.
+// Each probe outputs a line: <checkMarker> <probe> <status> [<message>]
+func getCheckScript(conn bindingConnection) string {
+	var script strings.Builder
+	fmt.Fprintf(&script, "h=%s; p=%d\n", shellQuote(conn.Host), conn.Port)
+	if conn.PasswordRef != "" {
+		fmt.Fprintf(&script, "pw=%s\n", conn.PasswordRef)
+	}
+	if conn.HasURL {
+		fmt.Fprintf(&script, "url=%s\n", conn.URLRef)
+	}
+	fmt.Fprintf(&script, "to=; if command -v timeout >/dev/null 2>&1; then to='timeout %d'; fi\n", checkTimeout)
+	fmt.Fprintf(&script, `if command -v bash >/dev/null 2>&1; then
+  $to bash -c 'exec 3<>"/dev/tcp/$0/$1"' "$h" "$p" >/dev/null 2>&1
+elif command -v nc >/dev/null 2>&1; then
+  $to nc -z -w %[2]d "$h" "$p" >/dev/null 2>&1
+else
+  echo '%[1]s tcp skipped neither bash nor nc found in the container'; exit 0
+fi
+if [ $? -ne 0 ]; then echo "%[1]s tcp failed unable to connect to $h:$p"; exit 0; fi
+echo '%[1]s tcp ok'
+`, checkMarker, checkTimeout)
+
+	tool, command, condition := getAuthCommand(conn)
+	switch {
+	case tool == "" && conn.Type == "":
+		fmt.Fprintf(&script, "echo '%s auth skipped no type in the binding'\n", checkMarker)
+		return script.String()
+	case tool == "":
+		fmt.Fprintf(&script, "echo '%s auth skipped no handshake for the type '%s\n", checkMarker, shellQuote(conn.Type))
+		return script.String()
+	}
+	fmt.Fprintf(&script, `if command -v %[2]s >/dev/null 2>&1; then
+  if out=$(%[3]s 2>&1)%[4]s; then echo '%[1]s auth ok'; else echo '%[1]s auth failed '"$(printf '%%s\n' "$out" | head -n 1)"; fi
+else
+  echo '%[1]s auth skipped %[2]s not found in the container'
+fi
+`, checkMarker, tool, command, condition)
+	return script.String()
+}
+
+// getAuthCommand returns the client, the command and the additional condition on its output $out
+// authenticating to the service of the binding, depending on its type
+func getAuthCommand(conn bindingConnection) (tool string, command string, condition string) {
+	switch conn.Type {
+	case "postgresql":
+		args := []string{"psql", `-h "$h"`, `-p "$p"`}
+		if conn.Username != "" {
+			args = append(args, "-U", shellQuote(conn.Username))
+		}
+		if conn.Database != "" {
+			args = append(args, "-d", shellQuote(conn.Database))
+		}
+		args = append(args, "-w", "-tAc", "'select 1'")
+		return "psql", fmt.Sprintf(`PGPASSWORD="$pw" PGCONNECT_TIMEOUT=%d; export PGPASSWORD PGCONNECT_TIMEOUT; $to %s`,
+			checkTimeout, strings.Join(args, " ")), ""
+	case "mysql":
+		args := []string{"mysql", `-h "$h"`, `-P "$p"`, fmt.Sprintf("--connect-timeout=%d", checkTimeout)}
+		if conn.Username != "" {
+			args = append(args, "-u", shellQuote(conn.Username))
+		}
+		args = append(args, "-e", "'select 1'")
+		if conn.Database != "" {
+			args = append(args, shellQuote(conn.Database))
+		}
+		return "mysql", `MYSQL_PWD="$pw"; export MYSQL_PWD; $to ` + strings.Join(args, " "), ""
+	case "redis":
+		args := []string{"redis-cli", `-h "$h"`, `-p "$p"`}
+		if conn.Username != "" {
+			args = append(args, "--user", shellQuote(conn.Username))
+		}
+		args = append(args, "ping")
+		command := "$to " + strings.Join(args, " ")
+		if conn.PasswordRef != "" {
+			command = `REDISCLI_AUTH="$pw"; export REDISCLI_AUTH; ` + command
+		}
+		// redis-cli exits successfully on authentication errors
+		return "redis-cli", command, ` && [ "$out" = PONG ]`
+	case "http", "https":
+		target := shellQuote(fmt.Sprintf("%s://%s:%d/", conn.Type, conn.Host, conn.Port))
+		if conn.HasURL {
+			// the credentials of the URL are passed with the configuration below
+			target = fmt.Sprintf(`"$(printf '%%s' "$url" | sed '%s')"`, urlWithoutUserSed)
+		}
+		args := []string{"curl", "-s", "-k", "-o", "/dev/null", fmt.Sprintf("-m %d", checkTimeout), "-w", "'HTTP status %{http_code}'"}
+		command := strings.Join(append(args, target), " ")
+		if conn.Username != "" {
+			// the credentials are passed in the configuration read from stdin, not visible in the arguments of curl
+			command = fmt.Sprintf(`printf 'user = "%%s"\n' "$(printf '%%s' %s:"$pw" | sed 's/[\\"]/\\&/g')" | %s -K -`,
+				shellQuote(conn.Username), command)
+		}
+		// the authentication fails with the statuses 401 and 403
+		return "curl", command, ` && [ "${out#HTTP status 40[13]}" = "$out" ]`
+	}
+	return "", "", ""
+}
+
+// shellQuote returns the value quoted for a POSIX shell
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
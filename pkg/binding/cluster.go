@@ -0,0 +1,123 @@
+package binding
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/devfile/library/pkg/devfile/parser"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	bindingApis "github.com/redhat-developer/service-binding-operator/apis"
+	bindingApi "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
+	specApi "github.com/redhat-developer/service-binding-operator/apis/spec/v1alpha3"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+// IsClusterBinding returns true if the resource is a ServiceBinding projected by the Service Binding Operator
+func IsClusterBinding(u unstructured.Unstructured) bool {
+	gvk := u.GroupVersionKind()
+	if gvk.Kind != "ServiceBinding" {
+		return false
+	}
+	return gvk.Group == bindingApi.GroupVersion.Group || (gvk.Group == specApi.GroupVersion.Group && !IsNativeBinding(u))
+}
+
+// GetClusterBindings returns the ServiceBindings of the devfile projected by the Service Binding Operator,
+// as bindings whose entries are read from the Secret generated by the Operator in the current namespace.
+// The environment variables are the ones defined by the ServiceBindings on the cluster, so that the application
+// gets the same configuration when the bindings are projected by odo, for example into a podman pod.
+// The names of the ServiceBindings not found on the cluster, or not injected yet, are returned as notReady.
+func GetClusterBindings(devfileObj parser.DevfileObj, context string, kubeClient kclient.ClientInterface) (bindings []NativeBinding, notReady []string, err error) {
+	resources, err := getDevfileResources(devfileObj, context)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, u := range resources {
+		if !IsClusterBinding(u) {
+			continue
+		}
+		if kubeClient == nil {
+			notReady = append(notReady, u.GetName())
+			continue
+		}
+		binding, found, err := getClusterBinding(u.GetName(), kubeClient)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !found {
+			notReady = append(notReady, u.GetName())
+			continue
+		}
+		bindings = append(bindings, binding)
+	}
+	return bindings, notReady, nil
+}
+
+// getClusterBinding returns the binding named name, with the entries of its Secret,
+// searching first the ServiceBindings from group binding.operators.coreos.com, then from group servicebinding.io,
+// as GetBindingFromCluster does
+func getClusterBinding(name string, kubeClient kclient.ClientInterface) (NativeBinding, bool, error) {
+	binding := NativeBinding{
+		Name:      name,
+		Directory: name,
+	}
+	var (
+		secretName string
+		// envFromKeys indicates the entries are injected as environment variables named after the keys of the Secret
+		envFromKeys bool
+	)
+
+	bindingSB, err := kubeClient.GetBindingServiceBinding(name)
+	switch {
+	case err == nil:
+		if !meta.IsStatusConditionTrue(bindingSB.Status.Conditions, bindingApis.InjectionReady) || bindingSB.Status.Secret == "" {
+			return NativeBinding{}, false, nil
+		}
+		secretName = bindingSB.Status.Secret
+		envFromKeys = !bindingSB.Spec.BindAsFiles
+	case kerrors.IsNotFound(err):
+		specSB, err := kubeClient.GetSpecServiceBinding(name)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return NativeBinding{}, false, nil
+			}
+			return NativeBinding{}, false, err
+		}
+		if !meta.IsStatusConditionTrue(specSB.Status.Conditions, bindingApis.InjectionReady) || specSB.Status.Binding == nil {
+			return NativeBinding{}, false, nil
+		}
+		secretName = specSB.Status.Binding.Name
+		if specSB.Spec.Name != "" {
+			binding.Directory = specSB.Spec.Name
+		}
+		binding.Containers = specSB.Spec.Workload.Containers
+		binding.Env = specSB.Spec.Env
+	default:
+		return NativeBinding{}, false, err
+	}
+
+	secret, err := kubeClient.GetSecret(secretName, kubeClient.GetCurrentNamespace())
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return NativeBinding{}, false, nil
+		}
+		return NativeBinding{}, false, fmt.Errorf("unable to read the Secret of the binding %q: %w", name, err)
+	}
+	binding.Entries = make(map[string]string, len(secret.Data))
+	for key, value := range secret.Data {
+		binding.Entries[key] = string(value)
+	}
+	if envFromKeys {
+		// the keys of the Secret are the names of the environment variables injected by the Operator
+		for key := range binding.Entries {
+			binding.Env = append(binding.Env, specApi.EnvMapping{Name: key, Key: key})
+		}
+		sort.Slice(binding.Env, func(i, j int) bool {
+			return binding.Env[i].Name < binding.Env[j].Name
+		})
+	}
+	return binding, true, nil
+}
@@ -0,0 +1,153 @@
+package binding
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	bindingApis "github.com/redhat-developer/service-binding-operator/apis"
+	bindingApi "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
+	specApi "github.com/redhat-developer/service-binding-operator/apis/spec/v1alpha3"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+const (
+	pgBindingManifest = `apiVersion: binding.operators.coreos.com/v1alpha1
+kind: ServiceBinding
+metadata:
+  name: pg
+spec:
+  bindAsFiles: false
+  application:
+    group: apps
+    version: v1
+    resource: deployments
+    name: my-app-app
+  services:
+    - group: postgresql.k8s.enterprisedb.io
+      version: v1
+      kind: Cluster
+      name: my-db
+`
+	mqBindingManifest = `apiVersion: servicebinding.io/v1alpha3
+kind: ServiceBinding
+metadata:
+  name: mq
+spec:
+  workload:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: my-app-app
+  service:
+    apiVersion: rabbitmq.com/v1beta1
+    kind: RabbitmqCluster
+    name: my-mq
+`
+	pendingBindingManifest = `apiVersion: binding.operators.coreos.com/v1alpha1
+kind: ServiceBinding
+metadata:
+  name: pending
+spec:
+  application:
+    group: apps
+    version: v1
+    resource: deployments
+    name: my-app-app
+  services:
+    - group: redis.redis.opstreelabs.in
+      version: v1beta1
+      kind: Redis
+      name: my-redis
+`
+)
+
+func TestGetClusterBindings(t *testing.T) {
+	injected := []metav1.Condition{{Type: bindingApis.InjectionReady, Status: metav1.ConditionTrue}}
+	notFound := kerrors.NewNotFound(schema.GroupResource{}, "")
+
+	devfileObj := getDevfileWithManifests(t, map[string]string{
+		"pg":      pgBindingManifest,
+		"mq":      mqBindingManifest,
+		"pending": pendingBindingManifest,
+		"db":      dbBindingManifest,
+		"secret":  dbSecretManifest,
+	})
+
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	kubeClient.EXPECT().GetCurrentNamespace().Return("my-ns").AnyTimes()
+	kubeClient.EXPECT().GetBindingServiceBinding("pg").Return(bindingApi.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "pg"},
+		Status:     bindingApi.ServiceBindingStatus{Conditions: injected, Secret: "pg-secret"},
+	}, nil)
+	kubeClient.EXPECT().GetSecret("pg-secret", "my-ns").Return(&corev1.Secret{
+		Data: map[string][]byte{"PG_HOST": []byte("my-db-rw"), "PG_PORT": []byte("5432")},
+	}, nil)
+	kubeClient.EXPECT().GetBindingServiceBinding("mq").Return(bindingApi.ServiceBinding{}, notFound)
+	kubeClient.EXPECT().GetSpecServiceBinding("mq").Return(specApi.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "mq"},
+		Spec: specApi.ServiceBindingSpec{
+			Name: "rabbitmq",
+			Env:  []specApi.EnvMapping{{Name: "MQ_HOST", Key: "host"}},
+		},
+		Status: specApi.ServiceBindingStatus{
+			Conditions: injected,
+			Binding:    &specApi.ServiceBindingSecretReference{Name: "mq-secret"},
+		},
+	}, nil)
+	kubeClient.EXPECT().GetSecret("mq-secret", "my-ns").Return(&corev1.Secret{
+		Data: map[string][]byte{"host": []byte("my-mq"), "port": []byte("5672")},
+	}, nil)
+	kubeClient.EXPECT().GetBindingServiceBinding("pending").Return(bindingApi.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "pending"},
+	}, nil)
+
+	got, notReady, err := GetClusterBindings(devfileObj, "", kubeClient)
+	if err != nil {
+		t.Fatalf("GetClusterBindings() unexpected error: %v", err)
+	}
+	sort.Slice(got, func(i, j int) bool {
+		return got[i].Name < got[j].Name
+	})
+	want := []NativeBinding{
+		{
+			Name:      "mq",
+			Directory: "rabbitmq",
+			Env:       []specApi.EnvMapping{{Name: "MQ_HOST", Key: "host"}},
+			Entries:   map[string]string{"host": "my-mq", "port": "5672"},
+		},
+		{
+			Name:      "pg",
+			Directory: "pg",
+			Env:       []specApi.EnvMapping{{Name: "PG_HOST", Key: "PG_HOST"}, {Name: "PG_PORT", Key: "PG_PORT"}},
+			Entries:   map[string]string{"PG_HOST": "my-db-rw", "PG_PORT": "5432"},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetClusterBindings() mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"pending"}, notReady); diff != "" {
+		t.Errorf("GetClusterBindings() notReady mismatch (-want +got):\n%s", diff)
+	}
+
+	t.Run("without access to the cluster", func(t *testing.T) {
+		got, notReady, err := GetClusterBindings(devfileObj, "", nil)
+		if err != nil {
+			t.Fatalf("GetClusterBindings() unexpected error: %v", err)
+		}
+		if len(got) != 0 {
+			t.Errorf("GetClusterBindings() = %v, want no binding", got)
+		}
+		sort.Strings(notReady)
+		if diff := cmp.Diff([]string{"mq", "pending", "pg"}, notReady); diff != "" {
+			t.Errorf("GetClusterBindings() notReady mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
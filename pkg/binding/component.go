@@ -0,0 +1,128 @@
+package binding
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/devfile/library/pkg/devfile/parser"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+const (
+	// ComponentBindingAnnotation contains the name of the component bound by a native binding
+	ComponentBindingAnnotation = "odo.dev/binding-component"
+
+	// PodmanHost is the host name of the machine running podman, from the containers
+	PodmanHost = "host.containers.internal"
+
+	// the entries of the bindings to components
+	componentHostEntry = "host"
+	componentPortEntry = "port"
+	componentURLEntry  = "url"
+)
+
+// ComponentEndpointFunc returns the host and port to reach the component componentName,
+// and false if the component is not running
+type ComponentEndpointFunc func(componentName string) (host string, port int, found bool, err error)
+
+// GetBoundComponents returns the names of the components bound by the native bindings of the devfile, sorted
+func GetBoundComponents(devfileObj parser.DevfileObj, context string) ([]string, error) {
+	resources, err := getDevfileResources(devfileObj, context)
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	for _, u := range resources {
+		if !IsNativeBinding(u) {
+			continue
+		}
+		if component := u.GetAnnotations()[ComponentBindingAnnotation]; component != "" && !contains(result, component) {
+			result = append(result, component)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// ResolveComponentBindings sets the host, port and url entries of the bindings to components,
+// from the endpoints returned by getEndpoint. The names of the components not running are returned,
+// and the entries of their bindings are not set
+func ResolveComponentBindings(bindings []NativeBinding, getEndpoint ComponentEndpointFunc) (notRunning []string, err error) {
+	for i := range bindings {
+		if bindings[i].Component == "" {
+			continue
+		}
+		host, port, found, err := getEndpoint(bindings[i].Component)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the endpoint of the component %q bound by %q: %w", bindings[i].Component, bindings[i].Name, err)
+		}
+		if !found {
+			notRunning = append(notRunning, bindings[i].Component)
+			continue
+		}
+		if bindings[i].Entries == nil {
+			bindings[i].Entries = map[string]string{}
+		}
+		bindings[i].Entries[componentHostEntry] = host
+		bindings[i].Entries[componentPortEntry] = strconv.Itoa(port)
+		bindings[i].Entries[componentURLEntry] = fmt.Sprintf("http://%s:%d", host, port)
+	}
+	return notRunning, nil
+}
+
+// GetClusterComponentEndpoint returns a function returning the Service of a component running in Dev mode on the cluster,
+// or deployed with odo deploy, with its first port
+func GetClusterComponentEndpoint(kubeClient kclient.ClientInterface, appName string) ComponentEndpointFunc {
+	return func(componentName string) (string, int, bool, error) {
+		for _, mode := range []string{odolabels.ComponentDevMode, odolabels.ComponentDeployMode} {
+			services, err := kubeClient.ListServices(odolabels.GetSelector(componentName, appName, mode, false))
+			if err != nil {
+				return "", 0, false, err
+			}
+			sort.Slice(services, func(i, j int) bool {
+				return services[i].Name < services[j].Name
+			})
+			for _, service := range services {
+				if len(service.Spec.Ports) > 0 {
+					return service.Name, int(service.Spec.Ports[0].Port), true, nil
+				}
+			}
+		}
+		return "", 0, false, nil
+	}
+}
+
+// GetPodmanComponentEndpoint returns a function returning the host and the first port forwarded on the host
+// of a component running in Dev mode on podman, as reached from other podman pods
+func GetPodmanComponentEndpoint(podmanClient podman.Client, appName string) ComponentEndpointFunc {
+	return func(componentName string) (string, int, bool, error) {
+		podName, err := util.NamespaceKubernetesObject(componentName, appName)
+		if err != nil {
+			return "", 0, false, err
+		}
+		pods, err := podmanClient.PodLs()
+		if err != nil {
+			return "", 0, false, err
+		}
+		if !pods[podName] {
+			return "", 0, false, nil
+		}
+		pod, err := podmanClient.KubeGenerate(podName)
+		if err != nil {
+			return "", 0, false, err
+		}
+		for _, container := range pod.Spec.Containers {
+			for _, port := range container.Ports {
+				if port.HostPort != 0 {
+					return PodmanHost, int(port.HostPort), true, nil
+				}
+			}
+		}
+		return "", 0, false, nil
+	}
+}
@@ -0,0 +1,326 @@
+package binding
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	specApi "github.com/redhat-developer/service-binding-operator/apis/spec/v1alpha3"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/podman"
+	odoTestingUtil "github.com/redhat-developer/odo/pkg/testingutil"
+)
+
+const apiBindingManifest = `apiVersion: servicebinding.io/v1alpha3
+kind: ServiceBinding
+metadata:
+  name: my-app-api
+  annotations:
+    odo.dev/binding-mode: native
+    odo.dev/binding-component: api
+spec:
+  workload:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: my-app-app
+  service:
+    apiVersion: v1
+    kind: Service
+    name: api-app
+  env:
+    - name: API_HOST
+      key: host
+    - name: API_PORT
+      key: port
+    - name: API_URL
+      key: url
+`
+
+func TestGetBoundComponents(t *testing.T) {
+	devfileObj := getDevfileWithManifests(t, map[string]string{
+		"my-app-api": apiBindingManifest,
+		"db":         dbBindingManifest,
+		"pg":         pgBindingManifest,
+	})
+	got, err := GetBoundComponents(devfileObj, "")
+	if err != nil {
+		t.Fatalf("GetBoundComponents() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"api"}, got); diff != "" {
+		t.Errorf("GetBoundComponents() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestResolveComponentBindings(t *testing.T) {
+	endpoints := map[string]int{"api": 8080}
+	getEndpoint := func(componentName string) (string, int, bool, error) {
+		if componentName == "broken" {
+			return "", 0, false, errors.New("connection refused")
+		}
+		port, found := endpoints[componentName]
+		return componentName + "-app", port, found, nil
+	}
+
+	tests := []struct {
+		name           string
+		bindings       []NativeBinding
+		wantBindings   []NativeBinding
+		wantNotRunning []string
+		wantErr        string
+	}{
+		{
+			name: "component running",
+			bindings: []NativeBinding{
+				{Name: "db", Entries: map[string]string{"username": "user"}},
+				{Name: "my-app-api", Component: "api", Entries: map[string]string{"type": "http"}},
+			},
+			wantBindings: []NativeBinding{
+				{Name: "db", Entries: map[string]string{"username": "user"}},
+				{Name: "my-app-api", Component: "api", Entries: map[string]string{
+					"type": "http",
+					"host": "api-app",
+					"port": "8080",
+					"url":  "http://api-app:8080",
+				}},
+			},
+		},
+		{
+			name:           "component not running",
+			bindings:       []NativeBinding{{Name: "my-app-web", Component: "web", Entries: map[string]string{}}},
+			wantBindings:   []NativeBinding{{Name: "my-app-web", Component: "web", Entries: map[string]string{}}},
+			wantNotRunning: []string{"web"},
+		},
+		{
+			name:     "error",
+			bindings: []NativeBinding{{Name: "my-app-broken", Component: "broken"}},
+			wantErr:  `unable to get the endpoint of the component "broken" bound by "my-app-broken": connection refused`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notRunning, err := ResolveComponentBindings(tt.bindings, getEndpoint)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("ResolveComponentBindings() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveComponentBindings() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantNotRunning, notRunning); diff != "" {
+				t.Errorf("ResolveComponentBindings() not running mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantBindings, tt.bindings); diff != "" {
+				t.Errorf("ResolveComponentBindings() bindings mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetClusterComponentEndpoint(t *testing.T) {
+	devSelector := odolabels.GetSelector("api", "app", odolabels.ComponentDevMode, false)
+	deploySelector := odolabels.GetSelector("api", "app", odolabels.ComponentDeployMode, false)
+	service := func(name string, port int32) corev1.Service {
+		return corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: port}}},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(client *kclient.MockClientInterface)
+		wantHost  string
+		wantPort  int
+		wantFound bool
+	}{
+		{
+			name: "running in Dev mode",
+			setup: func(client *kclient.MockClientInterface) {
+				client.EXPECT().ListServices(devSelector).Return([]corev1.Service{service("api-app", 8080)}, nil)
+			},
+			wantHost:  "api-app",
+			wantPort:  8080,
+			wantFound: true,
+		},
+		{
+			name: "deployed",
+			setup: func(client *kclient.MockClientInterface) {
+				client.EXPECT().ListServices(devSelector).Return(nil, nil)
+				client.EXPECT().ListServices(deploySelector).Return([]corev1.Service{service("api-svc", 80), service("api", 9090)}, nil)
+			},
+			wantHost:  "api",
+			wantPort:  9090,
+			wantFound: true,
+		},
+		{
+			name: "not running",
+			setup: func(client *kclient.MockClientInterface) {
+				client.EXPECT().ListServices(devSelector).Return(nil, nil)
+				client.EXPECT().ListServices(deploySelector).Return(nil, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := kclient.NewMockClientInterface(ctrl)
+			tt.setup(client)
+			host, port, found, err := GetClusterComponentEndpoint(client, "app")("api")
+			if err != nil {
+				t.Fatalf("GetClusterComponentEndpoint() unexpected error: %v", err)
+			}
+			if host != tt.wantHost || port != tt.wantPort || found != tt.wantFound {
+				t.Errorf("GetClusterComponentEndpoint() = %q, %d, %v, want %q, %d, %v", host, port, found, tt.wantHost, tt.wantPort, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestGetPodmanComponentEndpoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		setup     func(client *podman.MockClient)
+		wantPort  int
+		wantFound bool
+	}{
+		{
+			name: "running",
+			setup: func(client *podman.MockClient) {
+				client.EXPECT().PodLs().Return(map[string]bool{"api-app": true}, nil)
+				client.EXPECT().KubeGenerate("api-app").Return(&corev1.Pod{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{
+						{Name: "tools"},
+						{Name: "runtime", Ports: []corev1.ContainerPort{{ContainerPort: 8080, HostPort: 40001}}},
+					}},
+				}, nil)
+			},
+			wantPort:  40001,
+			wantFound: true,
+		},
+		{
+			name: "not running",
+			setup: func(client *podman.MockClient) {
+				client.EXPECT().PodLs().Return(map[string]bool{"web-app": true}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := podman.NewMockClient(ctrl)
+			tt.setup(client)
+			host, port, found, err := GetPodmanComponentEndpoint(client, "app")("api")
+			if err != nil {
+				t.Fatalf("GetPodmanComponentEndpoint() unexpected error: %v", err)
+			}
+			if found && host != PodmanHost {
+				t.Errorf("GetPodmanComponentEndpoint() host = %q, want %q", host, PodmanHost)
+			}
+			if port != tt.wantPort || found != tt.wantFound {
+				t.Errorf("GetPodmanComponentEndpoint() = %d, %v, want %d, %v", port, found, tt.wantPort, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestBindingClient_ValidateAddBinding_Component(t *testing.T) {
+	tests := []struct {
+		name        string
+		flags       map[string]string
+		withDevfile bool
+		wantErr     string
+	}{
+		{
+			name:        "component",
+			flags:       map[string]string{"component": "api"},
+			withDevfile: true,
+		},
+		{
+			name:        "component with name and type",
+			flags:       map[string]string{"component": "api", "name": "backend", "type": "http", "native": "true"},
+			withDevfile: true,
+		},
+		{
+			name:    "without devfile",
+			flags:   map[string]string{"component": "api"},
+			wantErr: "--component can only be used from a directory containing a Devfile",
+		},
+		{
+			name:        "with service",
+			flags:       map[string]string{"component": "api", "service": "db-credentials"},
+			withDevfile: true,
+			wantErr:     "--service cannot be used with --component",
+		},
+		{
+			name:        "not native",
+			flags:       map[string]string{"component": "api", "native": "false"},
+			withDevfile: true,
+			wantErr:     "--native=false cannot be used with --component",
+		},
+		{
+			name:        "invalid component name",
+			flags:       map[string]string{"component": "My_API"},
+			withDevfile: true,
+			wantErr:     "component",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewBindingClient(nil, nil)
+			err := o.ValidateAddBinding(tt.flags, tt.withDevfile)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateAddBinding() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateAddBinding() error = %v, want an error starting with %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBindingClient_AddComponentBindingToDevfile(t *testing.T) {
+	o := NewBindingClient(nil, nil)
+	obj := odoTestingUtil.GetTestDevfileObj(filesystem.NewFakeFs())
+	got, added, err := o.AddComponentBindingToDevfile("my-app", map[string]string{"component": "api"}, obj)
+	if err != nil {
+		t.Fatalf("AddComponentBindingToDevfile() unexpected error: %v", err)
+	}
+	want := NativeBinding{
+		Name:      "my-app-api",
+		Directory: "my-app-api",
+		Workload:  "my-app-app",
+		Service:   specApi.ServiceBindingServiceReference{APIVersion: "v1", Kind: "Service", Name: "api-app"},
+		Component: "api",
+		Env: []specApi.EnvMapping{
+			{Name: "API_HOST", Key: "host"},
+			{Name: "API_PORT", Key: "port"},
+			{Name: "API_URL", Key: "url"},
+		},
+	}
+	if diff := cmp.Diff(want, added); diff != "" {
+		t.Errorf("AddComponentBindingToDevfile() binding mismatch (-want +got):\n%s", diff)
+	}
+
+	// the binding is read back from the devfile, without accessing the cluster
+	bindings, err := GetNativeBindings(got, "", nil)
+	if err != nil {
+		t.Fatalf("GetNativeBindings() unexpected error: %v", err)
+	}
+	want.Entries = map[string]string{}
+	if diff := cmp.Diff([]NativeBinding{want}, bindings); diff != "" {
+		t.Errorf("GetNativeBindings() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -47,6 +47,21 @@ type Client interface {
 		unstructuredService unstructured.Unstructured,
 		obj parser.DevfileObj,
 	) (parser.DevfileObj, error)
+	// AddNativeBindingToDevfile adds to the devfile the ServiceBinding manifest of a binding projected by odo itself
+	// into the component, without the Service Binding Operator, and returns the binding added
+	AddNativeBindingToDevfile(
+		componentName string,
+		flags map[string]string,
+		obj parser.DevfileObj,
+		context string,
+	) (parser.DevfileObj, NativeBinding, error)
+	// AddComponentBindingToDevfile adds to the devfile the ServiceBinding manifest of a native binding
+	// to another component, and returns the binding added
+	AddComponentBindingToDevfile(
+		componentName string,
+		flags map[string]string,
+		obj parser.DevfileObj,
+	) (parser.DevfileObj, NativeBinding, error)
 	// AddBinding creates a binding in file and cluster (if options selected)
 	// and returns the selected options, the binding definition as string (if option selected)
 	// and the filename where definition is written (if options selected)
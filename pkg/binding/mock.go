@@ -70,6 +70,38 @@ func (mr *MockClientMockRecorder) AddBindingToDevfile(componentName, bindingName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBindingToDevfile", reflect.TypeOf((*MockClient)(nil).AddBindingToDevfile), componentName, bindingName, bindAsFiles, serviceNs, namingStrategy, unstructuredService, obj)
 }
 
+// AddComponentBindingToDevfile mocks base method.
+func (m *MockClient) AddComponentBindingToDevfile(componentName string, flags map[string]string, obj parser.DevfileObj) (parser.DevfileObj, NativeBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddComponentBindingToDevfile", componentName, flags, obj)
+	ret0, _ := ret[0].(parser.DevfileObj)
+	ret1, _ := ret[1].(NativeBinding)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddComponentBindingToDevfile indicates an expected call of AddComponentBindingToDevfile.
+func (mr *MockClientMockRecorder) AddComponentBindingToDevfile(componentName, flags, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComponentBindingToDevfile", reflect.TypeOf((*MockClient)(nil).AddComponentBindingToDevfile), componentName, flags, obj)
+}
+
+// AddNativeBindingToDevfile mocks base method.
+func (m *MockClient) AddNativeBindingToDevfile(componentName string, flags map[string]string, obj parser.DevfileObj, context string) (parser.DevfileObj, NativeBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNativeBindingToDevfile", componentName, flags, obj, context)
+	ret0, _ := ret[0].(parser.DevfileObj)
+	ret1, _ := ret[1].(NativeBinding)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddNativeBindingToDevfile indicates an expected call of AddNativeBindingToDevfile.
+func (mr *MockClientMockRecorder) AddNativeBindingToDevfile(componentName, flags, obj, context interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNativeBindingToDevfile", reflect.TypeOf((*MockClient)(nil).AddNativeBindingToDevfile), componentName, flags, obj, context)
+}
+
 // AskBindAsFiles mocks base method.
 func (m *MockClient) AskBindAsFiles(flags map[string]string) (bool, error) {
 	m.ctrl.T.Helper()
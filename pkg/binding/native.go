@@ -0,0 +1,458 @@
+package binding
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	devfilev1alpha2 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
+
+	specApi "github.com/redhat-developer/service-binding-operator/apis/spec/v1alpha3"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+)
+
+const (
+	// NativeBindingAnnotation is set to NativeBindingMode on the ServiceBinding resources of the devfile
+	// which are projected into the component by odo itself, without the Service Binding Operator
+	NativeBindingAnnotation = "odo.dev/binding-mode"
+	// NativeBindingMode is the value of NativeBindingAnnotation for the native bindings
+	NativeBindingMode = "native"
+	// NativeBindingSecretAnnotation contains the name of the Secret bound along with the Service of a native binding
+	NativeBindingSecretAnnotation = "odo.dev/binding-secret"
+	// NativeBindingLabel contains the name of the native binding projected by a Secret generated by odo
+	NativeBindingLabel = "odo.dev/binding"
+
+	// ServiceBindingRootEnv is the environment variable containing the directory of the bindings in the containers
+	ServiceBindingRootEnv = "SERVICE_BINDING_ROOT"
+	// DefaultServiceBindingRoot is the directory of the bindings when the container does not define SERVICE_BINDING_ROOT
+	DefaultServiceBindingRoot = "/bindings"
+)
+
+// nativeBindingKinds are the kinds of the resources which can be bound by a native binding, from the core API group
+var nativeBindingKinds = []string{"Secret", "ConfigMap", "Service"}
+
+var invalidEnvChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// NativeBinding is a binding projected by odo itself into the containers of the component,
+// following the servicebinding.io workload projection
+type NativeBinding struct {
+	// Name is the name of the binding
+	Name string
+	// Directory is the name of the directory of the binding in $SERVICE_BINDING_ROOT
+	Directory string
+	// Workload is the name of the Deployment of the component
+	Workload string
+	// Containers are the names of the containers the binding is projected into, all the containers if empty
+	Containers []string
+	// Service is the Secret, ConfigMap or Service bound
+	Service specApi.ServiceBindingServiceReference
+	// Secret is the Secret bound along with a Service
+	Secret string
+	// Component is the component bound, whose endpoint is resolved when the binding is projected
+	Component string
+	// Type and Provider, if not empty, override the type and provider entries of the binding
+	Type     string
+	Provider string
+	// Env are the environment variables set from the entries of the binding
+	Env []specApi.EnvMapping
+	// Entries are the entries of the binding, read from the bound resources
+	Entries map[string]string
+}
+
+// IsNativeBinding returns true if the resource is a ServiceBinding projected by odo itself
+func IsNativeBinding(u unstructured.Unstructured) bool {
+	return u.GroupVersionKind() == specApi.GroupVersion.WithKind("ServiceBinding") &&
+		u.GetAnnotations()[NativeBindingAnnotation] == NativeBindingMode
+}
+
+// SecretName returns the name of the Secret generated by odo to project the binding into the Deployment
+func (o NativeBinding) SecretName() string {
+	return fmt.Sprintf("%s-%s", o.Workload, o.Name)
+}
+
+// VolumeName returns the name of the volume projecting the binding into the containers
+func (o NativeBinding) VolumeName() string {
+	name := "binding-" + o.Name
+	if len(name) > 63 {
+		name = strings.TrimSuffix(name[:63], "-")
+	}
+	return name
+}
+
+// Files returns the paths of the files of the binding, relative to $SERVICE_BINDING_ROOT
+func (o NativeBinding) Files() []string {
+	files := make([]string, 0, len(o.Entries))
+	for key := range o.Entries {
+		files = append(files, path.Join("${"+ServiceBindingRootEnv+"}", o.Directory, key))
+	}
+	sort.Strings(files)
+	return files
+}
+
+// GetNativeBindings returns the native bindings of the devfile, with their entries read from the resources bound.
+// The Secrets, ConfigMaps and Services are searched in the Kubernetes components of the devfile first,
+// then in the current namespace if kubeClient is not nil.
+func GetNativeBindings(devfileObj parser.DevfileObj, context string, kubeClient kclient.ClientInterface) ([]NativeBinding, error) {
+	resources, err := getDevfileResources(devfileObj, context)
+	if err != nil {
+		return nil, err
+	}
+	var result []NativeBinding
+	for _, u := range resources {
+		if !IsNativeBinding(u) {
+			continue
+		}
+		binding, err := nativeBindingFromResource(u)
+		if err != nil {
+			return nil, err
+		}
+		binding.Entries, err = binding.readEntries(resources, kubeClient)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, binding)
+	}
+	return result, nil
+}
+
+// WithoutNativeBindings returns the Kubernetes components of the devfile which do not define native bindings,
+// as the native bindings are not created on the cluster
+func WithoutNativeBindings(devfileObj parser.DevfileObj, components []devfilev1alpha2.Component, context string) ([]devfilev1alpha2.Component, error) {
+	result := make([]devfilev1alpha2.Component, 0, len(components))
+	for _, component := range components {
+		resources, err := libdevfile.GetK8sComponentAsUnstructuredList(devfileObj, component.Name, context, devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, err
+		}
+		native := len(resources) > 0
+		for _, u := range resources {
+			native = native && IsNativeBinding(u)
+		}
+		if !native {
+			result = append(result, component)
+		}
+	}
+	return result, nil
+}
+
+// ProjectNativeBinding projects the binding into the containers, following the servicebinding.io workload projection:
+// the volume is mounted in the $SERVICE_BINDING_ROOT/<directory> directory of the containers, SERVICE_BINDING_ROOT being
+// set to /bindings when the container does not define it, and the environment variables of the binding are set.
+// The environment variables reference the entries of the Secret secretName, or contain the values of the entries if secretName is empty.
+// It returns the volume to add to the pod.
+func ProjectNativeBinding(containers []corev1.Container, binding NativeBinding, source corev1.VolumeSource, secretName string) corev1.Volume {
+	volume := corev1.Volume{
+		Name:         binding.VolumeName(),
+		VolumeSource: source,
+	}
+	for i := range containers {
+		if len(binding.Containers) > 0 && !contains(binding.Containers, containers[i].Name) {
+			continue
+		}
+		root := DefaultServiceBindingRoot
+		if rootEnv := findEnv(containers[i].Env, ServiceBindingRootEnv); rootEnv == nil {
+			containers[i].Env = append(containers[i].Env, corev1.EnvVar{Name: ServiceBindingRootEnv, Value: root})
+		} else if rootEnv.Value != "" {
+			root = rootEnv.Value
+		}
+		containers[i].VolumeMounts = append(containers[i].VolumeMounts, corev1.VolumeMount{
+			Name:      volume.Name,
+			MountPath: path.Join(root, binding.Directory),
+			ReadOnly:  true,
+		})
+		for _, env := range binding.Env {
+			if findEnv(containers[i].Env, env.Name) != nil {
+				continue
+			}
+			if secretName != "" {
+				containers[i].Env = append(containers[i].Env, corev1.EnvVar{
+					Name: env.Name,
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+							Key:                  env.Key,
+							Optional:             pointer.BoolPtr(true),
+						},
+					},
+				})
+				continue
+			}
+			if value, ok := binding.Entries[env.Key]; ok {
+				containers[i].Env = append(containers[i].Env, corev1.EnvVar{Name: env.Name, Value: value})
+			}
+		}
+	}
+	return volume
+}
+
+// newNativeBindingObject returns the ServiceBinding resource describing the native binding in the devfile
+func newNativeBindingObject(binding NativeBinding) specApi.ServiceBinding {
+	annotations := map[string]string{NativeBindingAnnotation: NativeBindingMode}
+	if binding.Secret != "" {
+		annotations[NativeBindingSecretAnnotation] = binding.Secret
+	}
+	if binding.Component != "" {
+		annotations[ComponentBindingAnnotation] = binding.Component
+	}
+	sb := specApi.ServiceBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: specApi.GroupVersion.String(),
+			Kind:       "ServiceBinding",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        binding.Name,
+			Annotations: annotations,
+		},
+		Spec: specApi.ServiceBindingSpec{
+			Type:     binding.Type,
+			Provider: binding.Provider,
+			Workload: specApi.ServiceBindingWorkloadReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "Deployment",
+				Name:       binding.Workload,
+			},
+			Service: binding.Service,
+			Env:     binding.Env,
+		},
+	}
+	if binding.Directory != binding.Name {
+		sb.Spec.Name = binding.Directory
+	}
+	return sb
+}
+
+// nativeBindingFromResource returns the native binding defined by the ServiceBinding resource
+func nativeBindingFromResource(u unstructured.Unstructured) (NativeBinding, error) {
+	var sb specApi.ServiceBinding
+	err := kclient.ConvertUnstructuredToResource(u, &sb)
+	if err != nil {
+		return NativeBinding{}, err
+	}
+	if sb.Spec.Service.APIVersion != corev1.SchemeGroupVersion.String() || !contains(nativeBindingKinds, sb.Spec.Service.Kind) {
+		return NativeBinding{}, fmt.Errorf("the native binding %q can only bind a %s, not a %s", sb.Name,
+			strings.Join(nativeBindingKinds, ", "), sb.Spec.Service.Kind)
+	}
+	binding := NativeBinding{
+		Name:       sb.Name,
+		Directory:  sb.Spec.Name,
+		Workload:   sb.Spec.Workload.Name,
+		Containers: sb.Spec.Workload.Containers,
+		Service:    sb.Spec.Service,
+		Secret:     sb.Annotations[NativeBindingSecretAnnotation],
+		Component:  sb.Annotations[ComponentBindingAnnotation],
+		Type:       sb.Spec.Type,
+		Provider:   sb.Spec.Provider,
+		Env:        sb.Spec.Env,
+	}
+	if binding.Directory == "" {
+		binding.Directory = binding.Name
+	}
+	return binding, nil
+}
+
+// apiServiceBinding returns the description of the native binding, with the status of its projection
+func (o NativeBinding) apiServiceBinding(status *api.ServiceBindingStatus) api.ServiceBinding {
+	services := []corev1.ObjectReference{{
+		APIVersion: o.Service.APIVersion,
+		Kind:       o.Service.Kind,
+		Name:       o.Service.Name,
+	}}
+	if o.Secret != "" {
+		services = append(services, corev1.ObjectReference{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Secret",
+			Name:       o.Secret,
+		})
+	}
+	return api.ServiceBinding{
+		Name: o.Name,
+		Spec: api.ServiceBindingSpec{
+			Application: corev1.ObjectReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "Deployment",
+				Name:       o.Workload,
+			},
+			Services:    services,
+			BindAsFiles: true,
+			Native:      true,
+		},
+		Status: status,
+	}
+}
+
+// readEntries returns the entries of the binding, read from the resources of the devfile or from the cluster
+func (o NativeBinding) readEntries(resources []unstructured.Unstructured, kubeClient kclient.ClientInterface) (map[string]string, error) {
+	entries := map[string]string{}
+	switch {
+	case o.Component != "":
+		// the entries of the bindings to components are set by ResolveComponentBindings
+	case o.Service.Kind == "Secret":
+		if err := readSecret(entries, o.Service.Name, resources, kubeClient); err != nil {
+			return nil, fmt.Errorf("unable to read the entries of the binding %q: %w", o.Name, err)
+		}
+	case o.Service.Kind == "ConfigMap":
+		var configMap *corev1.ConfigMap
+		if u := findResource(resources, "ConfigMap", o.Service.Name); u != nil {
+			configMap = &corev1.ConfigMap{}
+			if err := kclient.ConvertUnstructuredToResource(*u, configMap); err != nil {
+				return nil, err
+			}
+		} else if kubeClient != nil {
+			var err error
+			if configMap, err = kubeClient.GetConfigMap(o.Service.Name, kubeClient.GetCurrentNamespace()); err != nil && !kerrors.IsNotFound(err) {
+				return nil, err
+			}
+		}
+		if configMap == nil {
+			return nil, fmt.Errorf("unable to read the entries of the binding %q: %w", o.Name, notFoundError("ConfigMap", o.Service.Name, kubeClient))
+		}
+		for key, value := range configMap.Data {
+			entries[key] = value
+		}
+		for key, value := range configMap.BinaryData {
+			entries[key] = string(value)
+		}
+	case o.Service.Kind == "Service":
+		var service *corev1.Service
+		if u := findResource(resources, "Service", o.Service.Name); u != nil {
+			service = &corev1.Service{}
+			if err := kclient.ConvertUnstructuredToResource(*u, service); err != nil {
+				return nil, err
+			}
+		} else if kubeClient != nil {
+			var err error
+			if service, err = kubeClient.GetService(o.Service.Name); err != nil && !kerrors.IsNotFound(err) {
+				return nil, err
+			}
+		}
+		if service == nil {
+			return nil, fmt.Errorf("unable to read the entries of the binding %q: %w", o.Name, notFoundError("Service", o.Service.Name, kubeClient))
+		}
+		entries["host"] = service.Name
+		if len(service.Spec.Ports) > 0 {
+			entries["port"] = strconv.Itoa(int(service.Spec.Ports[0].Port))
+		}
+		if o.Secret != "" {
+			if err := readSecret(entries, o.Secret, resources, kubeClient); err != nil {
+				return nil, fmt.Errorf("unable to read the entries of the binding %q: %w", o.Name, err)
+			}
+		}
+	}
+	if o.Type != "" {
+		entries["type"] = o.Type
+	}
+	if o.Provider != "" {
+		entries["provider"] = o.Provider
+	}
+	return entries, nil
+}
+
+// readSecret adds the entries of the Secret to entries
+func readSecret(entries map[string]string, name string, resources []unstructured.Unstructured, kubeClient kclient.ClientInterface) error {
+	var secret *corev1.Secret
+	if u := findResource(resources, "Secret", name); u != nil {
+		secret = &corev1.Secret{}
+		if err := kclient.ConvertUnstructuredToResource(*u, secret); err != nil {
+			return err
+		}
+	} else if kubeClient != nil {
+		var err error
+		if secret, err = kubeClient.GetSecret(name, kubeClient.GetCurrentNamespace()); err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+	if secret == nil {
+		return notFoundError("Secret", name, kubeClient)
+	}
+	for key, value := range secret.Data {
+		entries[key] = string(value)
+	}
+	for key, value := range secret.StringData {
+		entries[key] = value
+	}
+	return nil
+}
+
+func notFoundError(kind, name string, kubeClient kclient.ClientInterface) error {
+	if kubeClient == nil {
+		return fmt.Errorf("the %s %q is not defined in the devfile", kind, name)
+	}
+	return fmt.Errorf("the %s %q is not defined in the devfile nor in the namespace %q", kind, name, kubeClient.GetCurrentNamespace())
+}
+
+// getDevfileResources returns the resources defined by the Kubernetes components of the devfile
+func getDevfileResources(devfileObj parser.DevfileObj, context string) ([]unstructured.Unstructured, error) {
+	components, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{
+			ComponentType: devfilev1alpha2.KubernetesComponentType,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var resources []unstructured.Unstructured
+	for _, component := range components {
+		list, err := libdevfile.GetK8sComponentAsUnstructuredList(devfileObj, component.Name, context, devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, list...)
+	}
+	return resources, nil
+}
+
+func findResource(resources []unstructured.Unstructured, kind, name string) *unstructured.Unstructured {
+	for i := range resources {
+		gvk := resources[i].GroupVersionKind()
+		if gvk.Group == "" && gvk.Kind == kind && resources[i].GetName() == name {
+			return &resources[i]
+		}
+	}
+	return nil
+}
+
+// envName returns the name of the environment variable of the entry, following the pre-defined naming strategy
+func envName(key, namingStrategy string) string {
+	name := invalidEnvChars.ReplaceAllString(key, "_")
+	switch namingStrategy {
+	case "none":
+		return name
+	case "lowercase":
+		return strings.ToLower(name)
+	default:
+		return strings.ToUpper(name)
+	}
+}
+
+func findEnv(env []corev1.EnvVar, name string) *corev1.EnvVar {
+	for i := range env {
+		if env[i].Name == name {
+			return &env[i]
+		}
+	}
+	return nil
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
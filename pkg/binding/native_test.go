@@ -0,0 +1,449 @@
+package binding
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/devfile/library/pkg/devfile/parser/data"
+	"github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+
+	specApi "github.com/redhat-developer/service-binding-operator/apis/spec/v1alpha3"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odoTestingUtil "github.com/redhat-developer/odo/pkg/testingutil"
+)
+
+const (
+	dbSecretManifest = `apiVersion: v1
+kind: Secret
+metadata:
+  name: db-credentials
+stringData:
+  username: user
+  password: secret
+`
+	dbBindingManifest = `apiVersion: servicebinding.io/v1alpha3
+kind: ServiceBinding
+metadata:
+  name: db
+  annotations:
+    odo.dev/binding-mode: native
+spec:
+  name: postgres
+  type: postgresql
+  workload:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: my-app-app
+  service:
+    apiVersion: v1
+    kind: Secret
+    name: db-credentials
+  env:
+    - name: DB_USER
+      key: username
+`
+	settingsBindingManifest = `apiVersion: servicebinding.io/v1alpha3
+kind: ServiceBinding
+metadata:
+  name: settings
+  annotations:
+    odo.dev/binding-mode: native
+spec:
+  workload:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: my-app-app
+  service:
+    apiVersion: v1
+    kind: ConfigMap
+    name: settings
+`
+	cacheBindingManifest = `apiVersion: servicebinding.io/v1alpha3
+kind: ServiceBinding
+metadata:
+  name: cache
+  annotations:
+    odo.dev/binding-mode: native
+    odo.dev/binding-secret: db-credentials
+spec:
+  workload:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: my-app-app
+  service:
+    apiVersion: v1
+    kind: Service
+    name: redis
+`
+	redisServiceManifest = `apiVersion: v1
+kind: Service
+metadata:
+  name: redis
+spec:
+  ports:
+    - port: 6379
+`
+)
+
+func getDevfileWithManifests(t *testing.T, manifests map[string]string) parser.DevfileObj {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, manifest := range manifests {
+		err = devfileData.AddComponents([]v1alpha2.Component{{
+			Name: name,
+			ComponentUnion: v1alpha2.ComponentUnion{
+				Kubernetes: &v1alpha2.KubernetesComponent{
+					K8sLikeComponent: v1alpha2.K8sLikeComponent{
+						K8sLikeComponentLocation: v1alpha2.K8sLikeComponentLocation{
+							Inlined: manifest,
+						},
+					},
+				},
+			},
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return parser.DevfileObj{Data: devfileData}
+}
+
+func TestGetNativeBindings(t *testing.T) {
+	tests := []struct {
+		name       string
+		manifests  map[string]string
+		kubeClient func(ctrl *gomock.Controller) kclient.ClientInterface
+		want       []NativeBinding
+		wantErr    string
+	}{
+		{
+			name: "Secret defined in the devfile",
+			manifests: map[string]string{
+				"db-credentials": dbSecretManifest,
+				"db":             dbBindingManifest,
+			},
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				return nil
+			},
+			want: []NativeBinding{{
+				Name:      "db",
+				Directory: "postgres",
+				Workload:  "my-app-app",
+				Service:   specApi.ServiceBindingServiceReference{APIVersion: "v1", Kind: "Secret", Name: "db-credentials"},
+				Type:      "postgresql",
+				Env:       []specApi.EnvMapping{{Name: "DB_USER", Key: "username"}},
+				Entries:   map[string]string{"username": "user", "password": "secret", "type": "postgresql"},
+			}},
+		},
+		{
+			name: "ConfigMap read from the cluster",
+			manifests: map[string]string{
+				"settings": settingsBindingManifest,
+			},
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetCurrentNamespace().Return("my-ns").AnyTimes()
+				client.EXPECT().GetConfigMap("settings", "my-ns").Return(&corev1.ConfigMap{
+					Data:       map[string]string{"level": "debug"},
+					BinaryData: map[string][]byte{"raw": []byte("data")},
+				}, nil)
+				return client
+			},
+			want: []NativeBinding{{
+				Name:      "settings",
+				Directory: "settings",
+				Workload:  "my-app-app",
+				Service:   specApi.ServiceBindingServiceReference{APIVersion: "v1", Kind: "ConfigMap", Name: "settings"},
+				Entries:   map[string]string{"level": "debug", "raw": "data"},
+			}},
+		},
+		{
+			name: "Service along with a Secret",
+			manifests: map[string]string{
+				"cache":          cacheBindingManifest,
+				"redis":          redisServiceManifest,
+				"db-credentials": dbSecretManifest,
+			},
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				return nil
+			},
+			want: []NativeBinding{{
+				Name:      "cache",
+				Directory: "cache",
+				Workload:  "my-app-app",
+				Service:   specApi.ServiceBindingServiceReference{APIVersion: "v1", Kind: "Service", Name: "redis"},
+				Secret:    "db-credentials",
+				Entries:   map[string]string{"host": "redis", "port": "6379", "username": "user", "password": "secret"},
+			}},
+		},
+		{
+			name: "Secret not found in the devfile without cluster",
+			manifests: map[string]string{
+				"db": dbBindingManifest,
+			},
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				return nil
+			},
+			wantErr: `the Secret "db-credentials" is not defined in the devfile`,
+		},
+		{
+			name: "Secret not found in the devfile nor in the cluster",
+			manifests: map[string]string{
+				"db": dbBindingManifest,
+			},
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetCurrentNamespace().Return("my-ns").AnyTimes()
+				client.EXPECT().GetSecret("db-credentials", "my-ns").
+					Return(nil, kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "db-credentials"))
+				return client
+			},
+			wantErr: `the Secret "db-credentials" is not defined in the devfile nor in the namespace "my-ns"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			got, err := GetNativeBindings(getDevfileWithManifests(t, tt.manifests), "", tt.kubeClient(ctrl))
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != "unable to read the entries of the binding \"db\": "+tt.wantErr {
+					t.Fatalf("GetNativeBindings() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetNativeBindings() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetNativeBindings() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectNativeBinding(t *testing.T) {
+	nativeBinding := NativeBinding{
+		Name:       "db",
+		Directory:  "postgres",
+		Containers: []string{"runtime", "tools"},
+		Env:        []specApi.EnvMapping{{Name: "DB_USER", Key: "username"}, {Name: "DB_MISSING", Key: "missing"}},
+		Entries:    map[string]string{"username": "user"},
+	}
+	source := corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "my-app-app-db"}}
+	tests := []struct {
+		name       string
+		secretName string
+		want       []corev1.Container
+	}{
+		{
+			name:       "from the Secret generated by odo",
+			secretName: "my-app-app-db",
+			want: []corev1.Container{
+				{
+					Name: "runtime",
+					Env: []corev1.EnvVar{
+						{Name: "SERVICE_BINDING_ROOT", Value: "/bindings"},
+						{Name: "DB_USER", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "my-app-app-db"}, Key: "username", Optional: pointer.BoolPtr(true),
+						}}},
+						{Name: "DB_MISSING", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "my-app-app-db"}, Key: "missing", Optional: pointer.BoolPtr(true),
+						}}},
+					},
+					VolumeMounts: []corev1.VolumeMount{{Name: "binding-db", MountPath: "/bindings/postgres", ReadOnly: true}},
+				},
+				{
+					Name: "tools",
+					Env: []corev1.EnvVar{
+						{Name: "SERVICE_BINDING_ROOT", Value: "/opt/bindings"},
+						{Name: "DB_USER", Value: "admin"},
+						{Name: "DB_MISSING", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "my-app-app-db"}, Key: "missing", Optional: pointer.BoolPtr(true),
+						}}},
+					},
+					VolumeMounts: []corev1.VolumeMount{{Name: "binding-db", MountPath: "/opt/bindings/postgres", ReadOnly: true}},
+				},
+				{Name: "other"},
+			},
+		},
+		{
+			name: "with the values of the entries",
+			want: []corev1.Container{
+				{
+					Name: "runtime",
+					Env: []corev1.EnvVar{
+						{Name: "SERVICE_BINDING_ROOT", Value: "/bindings"},
+						{Name: "DB_USER", Value: "user"},
+					},
+					VolumeMounts: []corev1.VolumeMount{{Name: "binding-db", MountPath: "/bindings/postgres", ReadOnly: true}},
+				},
+				{
+					Name: "tools",
+					Env: []corev1.EnvVar{
+						{Name: "SERVICE_BINDING_ROOT", Value: "/opt/bindings"},
+						{Name: "DB_USER", Value: "admin"},
+					},
+					VolumeMounts: []corev1.VolumeMount{{Name: "binding-db", MountPath: "/opt/bindings/postgres", ReadOnly: true}},
+				},
+				{Name: "other"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			containers := []corev1.Container{
+				{Name: "runtime"},
+				{Name: "tools", Env: []corev1.EnvVar{
+					{Name: "SERVICE_BINDING_ROOT", Value: "/opt/bindings"},
+					{Name: "DB_USER", Value: "admin"},
+				}},
+				{Name: "other"},
+			}
+			volume := ProjectNativeBinding(containers, nativeBinding, source, tt.secretName)
+			if diff := cmp.Diff(corev1.Volume{Name: "binding-db", VolumeSource: source}, volume); diff != "" {
+				t.Errorf("ProjectNativeBinding() volume mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.want, containers); diff != "" {
+				t.Errorf("ProjectNativeBinding() containers mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBindingClient_ValidateAddBinding_Native(t *testing.T) {
+	tests := []struct {
+		name        string
+		flags       map[string]string
+		withDevfile bool
+		wantErr     string
+	}{
+		{
+			name:        "Secret",
+			flags:       map[string]string{"native": "true", "service": "db-credentials", "name": "db"},
+			withDevfile: true,
+		},
+		{
+			name:        "Service along with a Secret",
+			flags:       map[string]string{"native": "true", "service": "redis/Service", "secret": "redis-auth", "name": "cache", "naming-strategy": "lowercase"},
+			withDevfile: true,
+		},
+		{
+			name:    "without devfile",
+			flags:   map[string]string{"native": "true", "service": "db-credentials", "name": "db"},
+			wantErr: "--native can only be used from a directory containing a Devfile",
+		},
+		{
+			name:        "custom resource",
+			flags:       map[string]string{"native": "true", "service": "db/Cluster.postgresql.k8s.enterprisedb.io", "name": "db"},
+			withDevfile: true,
+			wantErr:     "a native binding can only bind a Secret, ConfigMap, Service, not a Cluster.postgresql.k8s.enterprisedb.io",
+		},
+		{
+			name:        "Secret along with a Secret",
+			flags:       map[string]string{"native": "true", "service": "db-credentials", "secret": "other", "name": "db"},
+			withDevfile: true,
+			wantErr:     "--secret can only be used when binding a Service",
+		},
+		{
+			name:        "service namespace",
+			flags:       map[string]string{"native": "true", "service": "db-credentials", "service-namespace": "other", "name": "db"},
+			withDevfile: true,
+			wantErr:     "--service-namespace cannot be used with --native",
+		},
+		{
+			name:        "custom naming strategy",
+			flags:       map[string]string{"native": "true", "service": "db-credentials", "name": "db", "naming-strategy": "{{ .name }}"},
+			withDevfile: true,
+			wantErr:     "only the pre-defined naming strategies",
+		},
+		{
+			name:        "type without native",
+			flags:       map[string]string{"service": "db/Cluster", "type": "postgresql", "name": "db"},
+			withDevfile: true,
+			wantErr:     "--type can only be used with --native",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewBindingClient(nil, nil)
+			err := o.ValidateAddBinding(tt.flags, tt.withDevfile)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateAddBinding() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateAddBinding() error = %v, want an error starting with %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBindingClient_AddNativeBindingToDevfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := kclient.NewMockClientInterface(ctrl)
+	client.EXPECT().GetCurrentNamespace().Return("my-ns").AnyTimes()
+	client.EXPECT().GetService("redis").Return(&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "redis"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 6379}}},
+	}, nil).Times(2)
+	client.EXPECT().GetSecret("redis-auth", "my-ns").Return(&corev1.Secret{
+		Data: map[string][]byte{"password": []byte("secret")},
+	}, nil).Times(2)
+
+	o := NewBindingClient(nil, client)
+	obj := odoTestingUtil.GetTestDevfileObj(filesystem.NewFakeFs())
+	flags := map[string]string{
+		"native":          "true",
+		"service":         "redis/Service",
+		"secret":          "redis-auth",
+		"name":            "cache",
+		"type":            "redis",
+		"bind-as-files":   "false",
+		"naming-strategy": "uppercase",
+	}
+	got, added, err := o.AddNativeBindingToDevfile("my-app", flags, obj, "")
+	if err != nil {
+		t.Fatalf("AddNativeBindingToDevfile() unexpected error: %v", err)
+	}
+	want := NativeBinding{
+		Name:      "cache",
+		Directory: "cache",
+		Workload:  "my-app-app",
+		Service:   specApi.ServiceBindingServiceReference{APIVersion: "v1", Kind: "Service", Name: "redis"},
+		Secret:    "redis-auth",
+		Type:      "redis",
+		Env: []specApi.EnvMapping{
+			{Name: "HOST", Key: "host"},
+			{Name: "PASSWORD", Key: "password"},
+			{Name: "PORT", Key: "port"},
+			{Name: "TYPE", Key: "type"},
+		},
+		Entries: map[string]string{"host": "redis", "port": "6379", "password": "secret", "type": "redis"},
+	}
+	if diff := cmp.Diff(want, added); diff != "" {
+		t.Errorf("AddNativeBindingToDevfile() binding mismatch (-want +got):\n%s", diff)
+	}
+
+	// the binding is read back from the devfile
+	bindings, err := GetNativeBindings(got, "", client)
+	if err != nil {
+		t.Fatalf("GetNativeBindings() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]NativeBinding{want}, bindings); diff != "" {
+		t.Errorf("GetNativeBindings() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,104 @@
+package binding
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+const (
+	// MaskedValue replaces the values of the bindings when they are not revealed
+	MaskedValue = "********"
+
+	// markers delimiting the values in the output of the script reading them in the container
+	valuesMarker          = "@@odo-binding-value@@"
+	valuesEndMarker       = "@@odo-binding-value-end@@"
+	valuesMissingMarker   = "@@odo-binding-missing@@"
+	serviceBindingRootVar = "${" + ServiceBindingRootEnv + "}"
+)
+
+// GetBindingValues reads, in the container containerName of the pod podName, the values of the files and
+// environment variables listed in the status, and sets them in the status.
+// The values are masked unless reveal is true, and the files and environment variables
+// not found in the container are reported as missing.
+// The command is executed with the platform client directly, so that the values
+// are neither logged nor part of the errors returned
+func GetBindingValues(platformClient platform.Client, podName string, containerName string, status *api.ServiceBindingStatus, reveal bool) error {
+	if status == nil {
+		return nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	script := getValuesScript(status.BindingFiles, status.BindingEnvVars)
+	err := platformClient.ExecCMDInContainer(containerName, podName, []string{"sh", "-c", script}, &stdout, &stderr, nil, false)
+	if err != nil {
+		return fmt.Errorf("unable to read the values of the bindings in the container %q: %w", containerName, err)
+	}
+	found := parseValuesOutput(strings.Split(strings.TrimSuffix(stdout.String(), "\n"), "\n"))
+
+	values := make([]api.BindingValue, 0, len(status.BindingFiles)+len(status.BindingEnvVars))
+	for i, name := range append(append([]string{}, status.BindingFiles...), status.BindingEnvVars...) {
+		value, ok := found[i]
+		values = append(values, newBindingValue(name, value, ok, reveal))
+	}
+	status.ValuesContainer = containerName
+	status.Values = values
+	return nil
+}
+
+func newBindingValue(name string, value string, found bool, reveal bool) api.BindingValue {
+	switch {
+	case !found:
+		return api.BindingValue{Name: name, Missing: true}
+	case reveal:
+		return api.BindingValue{Name: name, Value: value}
+	default:
+		return api.BindingValue{Name: name, Value: MaskedValue, Masked: true}
+	}
+}
+
+// getValuesScript returns a shell script displaying the content of each file, then the value of each
+// environment variable, identified by their index. Only the declared environment variables are read.
+// The ${SERVICE_BINDING_ROOT} prefix of the files is expanded by the shell,
+// and the trailing new lines of the values are removed
+func getValuesScript(files []string, envVars []string) string {
+	var script strings.Builder
+	for i, file := range files {
+		quoted := shellQuote(strings.TrimPrefix(file, serviceBindingRootVar))
+		if strings.HasPrefix(file, serviceBindingRootVar) {
+			quoted = `"$` + ServiceBindingRootEnv + `"` + quoted
+		}
+		fmt.Fprintf(&script, `if [ -f %[1]s ]; then echo %[2]s %[3]d; printf '%%s\n' "$(cat %[1]s)"; echo %[4]s; else echo %[5]s %[3]d; fi`+"\n",
+			quoted, valuesMarker, i, valuesEndMarker, valuesMissingMarker)
+	}
+	for i, name := range envVars {
+		fmt.Fprintf(&script, `if v=$(printenv %[1]s); then echo %[2]s %[3]d; printf '%%s\n' "$v"; echo %[4]s; else echo %[5]s %[3]d; fi`+"\n",
+			shellQuote(name), valuesMarker, len(files)+i, valuesEndMarker, valuesMissingMarker)
+	}
+	return script.String()
+}
+
+// parseValuesOutput parses the output of the script returned by getValuesScript,
+// and returns the values found, by index
+func parseValuesOutput(lines []string) map[int]string {
+	values := map[int]string{}
+	for i := 0; i < len(lines); i++ {
+		if !strings.HasPrefix(lines[i], valuesMarker+" ") {
+			continue
+		}
+		index, err := strconv.Atoi(strings.TrimPrefix(lines[i], valuesMarker+" "))
+		if err != nil {
+			continue
+		}
+		var content []string
+		for i++; i < len(lines) && lines[i] != valuesEndMarker; i++ {
+			content = append(content, lines[i])
+		}
+		values[index] = strings.Join(content, "\n")
+	}
+	return values
+}
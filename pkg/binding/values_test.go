@@ -0,0 +1,125 @@
+package binding
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+func TestGetBindingValues(t *testing.T) {
+	output := strings.Join([]string{
+		"@@odo-binding-value@@ 0",
+		"secret",
+		"@@odo-binding-value-end@@",
+		"@@odo-binding-missing@@ 1",
+		"@@odo-binding-value@@ 2",
+		"line1",
+		"",
+		"line3",
+		"@@odo-binding-value-end@@",
+		"@@odo-binding-value@@ 3",
+		"user",
+		"@@odo-binding-value-end@@",
+		"@@odo-binding-value@@ 4",
+		"-----BEGIN-----",
+		"abc",
+		"-----END-----",
+		"@@odo-binding-value-end@@",
+		"@@odo-binding-missing@@ 5",
+	}, "\n") + "\n"
+	status := func() *api.ServiceBindingStatus {
+		return &api.ServiceBindingStatus{
+			BindingFiles:   []string{"${SERVICE_BINDING_ROOT}/db/password", "${SERVICE_BINDING_ROOT}/db/host", "${SERVICE_BINDING_ROOT}/db/ca.crt"},
+			BindingEnvVars: []string{"DB_USER", "CERT", "DB_HOST"},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		reveal bool
+		want   []api.BindingValue
+	}{
+		{
+			name: "values masked",
+			want: []api.BindingValue{
+				{Name: "${SERVICE_BINDING_ROOT}/db/password", Value: MaskedValue, Masked: true},
+				{Name: "${SERVICE_BINDING_ROOT}/db/host", Missing: true},
+				{Name: "${SERVICE_BINDING_ROOT}/db/ca.crt", Value: MaskedValue, Masked: true},
+				{Name: "DB_USER", Value: MaskedValue, Masked: true},
+				{Name: "CERT", Value: MaskedValue, Masked: true},
+				{Name: "DB_HOST", Missing: true},
+			},
+		},
+		{
+			name:   "values revealed",
+			reveal: true,
+			want: []api.BindingValue{
+				{Name: "${SERVICE_BINDING_ROOT}/db/password", Value: "secret"},
+				{Name: "${SERVICE_BINDING_ROOT}/db/host", Missing: true},
+				{Name: "${SERVICE_BINDING_ROOT}/db/ca.crt", Value: "line1\n\nline3"},
+				{Name: "DB_USER", Value: "user"},
+				{Name: "CERT", Value: "-----BEGIN-----\nabc\n-----END-----"},
+				{Name: "DB_HOST", Missing: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			kubeClient := kclient.NewMockClientInterface(ctrl)
+			kubeClient.EXPECT().ExecCMDInContainer("runtime", "my-app-app-5d8f", gomock.Any(), gomock.Any(), gomock.Any(), nil, false).
+				DoAndReturn(func(_, _ string, command []string, stdout io.Writer, _ io.Writer, _ io.Reader, _ bool) error {
+					script := command[len(command)-1]
+					if !strings.Contains(script, `"$SERVICE_BINDING_ROOT"'/db/ca.crt'`) {
+						t.Errorf("the file ca.crt is not read by the script:\n%s", script)
+					}
+					if !strings.Contains(script, "printenv 'DB_USER'") {
+						t.Errorf("the environment variable DB_USER is not read by the script:\n%s", script)
+					}
+					if strings.Contains(script, "env;") {
+						t.Errorf("the script reads the whole environment:\n%s", script)
+					}
+					_, err := io.WriteString(stdout, output)
+					return err
+				})
+
+			got := status()
+			err := GetBindingValues(kubeClient, "my-app-app-5d8f", "runtime", got, tt.reveal)
+			if err != nil {
+				t.Fatalf("GetBindingValues() unexpected error: %v", err)
+			}
+			if got.ValuesContainer != "runtime" {
+				t.Errorf("GetBindingValues() container = %q, want %q", got.ValuesContainer, "runtime")
+			}
+			if diff := cmp.Diff(tt.want, got.Values); diff != "" {
+				t.Errorf("GetBindingValues() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetBindingValues_ErrorWithoutValues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	kubeClient.EXPECT().ExecCMDInContainer("runtime", "my-app-app-5d8f", gomock.Any(), gomock.Any(), gomock.Any(), nil, false).
+		DoAndReturn(func(_, _ string, _ []string, stdout io.Writer, _ io.Writer, _ io.Reader, _ bool) error {
+			_, _ = io.WriteString(stdout, "@@odo-binding-value@@ 0\nsecret\n")
+			return errors.New("error while streaming command")
+		})
+
+	status := &api.ServiceBindingStatus{BindingEnvVars: []string{"DB_PASSWORD"}}
+	err := GetBindingValues(kubeClient, "my-app-app-5d8f", "runtime", status, true)
+	if err == nil {
+		t.Fatal("GetBindingValues() expected an error")
+	}
+	if strings.Contains(err.Error(), "secret") {
+		t.Errorf("GetBindingValues() error contains the value of the binding: %v", err)
+	}
+}
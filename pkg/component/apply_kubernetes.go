@@ -1,13 +1,16 @@
 package component
 
 import (
+	"context"
 	"fmt"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/pkg/devfile/parser"
 	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/klog"
 
+	"github.com/redhat-developer/odo/pkg/devfile/image"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
@@ -16,13 +19,16 @@ import (
 )
 
 // ApplyKubernetes contains the logic to create the k8s resources defined by the `apply` command
+// ctx: the context, containing the options used to build the images
 // mode(Dev, Deploy): the mode in which the resources are deployed
 // appName: application name
 // devfile: the devfile object
 // kubernetes: the kubernetes devfile component to be deployed
 // kubeClient: Kubernetes client to be used to deploy the resource
 // path: path to the context directory
+// It returns the resources applied, before the labels and annotations are added
 func ApplyKubernetes(
+	ctx context.Context,
 	mode string,
 	appName string,
 	componentName string,
@@ -30,12 +36,12 @@ func ApplyKubernetes(
 	kubernetes devfilev1.Component,
 	kubeClient kclient.ClientInterface,
 	path string,
-) error {
+) ([]unstructured.Unstructured, error) {
 	// TODO: Use GetK8sComponentAsUnstructured here and pass it to ValidateResourcesExistInK8sComponent
 	// Validate if the GVRs represented by Kubernetes inlined components are supported by the underlying cluster
 	kind, err := ValidateResourcesExistInK8sComponent(kubeClient, devfile, kubernetes, path)
 	if err != nil {
-		return fmt.Errorf("%s: %w", kind, err)
+		return nil, fmt.Errorf("%s: %w", kind, err)
 	}
 
 	// Get the most common labels that's applicable to all resources being deployed.
@@ -53,15 +59,24 @@ func ApplyKubernetes(
 	// Get the Kubernetes component
 	uList, err := libdevfile.GetK8sComponentAsUnstructuredList(devfile, kubernetes.Name, path, devfilefs.DefaultFs{})
 	if err != nil {
-		return err
+		return nil, err
 	}
+	applied := make([]unstructured.Unstructured, 0, len(uList))
 	for _, u := range uList {
+		u := u
+		// Images loaded into a local cluster are not present in any registry, and must not be pulled
+		err = image.SetPullPolicyForLoadedImagesInResource(image.GetBuildOptions(ctx), devfile, &u)
+		if err != nil {
+			return nil, err
+		}
+
 		// Deploy the actual Kubernetes component and error out if there's an issue.
 		log.Sectionf("Deploying Kubernetes Component: %s", u.GetName())
 		err = service.PushKubernetesResource(kubeClient, u, labels, annotations, mode)
 		if err != nil {
-			return fmt.Errorf("failed to create service(s) associated with the component: %w", err)
+			return nil, fmt.Errorf("failed to create service(s) associated with the component: %w", err)
 		}
+		applied = append(applied, u)
 	}
-	return nil
+	return applied, nil
 }
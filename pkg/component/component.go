@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -12,6 +13,7 @@ import (
 	"github.com/devfile/library/pkg/devfile/parser"
 	"github.com/devfile/library/pkg/devfile/parser/data"
 	routev1 "github.com/openshift/api/route/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/platform"
@@ -104,7 +107,7 @@ func Log(platformClient platform.Client, componentName string, appName string, f
 
 	containerName := command.Exec.Component
 
-	return platformClient.GetPodLogs(pod.Name, containerName, follow)
+	return platformClient.GetPodLogs(pod.Name, containerName, platform.LogOptions{Follow: follow})
 }
 
 // ListAllClusterComponents returns a list of all "components" on a cluster
@@ -114,13 +117,23 @@ func Log(platformClient platform.Client, componentName string, appName string, f
 // `odo list`
 // that are both odo and non-odo components.
 func ListAllClusterComponents(client kclient.ClientInterface, namespace string) ([]api.ComponentAbstract, error) {
+	return listClusterComponents(client, namespace, "")
+}
+
+// listClusterComponents returns the list of components in namespace, built from the resources matching the selector
+func listClusterComponents(client kclient.ClientInterface, namespace string, selector string) ([]api.ComponentAbstract, error) {
 
 	// Get all the dynamic resources available
-	resourceList, err := client.GetAllResourcesFromSelector("", namespace)
+	resourceList, err := client.GetAllResourcesFromSelector(selector, namespace)
 	if err != nil {
 		return nil, fmt.Errorf("unable to list all dynamic resources required to find components: %w", err)
 	}
 
+	return getComponentsFromResources(resourceList), nil
+}
+
+// getComponentsFromResources returns the list of components built from the resources of a single namespace
+func getComponentsFromResources(resourceList []unstructured.Unstructured) []api.ComponentAbstract {
 	var components []api.ComponentAbstract
 
 	for _, resource := range resourceList {
@@ -174,11 +187,17 @@ func ListAllClusterComponents(client kclient.ClientInterface, namespace string)
 			ManagedByVersion: managedByVersion,
 			RunningOn:        commonflags.RunOnCluster,
 		}
+		if created := resource.GetCreationTimestamp(); !created.IsZero() {
+			component.CreatedAt = &created.Time
+		}
 		mode := odolabels.GetMode(labels)
 		componentFound := false
 		for v, otherCompo := range components {
 			if component.Name == otherCompo.Name {
 				componentFound = true
+				if component.CreatedAt != nil && (otherCompo.CreatedAt == nil || component.CreatedAt.Before(*otherCompo.CreatedAt)) {
+					components[v].CreatedAt = component.CreatedAt
+				}
 				if mode != "" {
 					if components[v].RunningIn == nil {
 						components[v].RunningIn = api.NewRunningModes()
@@ -204,7 +223,110 @@ func ListAllClusterComponents(client kclient.ClientInterface, namespace string)
 		}
 	}
 
-	return components, nil
+	return components
+}
+
+// ListAllOdoComponentsInAllNamespaces returns the components managed by odo running on podman
+// and on the cluster, in all the namespaces accessible to the user. Either client can be nil.
+// The namespaces the user is not allowed to access are ignored.
+func ListAllOdoComponentsInAllNamespaces(client kclient.ClientInterface, podmanClient podman.Client) ([]api.ComponentAbstract, error) {
+	var allComponents []api.ComponentAbstract
+
+	if client != nil {
+		components, err := listOdoClusterComponentsInAllNamespaces(client)
+		if err != nil {
+			return nil, err
+		}
+		allComponents = append(allComponents, components...)
+	}
+
+	if podmanClient != nil {
+		podmanComponents, err := podmanClient.ListAllComponents()
+		if err != nil {
+			return nil, err
+		}
+		for _, component := range podmanComponents {
+			if component.ManagedBy == "odo" {
+				allComponents = append(allComponents, component)
+			}
+		}
+	}
+
+	return allComponents, nil
+}
+
+// listOdoClusterComponentsInAllNamespaces returns the components managed by odo in all the namespaces accessible to the user.
+// The resources of all the namespaces are listed at once, or namespace by namespace when the user is not allowed to.
+func listOdoClusterComponentsInAllNamespaces(client kclient.ClientInterface) ([]api.ComponentAbstract, error) {
+	selector := odolabels.GetSelectorForAllComponents(true)
+
+	resourceList, err := client.GetAllResourcesFromSelectorInAllNamespaces(selector)
+	if err == nil {
+		byNamespace := make(map[string][]unstructured.Unstructured)
+		var namespaces []string
+		for _, resource := range resourceList {
+			ns := resource.GetNamespace()
+			if _, ok := byNamespace[ns]; !ok {
+				namespaces = append(namespaces, ns)
+			}
+			byNamespace[ns] = append(byNamespace[ns], resource)
+		}
+		sort.Strings(namespaces)
+		var result []api.ComponentAbstract
+		for _, ns := range namespaces {
+			result = append(result, withNamespace(getComponentsFromResources(byNamespace[ns]), ns)...)
+		}
+		return result, nil
+	}
+	if !kerrors.IsForbidden(err) {
+		return nil, fmt.Errorf("unable to list all dynamic resources required to find components: %w", err)
+	}
+	klog.V(4).Infof("not allowed to list the resources of all namespaces, listing each namespace: %v", err)
+
+	namespaces, _, err := GetAccessibleNamespaces(client)
+	if err != nil {
+		return nil, err
+	}
+	var result []api.ComponentAbstract
+	for _, ns := range namespaces {
+		components, err := listClusterComponents(client, ns, selector)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, withNamespace(components, ns)...)
+	}
+	return result, nil
+}
+
+// withNamespace sets the namespace of the components
+func withNamespace(components []api.ComponentAbstract, namespace string) []api.ComponentAbstract {
+	for i := range components {
+		components[i].Namespace = namespace
+	}
+	return components
+}
+
+// GetAccessibleNamespaces returns the namespaces (or projects on OpenShift) accessible to the user.
+// If the user is not allowed to list them, only the current namespace is returned, and listed is false.
+func GetAccessibleNamespaces(client kclient.ClientInterface) (namespaces []string, listed bool, err error) {
+	isOC, err := client.IsProjectSupported()
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to detect project support: %w", err)
+	}
+
+	if isOC {
+		namespaces, err = client.ListProjectNames()
+	} else {
+		namespaces, err = client.GetNamespaces()
+	}
+	if err != nil {
+		if !kerrors.IsForbidden(err) {
+			return nil, false, err
+		}
+		klog.V(4).Infof("not allowed to list the namespaces: %v", err)
+		return []string{client.GetCurrentNamespace()}, false, nil
+	}
+	return namespaces, true, nil
 }
 
 func ListAllComponents(client kclient.ClientInterface, podmanClient podman.Client, namespace string, devObj *parser.DevfileObj, componentName string) ([]api.ComponentAbstract, string, error) {
@@ -429,3 +551,33 @@ func ListRoutesAndIngresses(client kclient.ClientInterface, componentName, appNa
 
 	return ings, routes, nil
 }
+
+// GetDevContainer returns the running pod of the component in Dev mode on the cluster, and the name of its container containerName,
+// or of the container running the application if containerName is empty
+func GetDevContainer(client kclient.ClientInterface, devfileObj parser.DevfileObj, componentName, appName, containerName string) (*corev1.Pod, string, error) {
+	selector := odolabels.GetSelector(componentName, appName, odolabels.ComponentDevMode, false)
+	pod, err := client.GetRunningPodFromSelector(selector)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to find the running component %q, start \"odo dev\" first: %w", componentName, err)
+	}
+
+	if containerName == "" {
+		runCommand, found, err := libdevfile.GetCommand(devfileObj, "", v1alpha2.RunCommandGroupKind)
+		if err != nil {
+			return nil, "", err
+		}
+		if found && runCommand.Exec != nil {
+			containerName = runCommand.Exec.Component
+		} else if len(pod.Spec.Containers) > 0 {
+			containerName = pod.Spec.Containers[0].Name
+		}
+	}
+	containerNames := make([]string, 0, len(pod.Spec.Containers))
+	for _, container := range pod.Spec.Containers {
+		if container.Name == containerName {
+			return pod, containerName, nil
+		}
+		containerNames = append(containerNames, container.Name)
+	}
+	return nil, "", fmt.Errorf("container %q not found in the component, the available containers are: %s", containerName, strings.Join(containerNames, ", "))
+}
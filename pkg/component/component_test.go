@@ -7,6 +7,7 @@ import (
 	"path"
 	"path/filepath"
 	"testing"
+	"time"
 
 	devfilepkg "github.com/devfile/api/v2/pkg/devfile"
 	"github.com/devfile/library/pkg/devfile"
@@ -19,6 +20,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	v12 "github.com/openshift/api/route/v1"
 	v1 "k8s.io/api/networking/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -28,6 +30,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/podman"
 	"github.com/redhat-developer/odo/pkg/testingutil"
 	"github.com/redhat-developer/odo/pkg/util"
 
@@ -180,6 +183,174 @@ func TestListAllClusterComponents(t *testing.T) {
 	}
 }
 
+func TestListAllOdoComponentsInAllNamespaces(t *testing.T) {
+	const odoVersion = "v3.0.0-beta3"
+	commonLabels := labels.Builder().WithComponentName("comp1").WithManager("odo").WithManagedByVersion(odoVersion)
+
+	older := metav1.NewTime(time.Date(2023, 1, 19, 10, 0, 0, 0, time.UTC))
+	newer := metav1.NewTime(older.Add(time.Hour))
+
+	resDev := getUnstructured("depDev", "deployment", "v1", "odo", odoVersion, "nodejs", "ns1")
+	resDev.SetLabels(commonLabels.WithMode("Dev").Labels())
+	resDev.SetCreationTimestamp(newer)
+
+	resDeploy := getUnstructured("depDeploy", "deployment", "v1", "odo", odoVersion, "nodejs", "ns1")
+	resDeploy.SetLabels(commonLabels.WithMode("Deploy").Labels())
+	resDeploy.SetCreationTimestamp(older)
+
+	resNs2 := getUnstructured("depDev", "deployment", "v1", "odo", odoVersion, "nodejs", "ns2")
+	resNs2.SetLabels(commonLabels.WithMode("Dev").Labels())
+
+	selector := labels.GetSelectorForAllComponents(true)
+	forbidden := kerrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "", errors.New("forbidden"))
+	forbiddenAll := kerrors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "deployments"}, "", errors.New("forbidden"))
+
+	wantNs1 := api.ComponentAbstract{
+		Name:             "comp1",
+		ManagedBy:        "odo",
+		ManagedByVersion: odoVersion,
+		RunningIn:        api.RunningModes{"dev": true, "deploy": true},
+		Type:             "nodejs",
+		RunningOn:        "cluster",
+		Namespace:        "ns1",
+		CreatedAt:        &older.Time,
+	}
+	wantNs2 := api.ComponentAbstract{
+		Name:             "comp1",
+		ManagedBy:        "odo",
+		ManagedByVersion: odoVersion,
+		RunningIn:        api.RunningModes{"dev": true, "deploy": false},
+		Type:             "nodejs",
+		RunningOn:        "cluster",
+		Namespace:        "ns2",
+	}
+	podmanComponent := api.ComponentAbstract{
+		Name:      "comp2",
+		ManagedBy: "odo",
+		RunningIn: api.RunningModes{"dev": true, "deploy": false},
+		Type:      "nodejs",
+		RunningOn: "podman",
+	}
+
+	tests := []struct {
+		name         string
+		kubeClient   func(ctrl *gomock.Controller) kclient.ClientInterface
+		podmanClient func(ctrl *gomock.Controller) podman.Client
+		want         []api.ComponentAbstract
+		wantErr      bool
+	}{
+		{
+			name: "components listed at once in all namespaces and on podman",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetAllResourcesFromSelectorInAllNamespaces(selector).Return([]unstructured.Unstructured{resNs2, resDev, resDeploy}, nil)
+				return client
+			},
+			podmanClient: func(ctrl *gomock.Controller) podman.Client {
+				client := podman.NewMockClient(ctrl)
+				client.EXPECT().ListAllComponents().Return([]api.ComponentAbstract{
+					podmanComponent,
+					{Name: "other", ManagedBy: "Unknown", RunningOn: "podman"},
+				}, nil)
+				return client
+			},
+			want: []api.ComponentAbstract{wantNs1, wantNs2, podmanComponent},
+		},
+		{
+			name: "error listing the components of all namespaces",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetAllResourcesFromSelectorInAllNamespaces(selector).Return(nil, errors.New("an error"))
+				return client
+			},
+			podmanClient: func(ctrl *gomock.Controller) podman.Client {
+				return nil
+			},
+			wantErr: true,
+		},
+		{
+			name: "not allowed to list all namespaces at once, components listed by namespace",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetAllResourcesFromSelectorInAllNamespaces(selector).Return(nil, forbiddenAll)
+				client.EXPECT().IsProjectSupported().Return(false, nil)
+				client.EXPECT().GetNamespaces().Return([]string{"ns1", "ns2", "ns3"}, nil)
+				client.EXPECT().GetAllResourcesFromSelector(selector, "ns1").Return([]unstructured.Unstructured{resDev, resDeploy}, nil)
+				client.EXPECT().GetAllResourcesFromSelector(selector, "ns2").Return([]unstructured.Unstructured{resNs2}, nil)
+				client.EXPECT().GetAllResourcesFromSelector(selector, "ns3").Return(nil, nil)
+				return client
+			},
+			podmanClient: func(ctrl *gomock.Controller) podman.Client {
+				client := podman.NewMockClient(ctrl)
+				client.EXPECT().ListAllComponents().Return([]api.ComponentAbstract{
+					podmanComponent,
+					{Name: "other", ManagedBy: "Unknown", RunningOn: "podman"},
+				}, nil)
+				return client
+			},
+			want: []api.ComponentAbstract{wantNs1, wantNs2, podmanComponent},
+		},
+		{
+			name: "projects on OpenShift",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetAllResourcesFromSelectorInAllNamespaces(selector).Return(nil, forbiddenAll)
+				client.EXPECT().IsProjectSupported().Return(true, nil)
+				client.EXPECT().ListProjectNames().Return([]string{"ns2"}, nil)
+				client.EXPECT().GetAllResourcesFromSelector(selector, "ns2").Return([]unstructured.Unstructured{resNs2}, nil)
+				return client
+			},
+			podmanClient: func(ctrl *gomock.Controller) podman.Client {
+				return nil
+			},
+			want: []api.ComponentAbstract{wantNs2},
+		},
+		{
+			name: "not allowed to list the namespaces",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetAllResourcesFromSelectorInAllNamespaces(selector).Return(nil, forbiddenAll)
+				client.EXPECT().IsProjectSupported().Return(false, nil)
+				client.EXPECT().GetNamespaces().Return(nil, forbidden)
+				client.EXPECT().GetCurrentNamespace().Return("ns2")
+				client.EXPECT().GetAllResourcesFromSelector(selector, "ns2").Return([]unstructured.Unstructured{resNs2}, nil)
+				return client
+			},
+			podmanClient: func(ctrl *gomock.Controller) podman.Client {
+				return nil
+			},
+			want: []api.ComponentAbstract{wantNs2},
+		},
+		{
+			name: "error listing the namespaces",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetAllResourcesFromSelectorInAllNamespaces(selector).Return(nil, forbiddenAll)
+				client.EXPECT().IsProjectSupported().Return(false, nil)
+				client.EXPECT().GetNamespaces().Return(nil, errors.New("an error"))
+				return client
+			},
+			podmanClient: func(ctrl *gomock.Controller) podman.Client {
+				return nil
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			got, err := ListAllOdoComponentsInAllNamespaces(tt.kubeClient(ctrl), tt.podmanClient(ctrl))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ListAllOdoComponentsInAllNamespaces error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ListAllOdoComponentsInAllNamespaces() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGetComponentTypeFromDevfileMetadata(t *testing.T) {
 	tests := []devfilepkg.DevfileMetadata{
 		{
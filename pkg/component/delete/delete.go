@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog"
 
 	"github.com/redhat-developer/odo/pkg/component"
@@ -26,6 +27,9 @@ import (
 	"github.com/redhat-developer/odo/pkg/util"
 )
 
+// secretGVR is the resource of the Secrets storing the deploy revisions of the components
+var secretGVR = schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+
 type DeleteComponentClient struct {
 	kubeClient   kclient.ClientInterface
 	podmanClient podman.Client
@@ -55,6 +59,12 @@ func (do *DeleteComponentClient) ListClusterResourcesToDelete(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
+	// the deploy revisions are not labelled as part of the component, and are selected separately
+	revisions, err := do.listDeployRevisions(componentName, odocontext.GetApplication(ctx), namespace)
+	if err != nil {
+		return nil, err
+	}
+	list = append(list, revisions...)
 	for _, resource := range list {
 		// If the resource is Terminating, there is no sense in displaying it.
 		if resource.GetDeletionTimestamp() != nil {
@@ -161,9 +171,39 @@ func (do DeleteComponentClient) ListClusterResourcesToDeleteFromDevfile(devfileO
 		resources = append(resources, *cr)
 	}
 
+	if mode == odolabels.ComponentDeployMode || mode == odolabels.ComponentAnyMode {
+		var revisions []unstructured.Unstructured
+		revisions, err = do.listDeployRevisions(componentName, appName, "")
+		if err != nil {
+			err = clierrors.NewWarning(fmt.Sprintf("failed to list the deploy revisions of component %q", componentName), err)
+			return isInnerLoopDeployed, resources, err
+		}
+		resources = append(resources, revisions...)
+	}
+
 	return isInnerLoopDeployed, resources, nil
 }
 
+// listDeployRevisions returns the Secrets storing the deploy revisions of the component in the namespace,
+// or in the current namespace if namespace is empty
+func (do DeleteComponentClient) listDeployRevisions(componentName string, appName string, namespace string) ([]unstructured.Unstructured, error) {
+	list, err := do.kubeClient.ListDynamicResources(namespace, secretGVR, odolabels.GetDeployRevisionSelector(componentName, appName))
+	if err != nil {
+		return nil, err
+	}
+	if list == nil {
+		return nil, nil
+	}
+	var result []unstructured.Unstructured
+	for _, item := range list.Items {
+		if item.GetDeletionTimestamp() != nil {
+			continue
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
 // ExecutePreStopEvents executes preStop events if any, as a precondition to deleting a devfile component deployment
 func (do *DeleteComponentClient) ExecutePreStopEvents(devfileObj parser.DevfileObj, appName string, componentName string) error {
 	if !libdevfile.HasPreStopEvents(devfileObj) {
@@ -33,6 +33,8 @@ const (
 func TestDeleteComponentClient_ListClusterResourcesToDelete(t *testing.T) {
 	res1 := getUnstructured("dep1", "deployment", "v1", "")
 	res2 := getUnstructured("svc1", "service", "v1", "")
+	revision := getUnstructured("odo-deploy-my-component-1", "Secret", "v1", "")
+	revisionSelector := "app.kubernetes.io/managed-by=odo,app.kubernetes.io/part-of=app,odo.dev/deploy-revision-of=my-component"
 
 	type fields struct {
 		kubeClient func(ctrl *gomock.Controller) kclient.ClientInterface
@@ -55,6 +57,7 @@ func TestDeleteComponentClient_ListClusterResourcesToDelete(t *testing.T) {
 					client := kclient.NewMockClientInterface(ctrl)
 					selector := "app.kubernetes.io/instance=my-component,app.kubernetes.io/managed-by=odo,app.kubernetes.io/part-of=app"
 					client.EXPECT().GetAllResourcesFromSelector(selector, "my-ns").Return(nil, nil)
+					client.EXPECT().ListDynamicResources("my-ns", secretGVR, revisionSelector).Return(&unstructured.UnstructuredList{}, nil)
 					return client
 				},
 			},
@@ -74,6 +77,7 @@ func TestDeleteComponentClient_ListClusterResourcesToDelete(t *testing.T) {
 					client := kclient.NewMockClientInterface(ctrl)
 					selector := "app.kubernetes.io/instance=my-component,app.kubernetes.io/managed-by=odo,app.kubernetes.io/part-of=app"
 					client.EXPECT().GetAllResourcesFromSelector(selector, "my-ns").Return(resources, nil)
+					client.EXPECT().ListDynamicResources("my-ns", secretGVR, revisionSelector).Return(&unstructured.UnstructuredList{}, nil)
 					return client
 				},
 			},
@@ -100,6 +104,7 @@ func TestDeleteComponentClient_ListClusterResourcesToDelete(t *testing.T) {
 					client := kclient.NewMockClientInterface(ctrl)
 					selector := "app.kubernetes.io/instance=my-component,app.kubernetes.io/managed-by=odo,app.kubernetes.io/part-of=app"
 					client.EXPECT().GetAllResourcesFromSelector(selector, "my-ns").Return(resources, nil)
+					client.EXPECT().ListDynamicResources("my-ns", secretGVR, revisionSelector).Return(&unstructured.UnstructuredList{}, nil)
 					return client
 				},
 			},
@@ -110,6 +115,25 @@ func TestDeleteComponentClient_ListClusterResourcesToDelete(t *testing.T) {
 			wantErr: false,
 			want:    []unstructured.Unstructured{res2},
 		},
+		{
+			name: "deploy revisions found",
+			fields: fields{
+				kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+					client := kclient.NewMockClientInterface(ctrl)
+					selector := "app.kubernetes.io/instance=my-component,app.kubernetes.io/managed-by=odo,app.kubernetes.io/part-of=app"
+					client.EXPECT().GetAllResourcesFromSelector(selector, "my-ns").Return([]unstructured.Unstructured{res2}, nil)
+					client.EXPECT().ListDynamicResources("my-ns", secretGVR, revisionSelector).
+						Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{revision}}, nil)
+					return client
+				},
+			},
+			args: args{
+				componentName: "my-component",
+				namespace:     "my-ns",
+			},
+			wantErr: false,
+			want:    []unstructured.Unstructured{res2, revision},
+		},
 		// TODO: Add test cases.
 	}
 	for _, tt := range tests {
@@ -303,6 +327,10 @@ func TestDeleteComponentClient_ListClusterResourcesToDeleteFromDevfile(t *testin
 	innerLoopResourceUnstructured := *outerLoopResourceUnstructured.DeepCopy()
 	innerLoopResourceUnstructured.SetLabels(odolabels.GetLabels(compName, appName, "", odolabels.ComponentDevMode, false))
 
+	// revision is the Secret storing a deploy revision of the component
+	revision := getUnstructured("odo-deploy-"+compName+"-1", "Secret", "v1", "")
+	revisionSelector := odolabels.GetDeployRevisionSelector(compName, appName)
+
 	deploymentRESTMapping := meta.RESTMapping{
 		Resource: getGVR("apps", "v1", "Deployment"),
 	}
@@ -336,6 +364,7 @@ func TestDeleteComponentClient_ListClusterResourcesToDeleteFromDevfile(t *testin
 						GetDynamicResource(deploymentRESTMapping.Resource, outerLoopResourceUnstructured.GetName()).
 						Return(&labeledOuterloopResource, nil)
 
+					kubeClient.EXPECT().ListDynamicResources("", secretGVR, revisionSelector).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{revision}}, nil)
 					return kubeClient
 				},
 			},
@@ -345,7 +374,7 @@ func TestDeleteComponentClient_ListClusterResourcesToDeleteFromDevfile(t *testin
 				mode:       odolabels.ComponentAnyMode,
 			},
 			wantIsInnerLoopDeployed: true,
-			wantResources:           []unstructured.Unstructured{innerLoopCoreDeploymentUnstructured, labeledOuterloopResource},
+			wantResources:           []unstructured.Unstructured{innerLoopCoreDeploymentUnstructured, labeledOuterloopResource, revision},
 			wantErr:                 false,
 		},
 		{
@@ -418,6 +447,7 @@ func TestDeleteComponentClient_ListClusterResourcesToDeleteFromDevfile(t *testin
 					kubeClient.EXPECT().
 						GetDynamicResource(deploymentRESTMapping.Resource, outerLoopResourceUnstructured.GetName()).
 						Return(&labeledOuterloopResource, nil)
+					kubeClient.EXPECT().ListDynamicResources("", secretGVR, revisionSelector).Return(&unstructured.UnstructuredList{}, nil)
 					return kubeClient
 				},
 			},
@@ -441,6 +471,7 @@ func TestDeleteComponentClient_ListClusterResourcesToDeleteFromDevfile(t *testin
 					kubeClient.EXPECT().
 						GetDynamicResource(deploymentRESTMapping.Resource, outerLoopResourceUnstructured.GetName()).
 						Return(&labeledOuterloopResource, nil)
+					kubeClient.EXPECT().ListDynamicResources("", secretGVR, revisionSelector).Return(&unstructured.UnstructuredList{}, nil)
 					return kubeClient
 				},
 			},
@@ -478,6 +509,7 @@ func TestDeleteComponentClient_ListClusterResourcesToDeleteFromDevfile(t *testin
 					kubeClient := kclient.NewMockClientInterface(ctrl)
 					kubeClient.EXPECT().GetDeploymentByName(innerLoopCoreDeploymentName).Return(innerLoopCoreDeployment, nil)
 					kubeClient.EXPECT().GetRestMappingFromUnstructured(outerLoopResourceUnstructured).Return(nil, errors.New("some error"))
+					kubeClient.EXPECT().ListDynamicResources("", secretGVR, revisionSelector).Return(&unstructured.UnstructuredList{}, nil)
 					return kubeClient
 				},
 			},
@@ -500,6 +532,7 @@ func TestDeleteComponentClient_ListClusterResourcesToDeleteFromDevfile(t *testin
 					kubeClient.EXPECT().
 						GetDynamicResource(deploymentRESTMapping.Resource, outerLoopResourceUnstructured.GetName()).
 						Return(nil, errors.New("some error"))
+					kubeClient.EXPECT().ListDynamicResources("", secretGVR, revisionSelector).Return(&unstructured.UnstructuredList{}, nil)
 					return kubeClient
 				},
 			},
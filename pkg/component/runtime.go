@@ -0,0 +1,207 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+)
+
+// maxRuntimeEvents is the maximum number of warning events returned by GetRuntimeStatus
+const maxRuntimeEvents = 10
+
+// GetRuntimeStatus returns the live state of the "name" component in the current namespace: its pods in Dev and Deploy modes,
+// the most recent warning events for the objects it owns, and the resources usage of its containers when the metrics API is available.
+// It returns nil if no resource of the component is found.
+// When the events or the resources usage cannot be fetched, the partial status is returned along with the error.
+func GetRuntimeStatus(ctx context.Context, client kclient.ClientInterface, name string) (*api.RuntimeStatus, error) {
+	if client == nil {
+		return nil, nil
+	}
+
+	resources, err := getResourcesForComponent(ctx, client, name, client.GetCurrentNamespace())
+	if err != nil {
+		return nil, err
+	}
+	if len(resources) == 0 {
+		return nil, nil
+	}
+
+	// objects owned by the component, as Kind/name
+	owned := make(map[string]struct{}, len(resources))
+	for _, resource := range resources {
+		owned[resource.GetKind()+"/"+resource.GetName()] = struct{}{}
+	}
+
+	// the pods are found from the resources of the component: the pods of the component themselves in Dev mode,
+	// and the pods owned by the resources deployed in Deploy mode, which have no labels of the component
+	ownedPods, err := client.GetPodsOwnedByResources(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	var status api.RuntimeStatus
+	for _, mode := range []string{odolabels.ComponentDevMode, odolabels.ComponentDeployMode} {
+		for _, ownedPod := range ownedPods {
+			if odolabels.GetMode(ownedPod.Owner.GetLabels()) != mode {
+				continue
+			}
+			pod := ownedPod.Pod
+			status.Pods = append(status.Pods, getPodStatus(pod, api.RunningMode(strings.ToLower(mode))))
+			owned["Pod/"+pod.GetName()] = struct{}{}
+			for _, owner := range pod.GetOwnerReferences() {
+				owned[owner.Kind+"/"+owner.Name] = struct{}{}
+			}
+		}
+	}
+
+	var warning error
+
+	events, err := client.ListWarningEvents()
+	if err != nil {
+		if kerrors.IsForbidden(err) {
+			klog.V(4).Infof("not allowed to list events: %v", err)
+		} else {
+			warning = fmt.Errorf("unable to get the events: %w", err)
+		}
+	}
+	status.Events = getOwnedWarningEvents(events, owned)
+
+	err = setResourcesUsage(client, &status)
+	if err != nil && warning == nil {
+		warning = fmt.Errorf("unable to get the resources usage: %w", err)
+	}
+
+	return &status, warning
+}
+
+func getPodStatus(pod corev1.Pod, mode api.RunningMode) api.PodStatus {
+	result := api.PodStatus{
+		Name:  pod.GetName(),
+		Mode:  mode,
+		Phase: string(pod.Status.Phase),
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			result.Ready = condition.Status == corev1.ConditionTrue
+		}
+	}
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		container := api.ContainerStatus{
+			Name:         containerStatus.Name,
+			Ready:        containerStatus.Ready,
+			RestartCount: containerStatus.RestartCount,
+		}
+		switch state := containerStatus.State; {
+		case state.Running != nil:
+			container.State = "running"
+		case state.Waiting != nil:
+			container.State = "waiting"
+			container.StateReason = state.Waiting.Reason
+		case state.Terminated != nil:
+			container.State = "terminated"
+			container.StateReason = state.Terminated.Reason
+		}
+		if terminated := containerStatus.LastTerminationState.Terminated; terminated != nil {
+			container.LastTerminationReason = terminated.Reason
+		}
+		result.Containers = append(result.Containers, container)
+	}
+	return result
+}
+
+// getOwnedWarningEvents returns the most recent events involving one of the owned objects, most recent first
+func getOwnedWarningEvents(events []corev1.Event, owned map[string]struct{}) []api.Event {
+	var result []api.Event
+	for _, event := range events {
+		object := event.InvolvedObject.Kind + "/" + event.InvolvedObject.Name
+		if _, ok := owned[object]; !ok {
+			continue
+		}
+		result = append(result, api.Event{
+			Object:        object,
+			Reason:        event.Reason,
+			Message:       event.Message,
+			Count:         getEventCount(event),
+			LastTimestamp: getEventTimestamp(event),
+		})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].LastTimestamp.After(result[j].LastTimestamp)
+	})
+	if len(result) > maxRuntimeEvents {
+		result = result[:maxRuntimeEvents]
+	}
+	return result
+}
+
+func getEventCount(event corev1.Event) int32 {
+	if event.Series != nil && event.Series.Count > 0 {
+		return event.Series.Count
+	}
+	if event.Count > 0 {
+		return event.Count
+	}
+	return 1
+}
+
+func getEventTimestamp(event corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case event.Series != nil && !event.Series.LastObservedTime.IsZero():
+		return event.Series.LastObservedTime.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	}
+	return event.FirstTimestamp.Time
+}
+
+// setResourcesUsage sets the resources usage of the containers of the pods, if the metrics API is available
+func setResourcesUsage(client kclient.ClientInterface, status *api.RuntimeStatus) error {
+	supported, err := client.IsPodMetricsSupported()
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return nil
+	}
+
+	for i := range status.Pods {
+		pod := &status.Pods[i]
+		usage, err := client.GetPodMetrics(pod.Name)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				// metrics are not collected yet for a new pod
+				continue
+			}
+			if kerrors.IsForbidden(err) {
+				klog.V(4).Infof("not allowed to get the metrics of pod %q: %v", pod.Name, err)
+				return nil
+			}
+			return err
+		}
+		for j := range pod.Containers {
+			container := &pod.Containers[j]
+			resources, ok := usage[container.Name]
+			if !ok {
+				continue
+			}
+			container.Usage = &api.ResourceUsage{
+				CPUMillicores: resources.Cpu().MilliValue(),
+				MemoryBytes:   resources.Memory().Value(),
+			}
+		}
+	}
+	status.MetricsAvailable = true
+	return nil
+}
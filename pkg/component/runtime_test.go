@@ -0,0 +1,262 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+)
+
+func TestGetRuntimeStatus(t *testing.T) {
+	deployment := unstructured.Unstructured{}
+	deployment.SetKind("Deployment")
+	deployment.SetName("aname-app")
+	deployment.SetLabels(odolabels.Builder().WithMode(odolabels.ComponentDevMode).Labels())
+
+	deployDeployment := unstructured.Unstructured{}
+	deployDeployment.SetKind("Deployment")
+	deployDeployment.SetName("my-deploy")
+	deployDeployment.SetLabels(odolabels.Builder().WithMode(odolabels.ComponentDeployMode).Labels())
+
+	devPod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "aname-app-1234",
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "ReplicaSet", Name: "aname-app-12"},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionFalse},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:         "runtime",
+					Ready:        true,
+					RestartCount: 0,
+					State:        corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				},
+				{
+					Name:         "sidecar",
+					RestartCount: 3,
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+					},
+					LastTerminationState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Reason: "Error"},
+					},
+				},
+			},
+		},
+	}
+	deployPod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-deploy-5678",
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "main",
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"},
+					},
+				},
+			},
+		},
+	}
+
+	now := time.Now().Truncate(time.Second)
+	newEvent := func(kind, name, reason string, count int32, ago time.Duration) corev1.Event {
+		return corev1.Event{
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: name},
+			Reason:         reason,
+			Message:        reason + " message",
+			Count:          count,
+			LastTimestamp:  metav1.NewTime(now.Add(-ago)),
+		}
+	}
+	events := []corev1.Event{
+		newEvent("Pod", "aname-app-1234", "BackOff", 5, time.Minute),
+		newEvent("Pod", "another-pod", "BackOff", 1, time.Minute),
+		newEvent("Pod", "my-deploy-5678", "Failed", 2, 30*time.Second),
+		newEvent("ReplicaSet", "aname-app-12", "FailedCreate", 0, 2*time.Minute),
+		newEvent("Deployment", "aname-app", "Unhealthy", 1, 3*time.Minute),
+	}
+
+	wantPods := func(withUsage bool) []api.PodStatus {
+		pods := []api.PodStatus{
+			{
+				Name:  "aname-app-1234",
+				Mode:  api.RunningModeDev,
+				Phase: "Running",
+				Ready: false,
+				Containers: []api.ContainerStatus{
+					{
+						Name:  "runtime",
+						Ready: true,
+						State: "running",
+					},
+					{
+						Name:                  "sidecar",
+						State:                 "waiting",
+						StateReason:           "CrashLoopBackOff",
+						RestartCount:          3,
+						LastTerminationReason: "Error",
+					},
+				},
+			},
+			{
+				Name:  "my-deploy-5678",
+				Mode:  api.RunningModeDeploy,
+				Phase: "Pending",
+				Containers: []api.ContainerStatus{
+					{
+						Name:        "main",
+						State:       "waiting",
+						StateReason: "ImagePullBackOff",
+					},
+				},
+			},
+		}
+		if withUsage {
+			pods[0].Containers[0].Usage = &api.ResourceUsage{CPUMillicores: 12, MemoryBytes: 64 * 1024 * 1024}
+		}
+		return pods
+	}
+	wantEvents := []api.Event{
+		{Object: "Pod/my-deploy-5678", Reason: "Failed", Message: "Failed message", Count: 2, LastTimestamp: now.Add(-30 * time.Second)},
+		{Object: "Pod/aname-app-1234", Reason: "BackOff", Message: "BackOff message", Count: 5, LastTimestamp: now.Add(-time.Minute)},
+		{Object: "ReplicaSet/aname-app-12", Reason: "FailedCreate", Message: "FailedCreate message", Count: 1, LastTimestamp: now.Add(-2 * time.Minute)},
+		{Object: "Deployment/aname-app", Reason: "Unhealthy", Message: "Unhealthy message", Count: 1, LastTimestamp: now.Add(-3 * time.Minute)},
+	}
+
+	withPods := func(c *kclient.MockClientInterface) {
+		resources := []unstructured.Unstructured{deployDeployment, deployment}
+		c.EXPECT().GetAllResourcesFromSelector(gomock.Any(), "a-namespace").Return(resources, nil)
+		c.EXPECT().GetPodsOwnedByResources(resources).Return([]kclient.OwnedPod{
+			{Pod: deployPod, Owner: deployDeployment},
+			{Pod: devPod, Owner: deployment},
+		}, nil)
+	}
+
+	tests := []struct {
+		name    string
+		client  func(ctrl *gomock.Controller) kclient.ClientInterface
+		want    *api.RuntimeStatus
+		wantErr bool
+	}{
+		{
+			name: "no cluster",
+			client: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				return nil
+			},
+			want: nil,
+		},
+		{
+			name: "component not deployed",
+			client: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				c := kclient.NewMockClientInterface(ctrl)
+				c.EXPECT().GetCurrentNamespace().Return("a-namespace").AnyTimes()
+				c.EXPECT().GetAllResourcesFromSelector(gomock.Any(), "a-namespace").Return(nil, nil)
+				return c
+			},
+			want: nil,
+		},
+		{
+			name: "pods, events and metrics",
+			client: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				c := kclient.NewMockClientInterface(ctrl)
+				c.EXPECT().GetCurrentNamespace().Return("a-namespace").AnyTimes()
+				withPods(c)
+				c.EXPECT().ListWarningEvents().Return(events, nil)
+				c.EXPECT().IsPodMetricsSupported().Return(true, nil)
+				c.EXPECT().GetPodMetrics("aname-app-1234").Return(map[string]corev1.ResourceList{
+					"runtime": {
+						corev1.ResourceCPU:    resource.MustParse("12m"),
+						corev1.ResourceMemory: resource.MustParse("64Mi"),
+					},
+				}, nil)
+				c.EXPECT().GetPodMetrics("my-deploy-5678").Return(nil, kerrors.NewNotFound(schema.GroupResource{Group: "metrics.k8s.io", Resource: "pods"}, "my-deploy-5678"))
+				return c
+			},
+			want: &api.RuntimeStatus{
+				Pods:             wantPods(true),
+				Events:           wantEvents,
+				MetricsAvailable: true,
+			},
+		},
+		{
+			name: "metrics API not available and events forbidden",
+			client: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				c := kclient.NewMockClientInterface(ctrl)
+				c.EXPECT().GetCurrentNamespace().Return("a-namespace").AnyTimes()
+				withPods(c)
+				c.EXPECT().ListWarningEvents().Return(nil, kerrors.NewForbidden(schema.GroupResource{Resource: "events"}, "", errors.New("forbidden")))
+				c.EXPECT().IsPodMetricsSupported().Return(false, nil)
+				return c
+			},
+			want: &api.RuntimeStatus{
+				Pods: wantPods(false),
+			},
+		},
+		{
+			name: "error getting the metrics returns the partial status",
+			client: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				c := kclient.NewMockClientInterface(ctrl)
+				c.EXPECT().GetCurrentNamespace().Return("a-namespace").AnyTimes()
+				withPods(c)
+				c.EXPECT().ListWarningEvents().Return(events, nil)
+				c.EXPECT().IsPodMetricsSupported().Return(true, nil)
+				c.EXPECT().GetPodMetrics("aname-app-1234").Return(nil, errors.New("an error"))
+				return c
+			},
+			want: &api.RuntimeStatus{
+				Pods:   wantPods(false),
+				Events: wantEvents,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error getting the pods",
+			client: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				c := kclient.NewMockClientInterface(ctrl)
+				c.EXPECT().GetCurrentNamespace().Return("a-namespace").AnyTimes()
+				c.EXPECT().GetAllResourcesFromSelector(gomock.Any(), "a-namespace").Return([]unstructured.Unstructured{deployment}, nil)
+				c.EXPECT().GetPodsOwnedByResources(gomock.Any()).Return(nil, errors.New("an error"))
+				return c
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := odocontext.WithApplication(context.TODO(), "app")
+			got, err := GetRuntimeStatus(ctx, tt.client(ctrl), "aname")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetRuntimeStatus() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
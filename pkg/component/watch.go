@@ -0,0 +1,162 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/podman"
+)
+
+// NewComponentsWatcher returns a watcher receiving an event each time a component may have been created, modified or deleted,
+// on the cluster and on podman. Either client can be nil.
+// On the cluster, the components of the namespace are watched, or the components managed by odo
+// in all the namespaces accessible to the user if allNamespaces is true.
+// The watcher is closed as soon as one of the underlying watchers is closed.
+func NewComponentsWatcher(
+	ctx context.Context,
+	client kclient.ClientInterface,
+	podmanClient podman.Client,
+	namespace string,
+	allNamespaces bool,
+) (watch.Interface, error) {
+	var watchers []watch.Interface
+	stopAll := func() {
+		for _, w := range watchers {
+			w.Stop()
+		}
+	}
+
+	if client != nil {
+		clusterWatchers, err := getClusterComponentsWatchers(ctx, client, namespace, allNamespaces)
+		if err != nil {
+			return nil, err
+		}
+		watchers = append(watchers, clusterWatchers...)
+	}
+
+	if podmanClient != nil {
+		podmanWatcher, err := podmanClient.PodsWatcher(ctx)
+		if err != nil {
+			stopAll()
+			return nil, err
+		}
+		watchers = append(watchers, podmanWatcher)
+	}
+
+	if len(watchers) == 0 {
+		return nil, errors.New("no platform accessible to watch the components")
+	}
+	return newMergedWatcher(watchers), nil
+}
+
+// getClusterComponentsWatchers returns the watchers on the Deployments of the components.
+// When the user is not allowed to watch the Deployments of all namespaces, a watcher is created for each accessible namespace
+func getClusterComponentsWatchers(ctx context.Context, client kclient.ClientInterface, namespace string, allNamespaces bool) ([]watch.Interface, error) {
+	if !allNamespaces {
+		w, err := client.DeploymentWatcherInNamespace(ctx, namespace, odolabels.GetSelectorForAllComponents(false))
+		if err != nil {
+			return nil, err
+		}
+		return []watch.Interface{w}, nil
+	}
+
+	selector := odolabels.GetSelectorForAllComponents(true)
+	w, err := client.DeploymentWatcherInNamespace(ctx, "", selector)
+	if err == nil {
+		return []watch.Interface{w}, nil
+	}
+	if !kerrors.IsForbidden(err) {
+		return nil, err
+	}
+	klog.V(4).Infof("not allowed to watch the Deployments of all namespaces, watching each namespace: %v", err)
+
+	namespaces, _, err := GetAccessibleNamespaces(client)
+	if err != nil {
+		return nil, err
+	}
+	var watchers []watch.Interface
+	for _, ns := range namespaces {
+		w, err = client.DeploymentWatcherInNamespace(ctx, ns, selector)
+		if err != nil {
+			if kerrors.IsForbidden(err) {
+				klog.V(4).Infof("not allowed to watch the Deployments of namespace %q: %v", ns, err)
+				continue
+			}
+			for _, other := range watchers {
+				other.Stop()
+			}
+			return nil, err
+		}
+		watchers = append(watchers, w)
+	}
+	return watchers, nil
+}
+
+// mergedWatcher forwards the events of several watchers into a single channel
+type mergedWatcher struct {
+	watchers []watch.Interface
+	result   chan watch.Event
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+var _ watch.Interface = (*mergedWatcher)(nil)
+
+func newMergedWatcher(watchers []watch.Interface) *mergedWatcher {
+	m := &mergedWatcher{
+		watchers: watchers,
+		result:   make(chan watch.Event),
+		stopCh:   make(chan struct{}),
+	}
+
+	var wg sync.WaitGroup
+	for _, w := range watchers {
+		wg.Add(1)
+		go func(w watch.Interface) {
+			defer wg.Done()
+			// stop all the watchers as soon as one is closed, so the caller can restart watching
+			defer m.Stop()
+			for {
+				select {
+				case event, ok := <-w.ResultChan():
+					if !ok {
+						return
+					}
+					select {
+					case m.result <- event:
+					case <-m.stopCh:
+						return
+					}
+				case <-m.stopCh:
+					return
+				}
+			}
+		}(w)
+	}
+	go func() {
+		wg.Wait()
+		close(m.result)
+	}()
+
+	return m
+}
+
+func (m *mergedWatcher) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopCh)
+		for _, w := range m.watchers {
+			w.Stop()
+		}
+	})
+}
+
+func (m *mergedWatcher) ResultChan() <-chan watch.Event {
+	return m.result
+}
@@ -0,0 +1,49 @@
+package component
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func Test_mergedWatcher(t *testing.T) {
+	w1 := watch.NewFake()
+	w2 := watch.NewFake()
+	merged := newMergedWatcher([]watch.Interface{w1, w2})
+
+	receive := func() (watch.Event, bool) {
+		select {
+		case ev, ok := <-merged.ResultChan():
+			return ev, ok
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for an event")
+		}
+		return watch.Event{}, false
+	}
+
+	pod := func(name string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+
+	go w1.Add(pod("pod1"))
+	if ev, ok := receive(); !ok || ev.Type != watch.Added || ev.Object.(*corev1.Pod).Name != "pod1" {
+		t.Errorf("unexpected event from first watcher: %v, %v", ev, ok)
+	}
+
+	go w2.Delete(pod("pod2"))
+	if ev, ok := receive(); !ok || ev.Type != watch.Deleted || ev.Object.(*corev1.Pod).Name != "pod2" {
+		t.Errorf("unexpected event from second watcher: %v, %v", ev, ok)
+	}
+
+	// closing one of the watchers closes the merged watcher and stops the others
+	w1.Stop()
+	if ev, ok := receive(); ok {
+		t.Errorf("merged watcher should be closed, got event %v", ev)
+	}
+	if !w2.IsStopped() {
+		t.Errorf("second watcher should be stopped")
+	}
+}
@@ -3,17 +3,24 @@ package deploy
 import (
 	"context"
 	"errors"
+	"fmt"
 	"path/filepath"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/pkg/devfile/parser"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
 
+	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/devfile/image"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/service"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
 
@@ -40,7 +47,144 @@ func (o *DeployClient) Deploy(ctx context.Context) error {
 		appName       = odocontext.GetApplication(ctx)
 	)
 	deployHandler := newDeployHandler(ctx, o.fs, *devfileObj, path, o.kubeClient, appName, componentName)
-	return libdevfile.Deploy(*devfileObj, deployHandler)
+	err := libdevfile.Deploy(*devfileObj, deployHandler)
+	if err != nil {
+		return err
+	}
+	devfileHash, err := o.getDevfileHash(ctx)
+	if err == nil {
+		_, err = o.recordRevision(ctx, deployHandler.applied, deployHandler.images, devfileHash, "")
+	}
+	if err != nil {
+		// The resources are deployed; only the history is missing this deploy
+		log.Warningf("Unable to record the deploy revision: %v", err)
+	}
+	return nil
+}
+
+func (o *DeployClient) History(ctx context.Context) ([]api.DeployRevision, error) {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+	)
+	revisions, err := o.listRevisions(componentName, appName)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]api.DeployRevision, 0, len(revisions))
+	for _, rev := range revisions {
+		result = append(result, rev.DeployRevision)
+	}
+	return result, nil
+}
+
+func (o *DeployClient) Rollback(ctx context.Context, number int) (api.DeployRevision, error) {
+	var (
+		devfileObj    = odocontext.GetDevfileObj(ctx)
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+	)
+	revisions, err := o.listRevisions(componentName, appName)
+	if err != nil {
+		return api.DeployRevision{}, err
+	}
+	target, err := findRevision(revisions, number)
+	if err != nil {
+		return api.DeployRevision{}, err
+	}
+
+	metadata := devfileObj.Data.GetMetadata()
+	labels := odolabels.GetLabels(componentName, appName, component.GetComponentRuntimeFromDevfileMetadata(metadata), odolabels.ComponentDeployMode, false)
+	annotations := make(map[string]string)
+	odolabels.SetProjectType(annotations, component.GetComponentTypeFromDevfileMetadata(metadata))
+
+	for _, u := range target.manifests {
+		u := *u.DeepCopy()
+		// Use the images exactly as they were deployed, even if their tags now reference other images
+		image.PinImagesInResource(&u, target.Images)
+		log.Sectionf("Deploying Kubernetes Component: %s", u.GetName())
+		err = service.PushKubernetesResource(o.kubeClient, u, labels, annotations, odolabels.ComponentDeployMode)
+		if err != nil {
+			return api.DeployRevision{}, fmt.Errorf("failed to create service(s) associated with the component: %w", err)
+		}
+	}
+
+	err = o.pruneResources(componentName, appName, target.manifests)
+	if err != nil {
+		return api.DeployRevision{}, err
+	}
+
+	// The hash of the Devfile the restored revision was deployed from is kept, as the current Devfile has not been deployed
+	return o.recordRevision(ctx, target.manifests, target.Images, target.DevfileHash, fmt.Sprintf("Rollback to revision %d", target.Revision))
+}
+
+// findRevision returns the revision with the given number, or the revision preceding the latest one if number is 0
+func findRevision(revisions []revision, number int) (revision, error) {
+	if number == 0 {
+		if len(revisions) < 2 {
+			return revision{}, errors.New("no previous revision found in the deploy history of the component")
+		}
+		return revisions[len(revisions)-2], nil
+	}
+	for _, rev := range revisions {
+		if rev.Revision == number {
+			return rev, nil
+		}
+	}
+	return revision{}, fmt.Errorf("revision %d not found in the deploy history of the component", number)
+}
+
+// pruneResources deletes the resources deployed for the component which are not part of the manifests
+func (o *DeployClient) pruneResources(componentName string, appName string, manifests []unstructured.Unstructured) error {
+	selector := odolabels.GetSelector(componentName, appName, odolabels.ComponentDeployMode, false)
+	deployed, err := o.kubeClient.GetAllResourcesFromSelector(selector, o.kubeClient.GetCurrentNamespace())
+	if err != nil {
+		return err
+	}
+	for _, u := range getResourcesToPrune(deployed, manifests) {
+		mapping, err := o.kubeClient.GetRestMappingFromUnstructured(u)
+		if err != nil {
+			return err
+		}
+		log.Sectionf("Deleting Kubernetes Component: %s", u.GetName())
+		err = o.kubeClient.DeleteDynamicResource(u.GetName(), mapping.Resource, false)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete %s %q: %w", u.GetKind(), u.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// getResourcesToPrune returns the deployed resources which are not part of the manifests,
+// ignoring the resources owned by other deployed resources, which are deleted by the garbage collector
+func getResourcesToPrune(deployed []unstructured.Unstructured, manifests []unstructured.Unstructured) []unstructured.Unstructured {
+	key := func(u unstructured.Unstructured) string {
+		return u.GroupVersionKind().GroupKind().String() + "/" + u.GetName()
+	}
+	keep := make(map[string]bool, len(manifests))
+	for _, u := range manifests {
+		keep[key(u)] = true
+	}
+	var result []unstructured.Unstructured
+	for _, u := range deployed {
+		if keep[key(u)] || u.GetDeletionTimestamp() != nil || isOwnedBy(u, deployed) {
+			continue
+		}
+		result = append(result, u)
+	}
+	return result
+}
+
+// isOwnedBy returns true if the resource is owned by one of the resources of the list
+func isOwnedBy(u unstructured.Unstructured, list []unstructured.Unstructured) bool {
+	for _, ownerRef := range u.GetOwnerReferences() {
+		for _, owner := range list {
+			if ownerRef.APIVersion == owner.GetAPIVersion() && ownerRef.Kind == owner.GetKind() && ownerRef.Name == owner.GetName() {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 type deployHandler struct {
@@ -51,6 +195,11 @@ type deployHandler struct {
 	kubeClient    kclient.ClientInterface
 	appName       string
 	componentName string
+
+	// applied are the resources applied by the handler
+	applied []unstructured.Unstructured
+	// images maps the names of the images built by the handler with their references by digest
+	images map[string]string
 }
 
 var _ libdevfile.Handler = (*deployHandler)(nil)
@@ -64,17 +213,33 @@ func newDeployHandler(ctx context.Context, fs filesystem.Filesystem, devfileObj
 		kubeClient:    kubeClient,
 		appName:       appName,
 		componentName: componentName,
+		images:        map[string]string{},
 	}
 }
 
 // ApplyImage builds and pushes the OCI image to be used on Kubernetes
 func (o *deployHandler) ApplyImage(img v1alpha2.Component) error {
-	return image.BuildPushSpecificImage(o.ctx, o.fs, img, true)
+	err := image.BuildPushSpecificImage(o.ctx, o.fs, img, true)
+	if err != nil {
+		return err
+	}
+	repoDigest, err := image.GetRepoDigest(o.ctx, img.Image.ImageName)
+	if err != nil {
+		// The digest is only informative, and not known for some images, as multi-platform images built with docker buildx
+		klog.V(3).Infof("unable to get the digest of image %q: %v", img.Image.ImageName, err)
+	}
+	o.images[img.Image.ImageName] = repoDigest
+	return nil
 }
 
 // ApplyKubernetes applies inline Kubernetes YAML from the devfile.yaml file
 func (o *deployHandler) ApplyKubernetes(kubernetes v1alpha2.Component) error {
-	return component.ApplyKubernetes(odolabels.ComponentDeployMode, o.appName, o.componentName, o.devfileObj, kubernetes, o.kubeClient, o.path)
+	applied, err := component.ApplyKubernetes(o.ctx, odolabels.ComponentDeployMode, o.appName, o.componentName, o.devfileObj, kubernetes, o.kubeClient, o.path)
+	if err != nil {
+		return err
+	}
+	o.applied = append(o.applied, applied...)
+	return nil
 }
 
 // Execute will deploy the listed information in the `exec` section of devfile.yaml
@@ -2,11 +2,21 @@ package deploy
 
 import (
 	"context"
+
+	"github.com/redhat-developer/odo/pkg/api"
 )
 
 type Client interface {
 	// Deploy resources from a devfile located in path, for the specified appName.
 	// The filesystem specified is used to download and store the Dockerfiles needed to build the necessary container images,
 	// in case such Dockerfiles are referenced as remote URLs in the Devfile.
+	// A revision of the deployed resources is recorded in the cluster after a successful deploy.
 	Deploy(ctx context.Context) error
+	// History returns the deploy revisions recorded for the component, sorted from the oldest to the newest.
+	History(ctx context.Context) ([]api.DeployRevision, error)
+	// Rollback deploys again the resources of the revision with the given number,
+	// or of the revision preceding the latest one if number is 0,
+	// and deletes the deployed resources which are not part of the revision.
+	// It returns the new revision recorded.
+	Rollback(ctx context.Context, number int) (api.DeployRevision, error)
 }
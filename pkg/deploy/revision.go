@@ -0,0 +1,203 @@
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/user"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/klog"
+	"sigs.k8s.io/yaml"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+)
+
+// maxRevisions is the number of revisions kept in the deploy history of a component
+const maxRevisions = 10
+
+const (
+	// revisionKey is the key of the revision Secret containing the description of the revision, as JSON
+	revisionKey = "revision.json"
+	// manifestsKey is the key of the revision Secret containing the manifests deployed, as YAML documents
+	manifestsKey = "manifests.yaml"
+)
+
+// revision is a deploy revision, along with the manifests deployed
+type revision struct {
+	api.DeployRevision
+	manifests []unstructured.Unstructured
+}
+
+// getRevisionSecretName returns the name of the Secret storing the revision of the component
+func getRevisionSecretName(componentName string, number int) string {
+	return fmt.Sprintf("odo-deploy-%s-%d", componentName, number)
+}
+
+// listRevisions returns the revisions stored for the component, sorted from the oldest to the newest
+func (o *DeployClient) listRevisions(componentName string, appName string) ([]revision, error) {
+	secrets, err := o.kubeClient.ListSecrets(odolabels.GetDeployRevisionSelector(componentName, appName))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]revision, 0, len(secrets))
+	for _, secret := range secrets {
+		rev, err := decodeRevision(secret)
+		if err != nil {
+			klog.V(3).Infof("ignoring invalid revision Secret %q: %v", secret.GetName(), err)
+			continue
+		}
+		result = append(result, rev)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Revision < result[j].Revision
+	})
+	return result, nil
+}
+
+// getDevfileHash returns the hash of the content of the Devfile of the component
+func (o *DeployClient) getDevfileHash(ctx context.Context) (string, error) {
+	devfileContent, err := o.fs.ReadFile(odocontext.GetDevfilePath(ctx))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(devfileContent)), nil
+}
+
+// recordRevision stores a new revision for the component, with the manifests and images deployed from the Devfile with the devfileHash hash,
+// and removes the oldest revisions exceeding the history limit
+func (o *DeployClient) recordRevision(ctx context.Context, manifests []unstructured.Unstructured, images map[string]string, devfileHash string, description string) (api.DeployRevision, error) {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+	)
+
+	revisions, err := o.listRevisions(componentName, appName)
+	if err != nil {
+		return api.DeployRevision{}, err
+	}
+	number := 1
+	if len(revisions) > 0 {
+		number = revisions[len(revisions)-1].Revision + 1
+	}
+
+	rev := revision{
+		DeployRevision: api.DeployRevision{
+			Revision:    number,
+			Timestamp:   time.Now().UTC().Truncate(time.Second),
+			User:        o.getCurrentUser(),
+			DevfileHash: devfileHash,
+			Images:      images,
+			Description: description,
+		},
+		manifests: manifests,
+	}
+	data, err := encodeRevision(rev)
+	if err != nil {
+		return api.DeployRevision{}, err
+	}
+	objectMeta := metav1.ObjectMeta{
+		Name:   getRevisionSecretName(componentName, number),
+		Labels: odolabels.GetDeployRevisionLabels(componentName, appName, number),
+	}
+	err = o.kubeClient.CreateSecret(objectMeta, data, metav1.OwnerReference{})
+	if err != nil {
+		return api.DeployRevision{}, fmt.Errorf("unable to record the deploy revision: %w", err)
+	}
+
+	revisions = append(revisions, rev)
+	for len(revisions) > maxRevisions {
+		name := getRevisionSecretName(componentName, revisions[0].Revision)
+		if err = o.kubeClient.DeleteSecret(name, o.kubeClient.GetCurrentNamespace()); err != nil {
+			klog.V(3).Infof("unable to delete the old deploy revision %q: %v", name, err)
+		}
+		revisions = revisions[1:]
+	}
+	return rev.DeployRevision, nil
+}
+
+// getCurrentUser returns the user of the current kubeconfig context, or the local user if it cannot be determined
+func (o *DeployClient) getCurrentUser() string {
+	if config := o.kubeClient.GetConfig(); config != nil {
+		if rawConfig, err := config.RawConfig(); err == nil {
+			if current, ok := rawConfig.Contexts[rawConfig.CurrentContext]; ok && current.AuthInfo != "" {
+				return current.AuthInfo
+			}
+		}
+	}
+	if current, err := user.Current(); err == nil {
+		return current.Username
+	}
+	return ""
+}
+
+// encodeRevision returns the content of the Secret storing the revision
+func encodeRevision(rev revision) (map[string]string, error) {
+	metadata, err := json.Marshal(rev.DeployRevision)
+	if err != nil {
+		return nil, err
+	}
+	var manifests bytes.Buffer
+	for i := range rev.manifests {
+		content, err := yaml.Marshal(rev.manifests[i].Object)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			manifests.WriteString("---\n")
+		}
+		manifests.Write(content)
+	}
+	return map[string]string{
+		revisionKey:  string(metadata),
+		manifestsKey: manifests.String(),
+	}, nil
+}
+
+// decodeRevision returns the revision stored in the Secret.
+// The revision number of the content must match the number in the labels of the Secret
+func decodeRevision(secret corev1.Secret) (revision, error) {
+	number, err := odolabels.GetDeployRevision(secret.GetLabels())
+	if err != nil {
+		return revision{}, err
+	}
+	var rev revision
+	metadata, ok := secret.Data[revisionKey]
+	if !ok {
+		return revision{}, fmt.Errorf("key %q not found", revisionKey)
+	}
+	err = json.Unmarshal(metadata, &rev.DeployRevision)
+	if err != nil {
+		return revision{}, err
+	}
+	if rev.Revision != number {
+		return revision{}, fmt.Errorf("revision %d does not match the revision %d of the labels", rev.Revision, number)
+	}
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(secret.Data[manifestsKey]), 4096)
+	for {
+		var obj map[string]interface{}
+		err = decoder.Decode(&obj)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return revision{}, err
+		}
+		if len(obj) == 0 {
+			continue
+		}
+		rev.manifests = append(rev.manifests, unstructured.Unstructured{Object: obj})
+	}
+	return rev, nil
+}
@@ -0,0 +1,135 @@
+package deploy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+)
+
+func newResource(apiVersion, kind, name string) unstructured.Unstructured {
+	u := unstructured.Unstructured{}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	u.SetName(name)
+	return u
+}
+
+func TestEncodeDecodeRevision(t *testing.T) {
+	deployment := newResource("apps/v1", "Deployment", "my-app")
+	err := unstructured.SetNestedSlice(deployment.Object, []interface{}{
+		map[string]interface{}{"name": "runtime", "image": "quay.io/user/my-app:latest"},
+	}, "spec", "template", "spec", "containers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rev := revision{
+		DeployRevision: api.DeployRevision{
+			Revision:    3,
+			Timestamp:   time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+			User:        "developer",
+			DevfileHash: "0123456789abcdef",
+			Images:      map[string]string{"quay.io/user/my-app": "quay.io/user/my-app@sha256:1234"},
+		},
+		manifests: []unstructured.Unstructured{deployment, newResource("v1", "Service", "my-app")},
+	}
+
+	data, err := encodeRevision(rev)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: odolabels.GetDeployRevisionLabels("my-app", "app", 3),
+		},
+		Data: map[string][]byte{},
+	}
+	for key, value := range data {
+		secret.Data[key] = []byte(value)
+	}
+	got, err := decodeRevision(secret)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(rev, got, cmp.AllowUnexported(revision{})); diff != "" {
+		t.Errorf("decodeRevision() mismatch (-want +got):\n%s", diff)
+	}
+
+	secret.SetLabels(odolabels.GetDeployRevisionLabels("my-app", "app", 4))
+	if _, err = decodeRevision(secret); err == nil {
+		t.Errorf("decodeRevision() expected an error when the revision does not match the labels")
+	}
+}
+
+func TestFindRevision(t *testing.T) {
+	revisions := []revision{
+		{DeployRevision: api.DeployRevision{Revision: 2}},
+		{DeployRevision: api.DeployRevision{Revision: 3}},
+		{DeployRevision: api.DeployRevision{Revision: 5}},
+	}
+	tests := []struct {
+		name      string
+		revisions []revision
+		number    int
+		want      int
+		wantErr   bool
+	}{
+		{
+			name:      "previous revision",
+			revisions: revisions,
+			want:      3,
+		},
+		{
+			name:      "specific revision",
+			revisions: revisions,
+			number:    2,
+			want:      2,
+		},
+		{
+			name:      "revision not found",
+			revisions: revisions,
+			number:    4,
+			wantErr:   true,
+		},
+		{
+			name:      "no previous revision",
+			revisions: revisions[:1],
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findRevision(tt.revisions, tt.number)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("findRevision() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.Revision != tt.want {
+				t.Errorf("findRevision() = %d, want %d", got.Revision, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetResourcesToPrune(t *testing.T) {
+	deployment := newResource("apps/v1", "Deployment", "my-app")
+	service := newResource("v1", "Service", "my-app")
+	route := newResource("route.openshift.io/v1", "Route", "my-app")
+	owned := newResource("v1", "ConfigMap", "owned")
+	owned.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "route.openshift.io/v1", Kind: "Route", Name: "my-app"}})
+	terminating := newResource("v1", "ConfigMap", "terminating")
+	terminating.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+
+	got := getResourcesToPrune(
+		[]unstructured.Unstructured{deployment, service, route, owned, terminating},
+		[]unstructured.Unstructured{newResource("apps/v1", "Deployment", "my-app"), newResource("v1", "Service", "my-app")},
+	)
+	if diff := cmp.Diff([]unstructured.Unstructured{route}, got); diff != "" {
+		t.Errorf("getResourcesToPrune() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -22,6 +22,9 @@ type StartOptions struct {
 	WatchFiles bool
 	// Variables to override in the Devfile
 	Variables map[string]string
+	// if ForwardBindings is set, the Services bound by the ServiceBindings resolved from the cluster
+	// are port-forwarded, so they can be reached from the component when it does not run on the cluster
+	ForwardBindings bool
 }
 
 type Client interface {
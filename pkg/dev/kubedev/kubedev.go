@@ -12,11 +12,14 @@ import (
 	"github.com/redhat-developer/odo/pkg/devfile"
 	"github.com/redhat-developer/odo/pkg/exec"
 	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/portForward"
 	"github.com/redhat-developer/odo/pkg/preference"
 	"github.com/redhat-developer/odo/pkg/sync"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 
+	corev1 "k8s.io/api/core/v1"
+	k8swatch "k8s.io/apimachinery/pkg/watch"
 	"k8s.io/klog"
 
 	"github.com/redhat-developer/odo/pkg/devfile/adapters"
@@ -114,6 +117,23 @@ func (o *DevClient) Start(
 	}
 	klog.V(4).Infoln("Successfully created inner-loop resources")
 
+	// The Services of the components bound to the component are watched, to project their endpoints again when they change
+	boundComponents, err := binding.GetBoundComponents(*devfileObj, path)
+	if err != nil {
+		return err
+	}
+	var newBindingsWatcher func(ctx context.Context) (k8swatch.Interface, error)
+	if len(boundComponents) > 0 {
+		selector := odolabels.GetSelectorForComponents(boundComponents, odocontext.GetApplication(ctx))
+		newBindingsWatcher = func(ctx context.Context) (k8swatch.Interface, error) {
+			w, err := o.kubernetesClient.ServiceWatcher(ctx, selector)
+			if err != nil {
+				return nil, fmt.Errorf("unable to watch the Services of the components bound: %w", err)
+			}
+			return k8swatch.Filter(w, isServiceChange), nil
+		}
+	}
+
 	watchParameters := watch.WatchParameters{
 		DevfilePath:         devfilePath,
 		Path:                path,
@@ -129,6 +149,7 @@ func (o *DevClient) Start(
 		RandomPorts:         options.RandomPorts,
 		WatchFiles:          options.WatchFiles,
 		WatchCluster:        true,
+		NewBindingsWatcher:  newBindingsWatcher,
 		ErrOut:              errOut,
 		PromptMessage:       promptMessage,
 	}
@@ -175,3 +196,16 @@ func (o *DevClient) regenerateComponentAdapterFromWatchParams(parameters watch.W
 		},
 	), nil
 }
+
+// isServiceChange keeps the events of the Services created, modified or deleted, and drops the other events
+// (errors, bookmarks) of the watcher on the Services of the components bound
+func isServiceChange(in k8swatch.Event) (k8swatch.Event, bool) {
+	if _, ok := in.Object.(*corev1.Service); !ok {
+		return in, false
+	}
+	switch in.Type {
+	case k8swatch.Added, k8swatch.Modified, k8swatch.Deleted:
+		return in, true
+	}
+	return in, false
+}
@@ -0,0 +1,399 @@
+package podmandev
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8swatch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/binding"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+const (
+	// the Services of the bindings are forwarded to local ports in this range,
+	// after the range of the ports forwarded to the endpoints of the component
+	bindingsForwardStartPort = 50001
+	bindingsForwardEndPort   = 60000
+	// bindingsForwardAddress is the address the Services of the bindings are forwarded on.
+	// Only the loopback address is used, so the services are not exposed to the network of the host;
+	// the containers reach it through host.containers.internal, with the network mode returned by getBindingsNetwork
+	bindingsForwardAddress = "127.0.0.1"
+
+	// componentBindingsPollInterval is the interval at which the endpoints of the components bound are checked,
+	// as podman does not provide a way to watch the pods
+	componentBindingsPollInterval = 5 * time.Second
+)
+
+// writeBindingFiles writes the entries of the native bindings as files in the directories of the bindings in dir,
+// which are mounted into the containers, and removes the entries and the bindings not present anymore.
+// As the entries contain credentials, dir is accessible by the current user only, so that the files cannot be read
+// by the other users of the host. The directories of the bindings are mounted directly into the containers,
+// where the files are readable by any user, as the containers do not necessarily run as root.
+func writeBindingFiles(dir string, bindings []binding.NativeBinding) error {
+	if len(bindings) > 0 {
+		err := os.MkdirAll(dir, 0700)
+		if err != nil {
+			return err
+		}
+		// MkdirAll and WriteFile do not change the mode of existing directories and files
+		err = os.Chmod(dir, 0700)
+		if err != nil {
+			return err
+		}
+	}
+	names := make(map[string]bool, len(bindings))
+	for _, b := range bindings {
+		names[b.Name] = true
+		bindingDir := filepath.Join(dir, b.Name)
+		err := os.MkdirAll(bindingDir, 0755)
+		if err != nil {
+			return err
+		}
+		err = os.Chmod(bindingDir, 0755)
+		if err != nil {
+			return err
+		}
+		for key, value := range b.Entries {
+			file := filepath.Join(bindingDir, key)
+			err = os.WriteFile(file, []byte(value), 0644)
+			if err != nil {
+				return err
+			}
+			err = os.Chmod(file, 0644)
+			if err != nil {
+				return err
+			}
+		}
+		files, err := os.ReadDir(bindingDir)
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			if _, ok := b.Entries[file.Name()]; !ok {
+				err = os.RemoveAll(filepath.Join(bindingDir, file.Name()))
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	dirs, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, d := range dirs {
+		if !names[d.Name()] {
+			err = os.RemoveAll(filepath.Join(dir, d.Name()))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// bindingForward is a port-forwarding from a local port to the Service bound by a binding resolved from the cluster
+type bindingForward struct {
+	// service is the Service bound, and port the port of the Service
+	service string
+	port    int
+	// target is the pod and port the local port is forwarded to
+	target    string
+	localPort int
+	stopChan  chan struct{}
+}
+
+// getBindingsNetwork returns the network mode of the pod allowing its containers to reach the Services of the bindings
+// forwarded on the loopback address of the host, and warns if the network of podman does not allow it
+func (o *DevClient) getBindingsNetwork(bindings []binding.NativeBinding) string {
+	if len(bindings) == 0 {
+		return ""
+	}
+	network, err := o.podmanClient.HostLoopbackNetwork()
+	if err != nil {
+		klog.V(3).Infof("unable to get the network allowing the containers to reach the loopback address of the host: %v", err)
+	}
+	if network == "" {
+		log.Warning("The network of podman does not allow the containers to reach the loopback address of the host, " +
+			"the Services forwarded for the bindings are not reachable from the pod. Use rootless podman with the slirp4netns network to forward the bindings")
+	}
+	return network
+}
+
+// forwardBindings forwards a local port to the Service bound by each binding resolved from the cluster,
+// and replaces the host and port entries of the bindings with the forwarded address, reachable from the containers.
+// The forwardings already started to the same pods and ports are kept, and the other ones are stopped.
+func (o *DevClient) forwardBindings(bindings []binding.NativeBinding, errOut io.Writer) {
+	o.bindingForwardsMu.Lock()
+	defer o.bindingForwardsMu.Unlock()
+	if o.bindingForwards == nil {
+		o.bindingForwards = map[string]*bindingForward{}
+	}
+	forwarded := make(map[string]bool, len(bindings))
+	for i := range bindings {
+		b := &bindings[i]
+		hostKey, portKey := findHostPortKeys(b.Entries)
+		if hostKey == "" || portKey == "" {
+			klog.V(4).Infof("no host and port found in the binding %q, not forwarded", b.Name)
+			continue
+		}
+		fw, err := o.getBindingForward(b.Name, b.Entries[hostKey], b.Entries[portKey], errOut)
+		if err != nil {
+			log.Warningf("unable to forward the Service of the binding %q: %v", b.Name, err)
+			continue
+		}
+		forwarded[b.Name] = true
+		b.Entries[hostKey] = binding.PodmanHost
+		b.Entries[portKey] = strconv.Itoa(fw.localPort)
+	}
+	for name, fw := range o.bindingForwards {
+		if !forwarded[name] {
+			close(fw.stopChan)
+			delete(o.bindingForwards, name)
+		}
+	}
+}
+
+// getBindingForward returns the port-forwarding to the Service at host:port for the binding name,
+// starting it if it is not running yet. It must be called with bindingForwardsMu locked
+func (o *DevClient) getBindingForward(name string, host string, port string, errOut io.Writer) (*bindingForward, error) {
+	serviceName, namespace := parseServiceHost(host)
+	if namespace != "" && namespace != o.kubeClient.GetCurrentNamespace() {
+		return nil, fmt.Errorf("the Service %q is not in the current namespace %q", host, o.kubeClient.GetCurrentNamespace())
+	}
+	servicePort, err := strconv.Atoi(port)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port %q: %w", port, err)
+	}
+	service, err := o.kubeClient.GetService(serviceName)
+	if err != nil {
+		return nil, err
+	}
+	pod, err := o.kubeClient.GetRunningPodFromSelector(labels.SelectorFromSet(service.Spec.Selector).String())
+	if err != nil {
+		return nil, fmt.Errorf("no running pod found for the Service %q: %w", serviceName, err)
+	}
+	targetPort, err := getTargetPort(service, servicePort, pod)
+	if err != nil {
+		return nil, err
+	}
+
+	target := fmt.Sprintf("%s:%d", pod.Name, targetPort)
+	if fw, ok := o.bindingForwards[name]; ok {
+		if fw.target == target {
+			return fw, nil
+		}
+		close(fw.stopChan)
+		delete(o.bindingForwards, name)
+	}
+
+	usedPorts := make([]int, 0, len(o.bindingForwards))
+	for _, fw := range o.bindingForwards {
+		usedPorts = append(usedPorts, fw.localPort)
+	}
+	localPort, err := util.NextFreePort(bindingsForwardStartPort, bindingsForwardEndPort, usedPorts)
+	if err != nil {
+		return nil, err
+	}
+	fw := &bindingForward{
+		service:   serviceName,
+		port:      servicePort,
+		target:    target,
+		localPort: localPort,
+		stopChan:  make(chan struct{}),
+	}
+	go func() {
+		err := o.kubeClient.SetupPortForwardingOnAddresses(pod, []string{bindingsForwardAddress}, []string{fmt.Sprintf("%d:%d", localPort, targetPort)}, io.Discard, errOut, fw.stopChan)
+		if err != nil {
+			o.removeFailedBindingForward(name, fw, err)
+		}
+	}()
+	o.bindingForwards[name] = fw
+	return fw, nil
+}
+
+// removeFailedBindingForward removes the port-forwarding fw of the binding name, if it has not been replaced nor stopped,
+// so it is started again at the next reconciliation, and reports the failure
+func (o *DevClient) removeFailedBindingForward(name string, fw *bindingForward, err error) {
+	o.bindingForwardsMu.Lock()
+	defer o.bindingForwardsMu.Unlock()
+	if o.bindingForwards[name] != fw {
+		klog.V(2).Infof("port-forwarding of the binding %q stopped: %v", name, err)
+		return
+	}
+	delete(o.bindingForwards, name)
+	log.Warningf("the port-forwarding to the Service %s:%d of the binding %q failed, the binding is not reachable until the next update: %v", fw.service, fw.port, name, err)
+}
+
+// getBindingForwards returns the running port-forwardings of the bindings, sorted by binding name
+func (o *DevClient) getBindingForwards() []bindingForwardStatus {
+	o.bindingForwardsMu.Lock()
+	defer o.bindingForwardsMu.Unlock()
+	result := make([]bindingForwardStatus, 0, len(o.bindingForwards))
+	for name, fw := range o.bindingForwards {
+		result = append(result, bindingForwardStatus{
+			name:      name,
+			service:   fw.service,
+			port:      fw.port,
+			localPort: fw.localPort,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].name < result[j].name
+	})
+	return result
+}
+
+// bindingForwardStatus describes a running port-forwarding of a binding
+type bindingForwardStatus struct {
+	name      string
+	service   string
+	port      int
+	localPort int
+}
+
+// stopBindingForwards stops all the port-forwardings of the bindings
+func (o *DevClient) stopBindingForwards() {
+	o.bindingForwardsMu.Lock()
+	defer o.bindingForwardsMu.Unlock()
+	for name, fw := range o.bindingForwards {
+		close(fw.stopChan)
+		delete(o.bindingForwards, name)
+	}
+}
+
+// findHostPortKeys returns the keys of the host and port entries, named host and port,
+// or ending with _host and _port, with the same prefix, case insensitive
+func findHostPortKeys(entries map[string]string) (string, string) {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, hostKey := range keys {
+		lower := strings.ToLower(hostKey)
+		if lower != "host" && !strings.HasSuffix(lower, "_host") {
+			continue
+		}
+		prefix := hostKey[:len(hostKey)-len("host")]
+		for _, portKey := range keys {
+			if len(portKey) == len(prefix)+len("port") && strings.HasPrefix(portKey, prefix) && strings.EqualFold(portKey[len(prefix):], "port") {
+				return hostKey, portKey
+			}
+		}
+	}
+	return "", ""
+}
+
+// parseServiceHost returns the name and namespace of the Service from its host name,
+// in the forms <name>, <name>.<namespace> or <name>.<namespace>.svc[.<cluster domain>]
+func parseServiceHost(host string) (name string, namespace string) {
+	parts := strings.Split(host, ".")
+	if len(parts) > 1 {
+		return parts[0], parts[1]
+	}
+	return parts[0], ""
+}
+
+// getTargetPort returns the port of the pod targeted by the port servicePort of the Service
+func getTargetPort(service *corev1.Service, servicePort int, pod *corev1.Pod) (int, error) {
+	for _, port := range service.Spec.Ports {
+		if int(port.Port) != servicePort {
+			continue
+		}
+		switch {
+		case port.TargetPort.Type == intstr.String:
+			for _, container := range pod.Spec.Containers {
+				for _, containerPort := range container.Ports {
+					if containerPort.Name == port.TargetPort.StrVal {
+						return int(containerPort.ContainerPort), nil
+					}
+				}
+			}
+			return 0, fmt.Errorf("port %q not found in the pod %q", port.TargetPort.StrVal, pod.Name)
+		case port.TargetPort.IntVal != 0:
+			return int(port.TargetPort.IntVal), nil
+		default:
+			return servicePort, nil
+		}
+	}
+	return 0, fmt.Errorf("port %d not found in the Service %q", servicePort, service.Name)
+}
+
+// watchComponentBindings returns a watcher sending an event when the endpoints of the components, running on podman
+// and bound to the component, change
+func (o *DevClient) watchComponentBindings(ctx context.Context, appName string, components []string, interval time.Duration) k8swatch.Interface {
+	ch := make(chan k8swatch.Event)
+	watcher := k8swatch.NewProxyWatcher(ch)
+	getEndpoint := binding.GetPodmanComponentEndpoint(o.podmanClient, appName)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		previous, err := getComponentEndpoints(getEndpoint, components)
+		if err != nil {
+			klog.V(4).Infof("unable to get the endpoints of the components bound: %v", err)
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-watcher.StopChan():
+				return
+			case <-ticker.C:
+			}
+			current, err := getComponentEndpoints(getEndpoint, components)
+			if err != nil {
+				// the endpoints are considered unchanged, to not restart the pod on transient errors
+				klog.V(4).Infof("unable to get the endpoints of the components bound: %v", err)
+				continue
+			}
+			if current == previous {
+				continue
+			}
+			klog.V(4).Infof("endpoints of the components bound changed: %q -> %q", previous, current)
+			previous = current
+			select {
+			case ch <- k8swatch.Event{Type: k8swatch.Modified}:
+			case <-ctx.Done():
+				return
+			case <-watcher.StopChan():
+				return
+			}
+		}
+	}()
+	return watcher
+}
+
+// getComponentEndpoints returns a description of the endpoints of the components, to detect their changes
+func getComponentEndpoints(getEndpoint binding.ComponentEndpointFunc, components []string) (string, error) {
+	endpoints := make([]string, 0, len(components))
+	for _, component := range components {
+		host, port, found, err := getEndpoint(component)
+		if err != nil {
+			return "", err
+		}
+		if !found {
+			endpoints = append(endpoints, component+"=")
+			continue
+		}
+		endpoints = append(endpoints, fmt.Sprintf("%s=%s:%d", component, host, port))
+	}
+	return strings.Join(endpoints, ","), nil
+}
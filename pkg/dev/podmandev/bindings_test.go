@@ -0,0 +1,142 @@
+package podmandev
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/redhat-developer/odo/pkg/binding"
+)
+
+func Test_findHostPortKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		entries  map[string]string
+		wantHost string
+		wantPort string
+	}{
+		{
+			name:     "host and port",
+			entries:  map[string]string{"host": "my-db", "port": "5432", "username": "user"},
+			wantHost: "host",
+			wantPort: "port",
+		},
+		{
+			name:     "prefixed environment variables",
+			entries:  map[string]string{"PG_HOST": "my-db", "PG_PORT": "5432", "PG_USER": "user"},
+			wantHost: "PG_HOST",
+			wantPort: "PG_PORT",
+		},
+		{
+			name:    "no port with the same prefix",
+			entries: map[string]string{"PG_HOST": "my-db", "MQ_PORT": "5672"},
+		},
+		{
+			name:    "no host",
+			entries: map[string]string{"uri": "postgresql://my-db:5432"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotHost, gotPort := findHostPortKeys(tt.entries)
+			if gotHost != tt.wantHost || gotPort != tt.wantPort {
+				t.Errorf("findHostPortKeys() = (%q, %q), want (%q, %q)", gotHost, gotPort, tt.wantHost, tt.wantPort)
+			}
+		})
+	}
+}
+
+func Test_getTargetPort(t *testing.T) {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-db"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Port: 5432, TargetPort: intstr.FromString("postgres")},
+				{Port: 9187, TargetPort: intstr.FromInt(9000)},
+				{Port: 8000},
+			},
+		},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-db-1"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:  "postgres",
+				Ports: []corev1.ContainerPort{{Name: "postgres", ContainerPort: 5433}},
+			}},
+		},
+	}
+	tests := []struct {
+		name        string
+		servicePort int
+		want        int
+		wantErr     bool
+	}{
+		{name: "named target port", servicePort: 5432, want: 5433},
+		{name: "numeric target port", servicePort: 9187, want: 9000},
+		{name: "no target port", servicePort: 8000, want: 8000},
+		{name: "unknown port", servicePort: 1234, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getTargetPort(service, tt.servicePort, pod)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getTargetPort() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("getTargetPort() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_writeBindingFiles(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "bindings")
+	// a file written with a restricted mode, and a binding not present anymore
+	if err := os.MkdirAll(filepath.Join(dir, "db"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "db", "password"), []byte("old"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "removed"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	err := writeBindingFiles(dir, []binding.NativeBinding{
+		{Name: "db", Entries: map[string]string{"password": "secret", "host": "my-db"}},
+	})
+	if err != nil {
+		t.Fatalf("writeBindingFiles() unexpected error: %v", err)
+	}
+
+	wantModes := map[string]os.FileMode{
+		dir:                                  os.ModeDir | 0700,
+		filepath.Join(dir, "db"):             os.ModeDir | 0755,
+		filepath.Join(dir, "db", "password"): 0644,
+		filepath.Join(dir, "db", "host"):     0644,
+	}
+	for path, want := range wantModes {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatalf("unable to stat %q: %v", path, err)
+		}
+		if got := info.Mode(); got != want {
+			t.Errorf("mode of %q = %v, want %v", path, got, want)
+		}
+	}
+	content, err := os.ReadFile(filepath.Join(dir, "db", "password"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "secret" {
+		t.Errorf("content of the password file = %q, want %q", content, "secret")
+	}
+	if _, err = os.Stat(filepath.Join(dir, "removed")); !os.IsNotExist(err) {
+		t.Errorf("the directory of the binding not present anymore is not removed: %v", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 func (o *DevClient) CleanupResources(ctx context.Context, out io.Writer) error {
 	fmt.Printf("Cleaning up resources\n")
+	o.stopBindingForwards()
 	if o.deployedPod == nil {
 		return nil
 	}
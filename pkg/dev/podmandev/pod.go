@@ -2,11 +2,13 @@ package podmandev
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"github.com/devfile/library/pkg/devfile/generator"
 	"github.com/devfile/library/pkg/devfile/parser"
 	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/binding"
 	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/devfile/adapters/kubernetes/utils"
 	"github.com/redhat-developer/odo/pkg/labels"
@@ -25,6 +27,8 @@ func createPodFromComponent(
 	runCommand string,
 	debugCommand string,
 	usedPorts []int,
+	bindings []binding.NativeBinding,
+	bindingsDir string,
 ) (*corev1.Pod, []api.ForwardedPort, error) {
 	containers, err := generator.GetContainers(devfileObj, common.DevfileOptions{})
 	if err != nil {
@@ -82,6 +86,14 @@ func createPodFromComponent(
 		}
 	}
 
+	for _, b := range bindings {
+		volumes = append(volumes, binding.ProjectNativeBinding(containers, b, corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: filepath.Join(bindingsDir, b.Name),
+			},
+		}, ""))
+	}
+
 	pod := corev1.Pod{
 		Spec: corev1.PodSpec{
 			Containers: containers,
@@ -10,6 +10,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/binding"
 	"github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile/generator"
 	"github.com/redhat-developer/odo/pkg/version"
@@ -18,6 +19,8 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
+
+	specApi "github.com/redhat-developer/service-binding-operator/apis/spec/v1alpha3"
 )
 
 var (
@@ -122,6 +125,7 @@ func Test_createPodFromComponent(t *testing.T) {
 		buildCommand  string
 		runCommand    string
 		debugCommand  string
+		bindings      []binding.NativeBinding
 	}
 	tests := []struct {
 		name        string
@@ -327,12 +331,56 @@ func Test_createPodFromComponent(t *testing.T) {
 				return pod
 			},
 		},
+		{
+			name: "basic component with native binding",
+			args: args{
+				devfileObj: func() parser.DevfileObj {
+					data, _ := data.NewDevfileData(string(data.APISchemaVersion200))
+					_ = data.AddCommands([]v1alpha2.Command{command})
+					_ = data.AddComponents([]v1alpha2.Component{baseComponent})
+					return parser.DevfileObj{
+						Data: data,
+					}
+				},
+				componentName: devfileName,
+				appName:       appName,
+				bindings: []binding.NativeBinding{
+					{
+						Name:      "db",
+						Directory: "postgres",
+						Env:       []specApi.EnvMapping{{Name: "DB_USER", Key: "username"}, {Name: "DB_MISSING", Key: "missing"}},
+						Entries:   map[string]string{"username": "user", "type": "postgresql"},
+					},
+				},
+			},
+			wantPod: func() *corev1.Pod {
+				pod := basePod.DeepCopy()
+				pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+					Name: "binding-db",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{
+							Path: "/project/.odo/bindings/db",
+						},
+					},
+				})
+				pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env,
+					corev1.EnvVar{Name: "SERVICE_BINDING_ROOT", Value: "/bindings"},
+					corev1.EnvVar{Name: "DB_USER", Value: "user"},
+				)
+				pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+					Name:      "binding-db",
+					MountPath: "/bindings/postgres",
+					ReadOnly:  true,
+				})
+				return pod
+			},
+		},
 
 		// TODO: Add test cases.
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, gotFwPorts, err := createPodFromComponent(tt.args.devfileObj(), tt.args.componentName, tt.args.appName, tt.args.buildCommand, tt.args.runCommand, tt.args.debugCommand, []int{40001, 40002})
+			got, gotFwPorts, err := createPodFromComponent(tt.args.devfileObj(), tt.args.componentName, tt.args.appName, tt.args.buildCommand, tt.args.runCommand, tt.args.debugCommand, []int{40001, 40002}, tt.args.bindings, "/project/.odo/bindings")
 			if (err != nil) != tt.wantErr {
 				t.Errorf("createPodFromComponent() error = %v, wantErr %v", err, tt.wantErr)
 				return
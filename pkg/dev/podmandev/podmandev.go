@@ -6,11 +6,14 @@ import (
 	"io"
 	"path/filepath"
 	"strings"
+	gosync "sync"
 
+	"github.com/redhat-developer/odo/pkg/binding"
 	"github.com/redhat-developer/odo/pkg/dev"
 	"github.com/redhat-developer/odo/pkg/dev/common"
 	"github.com/redhat-developer/odo/pkg/devfile/adapters"
 	"github.com/redhat-developer/odo/pkg/exec"
+	"github.com/redhat-developer/odo/pkg/kclient"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/podman"
 	"github.com/redhat-developer/odo/pkg/state"
@@ -18,6 +21,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/watch"
 
 	corev1 "k8s.io/api/core/v1"
+	k8swatch "k8s.io/apimachinery/pkg/watch"
 )
 
 const (
@@ -29,19 +33,26 @@ const (
 
 type DevClient struct {
 	podmanClient podman.Client
-	syncClient   sync.Client
-	execClient   exec.Client
-	stateClient  state.Client
-	watchClient  watch.Client
+	// kubeClient is used to read the resources bound by the native bindings, it can be nil
+	kubeClient  kclient.ClientInterface
+	syncClient  sync.Client
+	execClient  exec.Client
+	stateClient state.Client
+	watchClient watch.Client
 
 	deployedPod *corev1.Pod
 	usedPorts   []int
+	// bindingForwards are the port-forwardings to the Services of the bindings resolved from the cluster, by binding name
+	bindingForwards map[string]*bindingForward
+	// bindingForwardsMu protects bindingForwards, updated when a port-forwarding fails
+	bindingForwardsMu gosync.Mutex
 }
 
 var _ dev.Client = (*DevClient)(nil)
 
 func NewDevClient(
 	podmanClient podman.Client,
+	kubeClient kclient.ClientInterface,
 	syncClient sync.Client,
 	execClient exec.Client,
 	stateClient state.Client,
@@ -49,6 +60,7 @@ func NewDevClient(
 ) *DevClient {
 	return &DevClient{
 		podmanClient: podmanClient,
+		kubeClient:   kubeClient,
 		syncClient:   syncClient,
 		execClient:   execClient,
 		stateClient:  stateClient,
@@ -79,6 +91,18 @@ func (o *DevClient) Start(
 
 	watch.PrintInfoMessage(out, path, options.WatchFiles, promptMessage)
 
+	// The endpoints of the components bound to the component are polled, to project them again when they change
+	boundComponents, err := binding.GetBoundComponents(*devfileObj, path)
+	if err != nil {
+		return err
+	}
+	var newBindingsWatcher func(ctx context.Context) (k8swatch.Interface, error)
+	if len(boundComponents) > 0 {
+		newBindingsWatcher = func(ctx context.Context) (k8swatch.Interface, error) {
+			return o.watchComponentBindings(ctx, appName, boundComponents, componentBindingsPollInterval), nil
+		}
+	}
+
 	watchParameters := watch.WatchParameters{
 		DevfilePath:         devfilePath,
 		Path:                path,
@@ -94,6 +118,7 @@ func (o *DevClient) Start(
 		RandomPorts:         options.RandomPorts,
 		WatchFiles:          options.WatchFiles,
 		WatchCluster:        false,
+		NewBindingsWatcher:  newBindingsWatcher,
 		Out:                 out,
 		ErrOut:              errOut,
 		PromptMessage:       promptMessage,
@@ -10,11 +10,13 @@ import (
 	"github.com/fatih/color"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/binding"
 	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/dev"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/util"
 	"github.com/redhat-developer/odo/pkg/watch"
 
 	corev1 "k8s.io/api/core/v1"
@@ -37,7 +39,7 @@ func (o *DevClient) reconcile(
 		path          = filepath.Dir(devfilePath)
 	)
 
-	pod, fwPorts, err := o.deployPod(ctx, options)
+	pod, fwPorts, err := o.deployPod(ctx, errOut, options)
 	if err != nil {
 		return err
 	}
@@ -109,6 +111,10 @@ func (o *DevClient) reconcile(
 		s := fmt.Sprintf("Forwarding from %s:%d -> %d", fwPort.LocalAddress, fwPort.LocalPort, fwPort.ContainerPort)
 		fmt.Fprintf(out, " -  %s", log.SboldColor(color.FgGreen, s))
 	}
+	for _, fw := range o.getBindingForwards() {
+		s := fmt.Sprintf("Forwarding from %s:%d -> Service %s:%d (binding %s)", bindingsForwardAddress, fw.localPort, fw.service, fw.port, fw.name)
+		fmt.Fprintf(out, " -  %s", log.SboldColor(color.FgGreen, s))
+	}
 	err = o.stateClient.SetForwardedPorts(fwPorts)
 	if err != nil {
 		return err
@@ -119,16 +125,54 @@ func (o *DevClient) reconcile(
 }
 
 // deployPod deploys the component as a Pod in podman
-func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions) (*corev1.Pod, []api.ForwardedPort, error) {
+func (o *DevClient) deployPod(ctx context.Context, errOut io.Writer, options dev.StartOptions) (*corev1.Pod, []api.ForwardedPort, error) {
 	var (
 		appName       = odocontext.GetApplication(ctx)
 		componentName = odocontext.GetComponentName(ctx)
 		devfileObj    = odocontext.GetDevfileObj(ctx)
+		path          = filepath.Dir(odocontext.GetDevfilePath(ctx))
 	)
 
 	spinner := log.Spinner("Deploying pod")
 	defer spinner.End(false)
 
+	// The native bindings are projected from files written in the .odo directory
+	bindings, err := binding.GetNativeBindings(*devfileObj, path, o.kubeClient)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The bindings to other components are resolved from the ports forwarded by their pods
+	notRunning, err := binding.ResolveComponentBindings(bindings, binding.GetPodmanComponentEndpoint(o.podmanClient, appName))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, name := range notRunning {
+		log.Warningf("the component %q is not running on podman, its endpoint is not projected into the pod yet", name)
+	}
+	// The ServiceBindings are resolved from the Secrets generated on the cluster by the Service Binding Operator
+	clusterBindings, notReady, err := binding.GetClusterBindings(*devfileObj, path, o.kubeClient)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, name := range notReady {
+		if o.kubeClient == nil {
+			log.Warningf("unable to access the cluster, the binding %q is not projected into the pod", name)
+			continue
+		}
+		log.Warningf("the binding %q is not ready on the cluster, it is not projected into the pod. Run \"odo dev\" on the cluster first to create it", name)
+	}
+	var network string
+	if options.ForwardBindings && o.kubeClient != nil {
+		network = o.getBindingsNetwork(clusterBindings)
+		o.forwardBindings(clusterBindings, errOut)
+	}
+	bindings = append(bindings, clusterBindings...)
+	bindingsDir := filepath.Join(path, util.DotOdoDirectory, "bindings")
+	err = writeBindingFiles(bindingsDir, bindings)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to write the files of the bindings: %w", err)
+	}
+
 	pod, fwPorts, err := createPodFromComponent(
 		*devfileObj,
 		componentName,
@@ -137,6 +181,8 @@ func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions) (*c
 		options.RunCommand,
 		"",
 		o.usedPorts,
+		bindings,
+		bindingsDir,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -154,7 +200,7 @@ func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions) (*c
 		return nil, nil, err
 	}
 
-	err = o.podmanClient.PlayKube(pod)
+	err = o.podmanClient.PlayKube(pod, network)
 	if err != nil {
 		return nil, nil, err
 	}
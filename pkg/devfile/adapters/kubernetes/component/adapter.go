@@ -22,6 +22,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/devfile/adapters"
 	"github.com/redhat-developer/odo/pkg/devfile/adapters/kubernetes/storage"
 	"github.com/redhat-developer/odo/pkg/devfile/adapters/kubernetes/utils"
+	"github.com/redhat-developer/odo/pkg/devfile/image"
 	"github.com/redhat-developer/odo/pkg/exec"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
@@ -125,7 +126,7 @@ func (a Adapter) Push(ctx context.Context, parameters adapters.PushParameters, c
 	labels := odolabels.GetLabels(a.ComponentName, a.AppName, runtime, odolabels.ComponentDevMode, false)
 
 	var updated bool
-	deployment, updated, err = a.createOrUpdateComponent(deploymentExists, libdevfile.DevfileCommands{
+	deployment, updated, err = a.createOrUpdateComponent(ctx, deploymentExists, libdevfile.DevfileCommands{
 		BuildCmd: parameters.DevfileBuildCmd,
 		RunCmd:   parameters.DevfileRunCmd,
 		DebugCmd: parameters.DevfileDebugCmd,
@@ -356,6 +357,7 @@ func (a Adapter) Push(ctx context.Context, parameters adapters.PushParameters, c
 // with the expected spec.
 // Returns the new deployment and if the generation of the deployment has been updated
 func (a *Adapter) createOrUpdateComponent(
+	ctx context.Context,
 	componentExists bool,
 	commands libdevfile.DevfileCommands,
 	deployment *appsv1.Deployment,
@@ -409,6 +411,12 @@ func (a *Adapter) createOrUpdateComponent(
 		return nil, false, err
 	}
 
+	// Images loaded into a local cluster are not present in any registry, and must not be pulled
+	err = image.SetPullPolicyForLoadedImages(image.GetBuildOptions(ctx), a.Devfile, containers)
+	if err != nil {
+		return nil, false, err
+	}
+
 	initContainers, err := generator.GetInitContainers(a.Devfile)
 	if err != nil {
 		return nil, false, err
@@ -452,6 +460,17 @@ func (a *Adapter) createOrUpdateComponent(
 		return nil, false, err
 	}
 
+	// Project the native bindings, from Secrets created by odo with the entries of the bindings
+	nativeBindings, bindingVolumes, err := a.projectNativeBindings(containers, deploymentObjectMeta.Name)
+	if err != nil {
+		return nil, false, err
+	}
+	allVolumes = append(allVolumes, bindingVolumes...)
+	err = a.pushNativeBindingSecrets(nativeBindings, labels, deployment)
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to create the Secrets of the bindings: %w", err)
+	}
+
 	deployParams := generator.DeploymentParams{
 		TypeMeta:          generator.GetTypeMeta(kclient.DeploymentKind, kclient.DeploymentAPIVersion),
 		ObjectMeta:        deploymentObjectMeta,
@@ -480,6 +499,18 @@ func (a *Adapter) createOrUpdateComponent(
 		deployment.Annotations["app.openshift.io/vcs-uri"] = vcsUri
 	}
 
+	if len(nativeBindings) > 0 {
+		// restart the pods when the entries of the bindings change
+		hash, err := getBindingsHash(nativeBindings)
+		if err != nil {
+			return nil, false, err
+		}
+		if deployment.Spec.Template.Annotations == nil {
+			deployment.Spec.Template.Annotations = make(map[string]string)
+		}
+		deployment.Spec.Template.Annotations[bindingsHashAnnotation] = hash
+	}
+
 	// add the annotations to the service for linking
 	serviceAnnotations := make(map[string]string)
 	serviceAnnotations["service.binding/backend_ip"] = "path={.spec.clusterIP}"
@@ -532,6 +563,13 @@ func (a *Adapter) createOrUpdateComponent(
 		}
 
 		klog.V(2).Infof("Successfully created component %v", componentName)
+		if len(nativeBindings) > 0 {
+			// set the deployment as owner of the Secrets of the bindings
+			err = a.pushNativeBindingSecrets(nativeBindings, labels, deployment)
+			if err != nil {
+				return nil, false, fmt.Errorf("unable to update the Secrets of the bindings: %w", err)
+			}
+		}
 		if len(svc.Spec.Ports) > 0 {
 			ownerReference := generator.GetOwnerReference(deployment)
 			originOwnerRefs := svc.OwnerReferences
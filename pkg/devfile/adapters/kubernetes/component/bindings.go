@@ -0,0 +1,126 @@
+package component
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/devfile/library/pkg/devfile/generator"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/binding"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+// bindingsHashAnnotation is the annotation of the pod template containing the hash of the entries of the native bindings,
+// so the pods are restarted when the entries change
+const bindingsHashAnnotation = "odo.dev/bindings-hash"
+
+// projectNativeBindings projects the native bindings of the devfile into the containers of the deployment deploymentName,
+// from the Secrets generated by odo, and returns the bindings and the volumes to add to the deployment
+func (a *Adapter) projectNativeBindings(containers []corev1.Container, deploymentName string) ([]binding.NativeBinding, []corev1.Volume, error) {
+	bindings, err := binding.GetNativeBindings(a.Devfile, a.Context, a.kubeClient)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The bindings to other components are resolved from the Services of the components, in Dev mode or deployed
+	notRunning, err := binding.ResolveComponentBindings(bindings, binding.GetClusterComponentEndpoint(a.kubeClient, a.AppName))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, name := range notRunning {
+		log.Warningf("the component %q is not running on the cluster, its endpoint is not projected into the component yet", name)
+	}
+	volumes := make([]corev1.Volume, 0, len(bindings))
+	for i := range bindings {
+		bindings[i].Workload = deploymentName
+		secretName := bindings[i].SecretName()
+		volumes = append(volumes, binding.ProjectNativeBinding(containers, bindings[i], corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: secretName},
+		}, secretName))
+	}
+	return bindings, volumes, nil
+}
+
+// pushNativeBindingSecrets creates or updates the Secrets containing the entries of the native bindings,
+// and deletes the Secrets of the native bindings removed from the devfile.
+// The deployment, if not nil, is set as the owner of the Secrets.
+func (a *Adapter) pushNativeBindingSecrets(bindings []binding.NativeBinding, labels map[string]string, deployment *appsv1.Deployment) error {
+	ns := a.kubeClient.GetCurrentNamespace()
+	var ownerReference metav1.OwnerReference
+	if deployment != nil {
+		ownerReference = generator.GetOwnerReference(deployment)
+	}
+
+	pushed := make(map[string]bool, len(bindings))
+	for _, b := range bindings {
+		pushed[b.SecretName()] = true
+		secretLabels := make(map[string]string, len(labels)+1)
+		for k, v := range labels {
+			secretLabels[k] = v
+		}
+		secretLabels[binding.NativeBindingLabel] = b.Name
+
+		secret, err := a.kubeClient.GetSecret(b.SecretName(), ns)
+		if err != nil {
+			if !kerrors.IsNotFound(err) {
+				return err
+			}
+			err = a.kubeClient.CreateSecret(generator.GetObjectMeta(b.SecretName(), ns, secretLabels, nil), b.Entries, ownerReference)
+			if err != nil {
+				return err
+			}
+			klog.V(4).Infof("Secret %s of the binding %s created", b.SecretName(), b.Name)
+			continue
+		}
+		secret.Labels = secretLabels
+		secret.Data = nil
+		secret.StringData = b.Entries
+		if deployment != nil {
+			secret.OwnerReferences = []metav1.OwnerReference{ownerReference}
+		}
+		_, err = a.kubeClient.UpdateSecret(secret, ns)
+		if err != nil {
+			return err
+		}
+		klog.V(4).Infof("Secret %s of the binding %s updated", b.SecretName(), b.Name)
+	}
+
+	selector := fmt.Sprintf("%s,%s", odolabels.GetSelector(a.ComponentName, a.AppName, odolabels.ComponentDevMode, true), binding.NativeBindingLabel)
+	secrets, err := a.kubeClient.ListSecrets(selector)
+	if err != nil {
+		return err
+	}
+	for _, secret := range secrets {
+		if pushed[secret.Name] {
+			continue
+		}
+		err = a.kubeClient.DeleteSecret(secret.Name, ns)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+		klog.V(4).Infof("Secret %s of the binding %s deleted", secret.Name, secret.Labels[binding.NativeBindingLabel])
+	}
+	return nil
+}
+
+// getBindingsHash returns a hash of the entries of the native bindings
+func getBindingsHash(bindings []binding.NativeBinding) (string, error) {
+	entries := make(map[string]map[string]string, len(bindings))
+	for _, b := range bindings {
+		entries[b.Name] = b.Entries
+	}
+	// the keys of the maps are sorted by json.Marshal
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
@@ -36,7 +36,8 @@ func (a *runHandler) ApplyImage(img devfilev1.Component) error {
 }
 
 func (a *runHandler) ApplyKubernetes(kubernetes devfilev1.Component) error {
-	return component.ApplyKubernetes(odolabels.ComponentDevMode, a.appName, a.componentName, a.devfile, kubernetes, a.kubeClient, a.path)
+	_, err := component.ApplyKubernetes(a.ctx, odolabels.ComponentDevMode, a.appName, a.componentName, a.devfile, kubernetes, a.kubeClient, a.path)
+	return err
 }
 
 func (a *runHandler) Execute(devfileCmd devfilev1.Command) error {
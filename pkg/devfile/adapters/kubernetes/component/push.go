@@ -7,6 +7,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/redhat-developer/odo/pkg/binding"
 	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/devfile"
 	"github.com/redhat-developer/odo/pkg/devfile/adapters"
@@ -47,6 +48,11 @@ func (a *Adapter) pushDevfileKubernetesComponents(
 	if err != nil {
 		return nil, fmt.Errorf("error while trying to fetch service(s) from devfile: %w", err)
 	}
+	// the native bindings are projected by odo into the deployment, and not created on the cluster
+	k8sComponents, err = binding.WithoutNativeBindings(a.Devfile, k8sComponents, a.Context)
+	if err != nil {
+		return nil, err
+	}
 
 	// validate if the GVRs represented by Kubernetes inlined components are supported by the underlying cluster
 	err = component.ValidateResourcesExist(a.kubeClient, a.Devfile, k8sComponents, a.Context)
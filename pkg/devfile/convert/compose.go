@@ -0,0 +1,538 @@
+package convert
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"gopkg.in/yaml.v3"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+// ComposeFiles are the names of the Docker Compose files searched in a directory, by order of preference
+var ComposeFiles = []string{"compose.yaml", "compose.yml", "docker-compose.yaml", "docker-compose.yml"}
+
+// supportedServiceFields are the fields of the services which are converted
+var supportedServiceFields = map[string]bool{
+	"image":       true,
+	"build":       true,
+	"command":     true,
+	"entrypoint":  true,
+	"working_dir": true,
+	"environment": true,
+	"ports":       true,
+	"expose":      true,
+	"volumes":     true,
+}
+
+type composeFile struct {
+	// Services is the mapping of the services, decoded separately to keep their order
+	Services yaml.Node                 `yaml:"services"`
+	Volumes  map[string]*composeVolume `yaml:"volumes"`
+}
+
+type composeVolume struct {
+	External interface{} `yaml:"external"`
+}
+
+type composeService struct {
+	name        string
+	Image       string         `yaml:"image"`
+	Build       composeBuild   `yaml:"build"`
+	Command     shellCommand   `yaml:"command"`
+	Entrypoint  shellCommand   `yaml:"entrypoint"`
+	WorkingDir  string         `yaml:"working_dir"`
+	Environment composeEnv     `yaml:"environment"`
+	Ports       []composePort  `yaml:"ports"`
+	Expose      []string       `yaml:"expose"`
+	Volumes     []composeMount `yaml:"volumes"`
+}
+
+// composeBuild is the build section of a service, defined as a context directory or as a mapping
+type composeBuild struct {
+	Context    string `yaml:"context"`
+	Dockerfile string `yaml:"dockerfile"`
+}
+
+func (o *composeBuild) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		o.Context = value.Value
+		return nil
+	}
+	type plain composeBuild
+	return value.Decode((*plain)(o))
+}
+
+// shellCommand is a command defined as a string, run by a shell, or as a list of arguments
+type shellCommand []string
+
+func (o *shellCommand) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*o = shellCommand{"/bin/sh", "-c", value.Value}
+		return nil
+	}
+	var args []string
+	if err := value.Decode(&args); err != nil {
+		return err
+	}
+	*o = args
+	return nil
+}
+
+// commandLine returns the command as a command line
+func (o shellCommand) commandLine() string {
+	if len(o) == 3 && o[0] == "/bin/sh" && o[1] == "-c" {
+		return o[2]
+	}
+	quoted := make([]string, 0, len(o))
+	for _, arg := range o {
+		if strings.ContainsAny(arg, " \t\"'$&|;<>()") {
+			arg = strconv.Quote(arg)
+		}
+		quoted = append(quoted, arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// composeEnv are the environment variables of a service, defined as a mapping or as a list of NAME=VALUE.
+// A nil value means that the value is taken from the environment of the host.
+type composeEnv map[string]*string
+
+func (o *composeEnv) UnmarshalYAML(value *yaml.Node) error {
+	*o = composeEnv{}
+	if value.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(value.Content); i += 2 {
+			v := value.Content[i+1]
+			if v.Tag == "!!null" {
+				(*o)[value.Content[i].Value] = nil
+				continue
+			}
+			val := v.Value
+			(*o)[value.Content[i].Value] = &val
+		}
+		return nil
+	}
+	var list []string
+	if err := value.Decode(&list); err != nil {
+		return err
+	}
+	for _, item := range list {
+		parts := strings.SplitN(item, "=", 2)
+		if len(parts) == 1 {
+			(*o)[parts[0]] = nil
+			continue
+		}
+		val := parts[1]
+		(*o)[parts[0]] = &val
+	}
+	return nil
+}
+
+// composePort is a port of a service, defined as [HOST:]CONTAINER[/PROTOCOL] or as a mapping
+type composePort struct {
+	Target    string `yaml:"target"`
+	Published string `yaml:"published"`
+	Protocol  string `yaml:"protocol"`
+}
+
+func (o *composePort) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		spec := value.Value
+		if i := strings.LastIndex(spec, "/"); i >= 0 {
+			o.Protocol = spec[i+1:]
+			spec = spec[:i]
+		}
+		parts := strings.Split(spec, ":")
+		o.Target = parts[len(parts)-1]
+		if len(parts) > 1 {
+			o.Published = parts[len(parts)-2]
+		}
+		return nil
+	}
+	type plain composePort
+	return value.Decode((*plain)(o))
+}
+
+// composeMount is a volume of a service, defined as [SOURCE:]TARGET[:MODE] or as a mapping
+type composeMount struct {
+	Type   string `yaml:"type"`
+	Source string `yaml:"source"`
+	Target string `yaml:"target"`
+}
+
+func (o *composeMount) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.ScalarNode {
+		type plain composeMount
+		return value.Decode((*plain)(o))
+	}
+	parts := strings.Split(value.Value, ":")
+	switch {
+	case len(parts) == 1:
+		o.Type, o.Target = "volume", parts[0]
+	default:
+		o.Source, o.Target = parts[0], parts[1]
+		o.Type = "volume"
+		if strings.HasPrefix(o.Source, ".") || strings.HasPrefix(o.Source, "/") || strings.HasPrefix(o.Source, "~") {
+			o.Type = "bind"
+		}
+	}
+	return nil
+}
+
+// FindComposeFile returns the path of the Docker Compose file of the directory
+func FindComposeFile(fsys filesystem.Filesystem, dir string) (string, error) {
+	for _, name := range ComposeFiles {
+		path := filepath.Join(dir, name)
+		if _, err := fsys.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("no Docker Compose file (%s) found in %s", strings.Join(ComposeFiles, ", "), dir)
+}
+
+// FromCompose generates a Devfile named name from the Docker Compose file.
+// The main service, running the sources of the project, becomes the container component of the Devfile;
+// if mainService is empty, the main service is the first service built from the sources, or the first service.
+// The other services become Kubernetes components, deploying them with their storage and network services.
+func FromCompose(fsys filesystem.Filesystem, composePath string, name string, mainService string) (Result, error) {
+	b, err := fromCompose(fsys, composePath, filepath.Dir(composePath), name, mainService)
+	if err != nil {
+		return Result{}, err
+	}
+	return b.result()
+}
+
+// fromCompose converts the Docker Compose file; the bind mounts of projectDir in the main service define where the sources are mounted
+func fromCompose(fsys filesystem.Filesystem, composePath string, projectDir string, name string, mainService string) (*builder, error) {
+	content, err := fsys.ReadFile(composePath)
+	if err != nil {
+		return nil, err
+	}
+	var file composeFile
+	if err = yaml.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("unable to parse the Docker Compose file %s: %w", composePath, err)
+	}
+	services, unsupported, err := parseServices(&file.Services)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the Docker Compose file %s: %w", composePath, err)
+	}
+	if len(services) == 0 {
+		return nil, fmt.Errorf("no services defined in the Docker Compose file %s", composePath)
+	}
+
+	main, err := selectMainService(services, mainService)
+	if err != nil {
+		return nil, err
+	}
+	b := newBuilder(name, main.name)
+	for _, field := range unsupported {
+		b.warnf("%s is not converted", field)
+	}
+	dir := filepath.Dir(composePath)
+
+	if err = b.convertMainService(fsys, dir, projectDir, main); err != nil {
+		return nil, err
+	}
+
+	// volumes can be shared between the services with Docker Compose, not in a cluster
+	volumeUsers := map[string]string{}
+	for _, mount := range main.Volumes {
+		if mount.Type == "volume" && mount.Source != "" {
+			volumeUsers[mount.Source] = main.name
+		}
+	}
+	for _, service := range services {
+		if service == main {
+			continue
+		}
+		if err = b.convertService(service, file.Volumes, volumeUsers); err != nil {
+			return nil, err
+		}
+	}
+	var external []string
+	for volumeName, volume := range file.Volumes {
+		if volume != nil && volume.External != nil {
+			external = append(external, volumeName)
+		}
+	}
+	sort.Strings(external)
+	for _, volumeName := range external {
+		b.warnf("the volume %q is external in the Docker Compose file, a new volume is created instead", volumeName)
+	}
+	return b, nil
+}
+
+// parseServices returns the services, in the order of the file, and the fields of the services which are not converted
+func parseServices(node *yaml.Node) ([]*composeService, []string, error) {
+	if node.Kind == 0 {
+		return nil, nil, nil
+	}
+	if node.Kind != yaml.MappingNode {
+		return nil, nil, errors.New("services must be a mapping")
+	}
+	var (
+		services    []*composeService
+		unsupported []string
+	)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		name, value := node.Content[i].Value, node.Content[i+1]
+		service := composeService{name: name}
+		if err := value.Decode(&service); err != nil {
+			return nil, nil, fmt.Errorf("service %q: %w", name, err)
+		}
+		for j := 0; j+1 < len(value.Content); j += 2 {
+			if field := value.Content[j].Value; !supportedServiceFields[field] {
+				unsupported = append(unsupported, fmt.Sprintf("the field %q of the service %q", field, name))
+			}
+		}
+		services = append(services, &service)
+	}
+	return services, unsupported, nil
+}
+
+func selectMainService(services []*composeService, name string) (*composeService, error) {
+	if name != "" {
+		for _, service := range services {
+			if service.name == name {
+				return service, nil
+			}
+		}
+		return nil, fmt.Errorf("service %q not found in the Docker Compose file", name)
+	}
+	for _, service := range services {
+		if service.Build.Context != "" {
+			return service, nil
+		}
+	}
+	return services[0], nil
+}
+
+// convertMainService converts the main service into the container component running the sources
+func (b *builder) convertMainService(fsys filesystem.Filesystem, dir string, projectDir string, service *composeService) error {
+	image := service.Image
+	if service.Build.Context != "" {
+		context := joinPath(dir, service.Build.Context)
+		dockerfile := joinPath(context, defaultString(service.Build.Dockerfile, "Dockerfile"))
+		baseImage, err := dockerfileBaseImage(fsys, dockerfile)
+		switch {
+		case err == nil:
+			image = baseImage
+			b.warnf("the service %q is built from a Dockerfile, the container uses its base image %q: "+
+				"the other instructions of the Dockerfile are not run", service.name, baseImage)
+		case image == "":
+			return fmt.Errorf("unable to determine the image of the service %q: %w", service.name, err)
+		}
+	}
+	b.setImage(image)
+
+	for _, port := range service.Ports {
+		b.convertPort(service.name, port)
+	}
+	for _, expose := range service.Expose {
+		b.convertPort(service.name, composePort{Target: expose})
+	}
+
+	for _, envName := range sortedEnvNames(service.Environment) {
+		value := service.Environment[envName]
+		if value == nil {
+			b.warnf("the value of the environment variable %q of the service %q is taken from the host, it is not converted", envName, service.name)
+			continue
+		}
+		b.setEnv(envName, *value)
+	}
+
+	for i, mount := range service.Volumes {
+		switch {
+		case mount.Type == "bind" && filepath.Clean(joinPath(dir, mount.Source)) == filepath.Clean(projectDir):
+			b.setSourceMapping(mount.Target)
+		case mount.Type == "bind":
+			b.warnf("the bind mount of %q in the service %q is not supported, the directory is not available in the container", mount.Source, service.name)
+		case mount.Type == "tmpfs":
+			b.addVolume(fmt.Sprintf("%s-tmpfs-%d", service.name, i), mount.Target, true)
+		case mount.Source == "":
+			b.addVolume(fmt.Sprintf("%s-volume-%d", service.name, i), mount.Target, false)
+		default:
+			b.addVolume(mount.Source, mount.Target, false)
+		}
+	}
+
+	command := append(append(shellCommand{}, service.Entrypoint...), service.Command...)
+	if len(command) > 0 {
+		b.setCommand(runCommandId, devfilev1.RunCommandGroupKind, command.commandLine(), service.WorkingDir)
+	} else if service.Build.Context != "" || service.Image != "" {
+		b.warnf("the service %q runs the default command of its image, which is not known", service.name)
+	}
+	return nil
+}
+
+func (b *builder) convertPort(serviceName string, port composePort) {
+	if strings.Contains(port.Target, "-") {
+		b.warnf("the port range %q of the service %q is not supported", port.Target, serviceName)
+		return
+	}
+	target, err := strconv.Atoi(port.Target)
+	if err != nil {
+		b.warnf("the port %q of the service %q is invalid", port.Target, serviceName)
+		return
+	}
+	if port.Published != "" && port.Published != port.Target {
+		b.warnf("the port %d of the service %q is published on the port %s: odo forwards it to a local port chosen when running odo dev",
+			target, serviceName, port.Published)
+	}
+	var protocol devfilev1.EndpointProtocol
+	if strings.EqualFold(port.Protocol, "udp") {
+		protocol = devfilev1.UDPEndpointProtocol
+	}
+	b.addEndpoint(target, protocol)
+}
+
+// convertService converts a service, other than the main one, into a Kubernetes component
+// defining a Deployment, a Service if the service has ports, and the PersistentVolumeClaims of its volumes
+func (b *builder) convertService(service *composeService, volumes map[string]*composeVolume, volumeUsers map[string]string) error {
+	name := componentName(service.name)
+	if service.Image == "" {
+		b.warnf("the service %q is built from a Dockerfile, it is not converted: "+
+			"push its image to a registry and add it to the devfile with \"odo add container\"", service.name)
+		return nil
+	}
+
+	container := map[string]interface{}{
+		"name":  name,
+		"image": service.Image,
+	}
+	if len(service.Entrypoint) > 0 {
+		container["command"] = []string(service.Entrypoint)
+	}
+	if len(service.Command) > 0 {
+		container["args"] = []string(service.Command)
+	}
+	if service.WorkingDir != "" {
+		container["workingDir"] = service.WorkingDir
+	}
+
+	var env []interface{}
+	for _, envName := range sortedEnvNames(service.Environment) {
+		value := service.Environment[envName]
+		if value == nil {
+			b.warnf("the value of the environment variable %q of the service %q is taken from the host, it is not converted", envName, service.name)
+			continue
+		}
+		env = append(env, map[string]interface{}{"name": envName, "value": *value})
+	}
+	if len(env) > 0 {
+		container["env"] = env
+	}
+
+	var (
+		containerPorts []interface{}
+		servicePorts   []interface{}
+	)
+	ports := append([]composePort{}, service.Ports...)
+	for _, expose := range service.Expose {
+		ports = append(ports, composePort{Target: expose})
+	}
+	for _, port := range ports {
+		target, err := strconv.Atoi(port.Target)
+		if err != nil {
+			b.warnf("the port %q of the service %q is not supported", port.Target, service.name)
+			continue
+		}
+		protocol := strings.ToUpper(defaultString(port.Protocol, "tcp"))
+		containerPorts = append(containerPorts, map[string]interface{}{"containerPort": target, "protocol": protocol})
+		servicePorts = append(servicePorts, map[string]interface{}{
+			"name":       fmt.Sprintf("%s-%d", strings.ToLower(protocol), target),
+			"port":       target,
+			"targetPort": target,
+			"protocol":   protocol,
+		})
+	}
+	if len(containerPorts) > 0 {
+		container["ports"] = containerPorts
+	}
+
+	var (
+		podVolumes   []interface{}
+		volumeMounts []interface{}
+		claims       []interface{}
+	)
+	for i, mount := range service.Volumes {
+		volumeName := fmt.Sprintf("volume-%d", i)
+		podVolume := map[string]interface{}{"name": volumeName, "emptyDir": map[string]interface{}{}}
+		switch {
+		case mount.Type == "volume" && mount.Source != "":
+			if user, ok := volumeUsers[mount.Source]; ok {
+				b.warnf("the volume %q is shared by the services %q and %q, the services use distinct volumes", mount.Source, user, service.name)
+			}
+			volumeUsers[mount.Source] = service.name
+			claimName := componentName(name + "-" + mount.Source)
+			podVolume = map[string]interface{}{"name": volumeName, "persistentVolumeClaim": map[string]interface{}{"claimName": claimName}}
+			claims = append(claims, map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "PersistentVolumeClaim",
+				"metadata":   map[string]interface{}{"name": claimName},
+				"spec": map[string]interface{}{
+					"accessModes": []string{"ReadWriteOnce"},
+					"resources":   map[string]interface{}{"requests": map[string]interface{}{"storage": "1Gi"}},
+				},
+			})
+		case mount.Type == "bind":
+			b.warnf("the bind mount of %q in the service %q is not supported, an empty directory is mounted instead", mount.Source, service.name)
+		case mount.Type != "tmpfs":
+			b.warnf("the anonymous volume %q of the service %q is not persisted", mount.Target, service.name)
+		}
+		podVolumes = append(podVolumes, podVolume)
+		volumeMounts = append(volumeMounts, map[string]interface{}{"name": volumeName, "mountPath": mount.Target})
+	}
+	if len(volumeMounts) > 0 {
+		container["volumeMounts"] = volumeMounts
+	}
+
+	labels := map[string]interface{}{"app": name}
+	podSpec := map[string]interface{}{"containers": []interface{}{container}}
+	if len(podVolumes) > 0 {
+		podSpec["volumes"] = podVolumes
+	}
+	manifests := []interface{}{
+		map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": name},
+			"spec": map[string]interface{}{
+				"replicas": 1,
+				"selector": map[string]interface{}{"matchLabels": labels},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{"labels": labels},
+					"spec":     podSpec,
+				},
+			},
+		},
+	}
+	if len(servicePorts) > 0 {
+		// the Service has the name of the Compose service, so that the other services reach it with the same host name
+		manifests = append(manifests, map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]interface{}{"name": name},
+			"spec": map[string]interface{}{
+				"selector": labels,
+				"ports":    servicePorts,
+			},
+		})
+	}
+	manifests = append(manifests, claims...)
+	return b.addKubernetesComponent(name, manifests)
+}
+
+func sortedEnvNames(env composeEnv) []string {
+	names := make([]string, 0, len(env))
+	for name := range env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,276 @@
+// Package convert generates a Devfile from the definition of a development environment
+// for another tool: a Docker Compose file or a Dev Container configuration
+package convert
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfilepkg "github.com/devfile/api/v2/pkg/devfile"
+	"github.com/devfile/library/pkg/devfile/parser/data"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+const (
+	// runCommandId is the id of the run command generated
+	runCommandId = "run"
+	// buildCommandId is the id of the build command generated
+	buildCommandId = "build"
+)
+
+// Result is a Devfile generated from another format
+type Result struct {
+	// Devfile is the content of the Devfile, in YAML format
+	Devfile []byte
+	// Warnings are the parts of the source definition which cannot be expressed in the Devfile
+	Warnings []string
+}
+
+// builder builds a Devfile, around the container component running the sources of the project
+type builder struct {
+	name      string
+	container *devfilev1.Component
+	// components are the other components: volumes, and Kubernetes components running the other services
+	components []devfilev1.Component
+	commands   []devfilev1.Command
+	warnings   []string
+}
+
+func newBuilder(name string, containerName string) *builder {
+	return &builder{
+		name: name,
+		container: &devfilev1.Component{
+			Name: componentName(containerName),
+			ComponentUnion: devfilev1.ComponentUnion{
+				Container: &devfilev1.ContainerComponent{},
+			},
+		},
+	}
+}
+
+func (b *builder) warnf(format string, args ...interface{}) {
+	b.warnings = append(b.warnings, fmt.Sprintf(format, args...))
+}
+
+func (b *builder) setImage(image string) {
+	b.container.Container.Image = image
+}
+
+// addEndpoint adds an endpoint to the container, if no endpoint already uses the port
+func (b *builder) addEndpoint(port int, protocol devfilev1.EndpointProtocol) {
+	for _, endpoint := range b.container.Container.Endpoints {
+		if endpoint.TargetPort == port {
+			return
+		}
+	}
+	b.container.Container.Endpoints = append(b.container.Container.Endpoints, devfilev1.Endpoint{
+		Name:       fmt.Sprintf("%s-%d", defaultString(string(protocol), string(devfilev1.HTTPEndpointProtocol)), port),
+		TargetPort: port,
+		Protocol:   protocol,
+	})
+}
+
+// setEnv sets the value of the environment variable of the container
+func (b *builder) setEnv(name, value string) {
+	for i := range b.container.Container.Env {
+		if b.container.Container.Env[i].Name == name {
+			b.container.Container.Env[i].Value = value
+			return
+		}
+	}
+	b.container.Container.Env = append(b.container.Container.Env, devfilev1.EnvVar{Name: name, Value: value})
+}
+
+// addVolume adds a volume component, if it does not exist yet, and mounts it in the container
+func (b *builder) addVolume(name string, path string, ephemeral bool) {
+	name = componentName(name)
+	exists := false
+	for _, component := range b.components {
+		if component.Name == name {
+			exists = true
+		}
+	}
+	if !exists {
+		volume := devfilev1.Component{
+			Name: name,
+			ComponentUnion: devfilev1.ComponentUnion{
+				Volume: &devfilev1.VolumeComponent{},
+			},
+		}
+		if ephemeral {
+			volume.Volume.Ephemeral = pointer.Bool(true)
+		}
+		b.components = append(b.components, volume)
+	}
+	b.container.Container.VolumeMounts = append(b.container.Container.VolumeMounts, devfilev1.VolumeMount{Name: name, Path: path})
+}
+
+// setSourceMapping sets the path where the sources of the project are mounted in the container
+func (b *builder) setSourceMapping(path string) {
+	b.container.Container.SourceMapping = path
+}
+
+// setCommand sets the default command of the group, executed in the container
+func (b *builder) setCommand(id string, kind devfilev1.CommandGroupKind, commandLine string, workingDir string) {
+	command := devfilev1.Command{
+		Id: id,
+		CommandUnion: devfilev1.CommandUnion{
+			Exec: &devfilev1.ExecCommand{
+				LabeledCommand: devfilev1.LabeledCommand{
+					BaseCommand: devfilev1.BaseCommand{
+						Group: &devfilev1.CommandGroup{Kind: kind, IsDefault: pointer.Bool(true)},
+					},
+				},
+				CommandLine: commandLine,
+				Component:   b.container.Name,
+				WorkingDir:  defaultString(workingDir, "${PROJECT_SOURCE}"),
+			},
+		},
+	}
+	for i := range b.commands {
+		if b.commands[i].Id == id {
+			b.commands[i] = command
+			return
+		}
+	}
+	b.commands = append(b.commands, command)
+}
+
+func (b *builder) hasCommand(id string) bool {
+	for _, command := range b.commands {
+		if command.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// addKubernetesComponent adds a Kubernetes component defined by the inlined manifests
+func (b *builder) addKubernetesComponent(name string, manifests []interface{}) error {
+	var inlined []string
+	for _, manifest := range manifests {
+		content, err := yaml.Marshal(manifest)
+		if err != nil {
+			return err
+		}
+		inlined = append(inlined, string(content))
+	}
+	b.components = append(b.components, devfilev1.Component{
+		Name: name,
+		ComponentUnion: devfilev1.ComponentUnion{
+			Kubernetes: &devfilev1.KubernetesComponent{
+				K8sLikeComponent: devfilev1.K8sLikeComponent{
+					K8sLikeComponentLocation: devfilev1.K8sLikeComponentLocation{
+						Inlined: strings.Join(inlined, "---\n"),
+					},
+				},
+			},
+		},
+	})
+	return nil
+}
+
+// result returns the Devfile built
+func (b *builder) result() (Result, error) {
+	if b.container.Container.Image == "" {
+		return Result{}, fmt.Errorf("unable to determine the image of the container %q", b.container.Name)
+	}
+	if !b.hasCommand(runCommandId) {
+		b.warnf("no command to run the application has been found, add it with \"odo add command run --command-line <command> --group run --default\"")
+	}
+
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion220))
+	if err != nil {
+		return Result{}, err
+	}
+	devfileData.SetSchemaVersion(string(data.APISchemaVersion220))
+	devfileData.SetMetadata(devfilepkg.DevfileMetadata{Name: b.name})
+	components := append([]devfilev1.Component{*b.container}, b.components...)
+	if err = devfileData.AddComponents(components); err != nil {
+		return Result{}, err
+	}
+	if err = devfileData.AddCommands(b.commands); err != nil {
+		return Result{}, err
+	}
+	content, err := yaml.Marshal(devfileData)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Devfile: content, Warnings: b.warnings}, nil
+}
+
+// dockerfileBaseImage returns the base image of the last stage of the Dockerfile
+func dockerfileBaseImage(fsys filesystem.Filesystem, dockerfilePath string) (string, error) {
+	content, err := fsys.ReadFile(dockerfilePath)
+	if err != nil {
+		return "", err
+	}
+	stages := map[string]string{}
+	var image string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || !strings.EqualFold(fields[0], "FROM") {
+			continue
+		}
+		// skip the options, for example --platform
+		args := fields[1:]
+		for len(args) > 0 && strings.HasPrefix(args[0], "--") {
+			args = args[1:]
+		}
+		if len(args) == 0 {
+			continue
+		}
+		image = args[0]
+		// an image can be built from a previous stage
+		if stageImage, ok := stages[strings.ToLower(image)]; ok {
+			image = stageImage
+		}
+		if len(args) >= 3 && strings.EqualFold(args[1], "AS") {
+			stages[strings.ToLower(args[2])] = image
+		}
+	}
+	if image == "" {
+		return "", fmt.Errorf("no FROM instruction found in %s", dockerfilePath)
+	}
+	return image, nil
+}
+
+// joinPath returns the path relative to the directory, or the path if it is absolute
+func joinPath(dir string, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, filepath.FromSlash(path))
+}
+
+func defaultString(value string, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+// sortedKeys returns the keys of the map, sorted
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// componentName returns a valid name of component or volume, underscores being frequent in the names of services and volumes
+func componentName(name string) string {
+	return util.GetDNS1123Name(strings.ReplaceAll(name, "_", "-"))
+}
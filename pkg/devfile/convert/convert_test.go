@@ -0,0 +1,194 @@
+package convert
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/yaml"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+// devfileContent is the part of the Devfile checked by the tests
+type devfileContent struct {
+	Components []devfilev1.Component `json:"components"`
+	Commands   []devfilev1.Command   `json:"commands"`
+}
+
+func writeFiles(t *testing.T, fsys filesystem.Filesystem, files map[string]string) {
+	for path, content := range files {
+		if err := fsys.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := fsys.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func parseResult(t *testing.T, result Result) devfileContent {
+	if _, err := parser.ParseFromData(result.Devfile); err != nil {
+		t.Fatalf("the generated devfile is invalid: %v\n%s", err, string(result.Devfile))
+	}
+	var content devfileContent
+	if err := yaml.Unmarshal(result.Devfile, &content); err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+func TestFromCompose(t *testing.T) {
+	fsys := filesystem.NewFakeFs()
+	writeFiles(t, fsys, map[string]string{
+		"/app/Dockerfile": `FROM node:18 AS base
+RUN npm install
+FROM base
+CMD ["npm", "start"]
+`,
+		"/app/docker-compose.yml": `services:
+  web:
+    build: .
+    command: npm run dev
+    working_dir: /usr/src/app
+    ports:
+      - "8080:3000"
+    environment:
+      DB_HOST: db
+      HOME_PATH:
+    volumes:
+      - .:/usr/src/app
+      - node_modules:/usr/src/app/node_modules
+    depends_on: [db]
+  db:
+    image: postgres:14
+    environment:
+      - POSTGRES_PASSWORD=secret
+    ports:
+      - "5432"
+    volumes:
+      - pgdata:/var/lib/postgresql/data
+volumes:
+  node_modules:
+  pgdata:
+`,
+	})
+
+	result, err := FromCompose(fsys, "/app/docker-compose.yml", "my-app", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := parseResult(t, result)
+
+	wantContainer := devfilev1.Container{
+		Image:         "node:18",
+		SourceMapping: "/usr/src/app",
+		Env:           []devfilev1.EnvVar{{Name: "DB_HOST", Value: "db"}},
+		VolumeMounts:  []devfilev1.VolumeMount{{Name: "node-modules", Path: "/usr/src/app/node_modules"}},
+	}
+	if len(content.Components) != 3 {
+		t.Fatalf("expected 3 components (container, volume, kubernetes), got %d", len(content.Components))
+	}
+	web := content.Components[0]
+	if web.Name != "web" || web.Container == nil {
+		t.Fatalf("expected the container component web, got %+v", web)
+	}
+	if diff := cmp.Diff(wantContainer, web.Container.Container); diff != "" {
+		t.Errorf("container mismatch (-want +got):\n%s", diff)
+	}
+	wantEndpoints := []devfilev1.Endpoint{{Name: "http-3000", TargetPort: 3000}}
+	if diff := cmp.Diff(wantEndpoints, web.Container.Endpoints); diff != "" {
+		t.Errorf("endpoints mismatch (-want +got):\n%s", diff)
+	}
+	if content.Components[1].Name != "node-modules" || content.Components[1].Volume == nil {
+		t.Errorf("expected the volume component node-modules, got %+v", content.Components[1])
+	}
+	db := content.Components[2]
+	if db.Name != "db" || db.Kubernetes == nil {
+		t.Fatalf("expected the kubernetes component db, got %+v", db)
+	}
+	for _, kind := range []string{"kind: Deployment", "kind: Service", "kind: PersistentVolumeClaim", "claimName: db-pgdata", "value: secret"} {
+		if !strings.Contains(db.Kubernetes.Inlined, kind) {
+			t.Errorf("expected %q in the manifests of the db component:\n%s", kind, db.Kubernetes.Inlined)
+		}
+	}
+
+	if len(content.Commands) != 1 || content.Commands[0].Exec == nil {
+		t.Fatalf("expected a run command, got %+v", content.Commands)
+	}
+	run := content.Commands[0].Exec
+	if run.CommandLine != "npm run dev" || run.WorkingDir != "/usr/src/app" || run.Component != "web" || run.Group.Kind != devfilev1.RunCommandGroupKind {
+		t.Errorf("unexpected run command %+v", run)
+	}
+
+	wantWarnings := []string{
+		`the field "depends_on" of the service "web" is not converted`,
+		`the service "web" is built from a Dockerfile, the container uses its base image "node:18": the other instructions of the Dockerfile are not run`,
+		`the port 3000 of the service "web" is published on the port 8080: odo forwards it to a local port chosen when running odo dev`,
+		`the value of the environment variable "HOME_PATH" of the service "web" is taken from the host, it is not converted`,
+	}
+	if diff := cmp.Diff(wantWarnings, result.Warnings); diff != "" {
+		t.Errorf("warnings mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFromDevcontainer(t *testing.T) {
+	fsys := filesystem.NewFakeFs()
+	writeFiles(t, fsys, map[string]string{
+		"/app/.devcontainer/devcontainer.json": `{
+	// the development container
+	"name": "Go",
+	"image": "mcr.microsoft.com/devcontainers/go:1.19",
+	"forwardPorts": [8080, "db:5432"],
+	"containerEnv": {
+		"GOFLAGS": "-mod=vendor", /* build with the vendored modules */
+	},
+	"mounts": ["source=go-cache,target=/go/pkg,type=volume"],
+	"postCreateCommand": "go mod download",
+	"postStartCommand": ["go", "run", "."],
+	"customizations": {"vscode": {"extensions": ["golang.go"]}},
+}`,
+	})
+
+	result, err := FromDevcontainer(fsys, "/app/.devcontainer/devcontainer.json", "/app", "my-app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := parseResult(t, result)
+
+	if len(content.Components) != 2 {
+		t.Fatalf("expected 2 components (container, volume), got %d", len(content.Components))
+	}
+	wantContainer := devfilev1.Container{
+		Image:        "mcr.microsoft.com/devcontainers/go:1.19",
+		Env:          []devfilev1.EnvVar{{Name: "GOFLAGS", Value: "-mod=vendor"}},
+		VolumeMounts: []devfilev1.VolumeMount{{Name: "go-cache", Path: "/go/pkg"}},
+	}
+	if diff := cmp.Diff(wantContainer, content.Components[0].Container.Container); diff != "" {
+		t.Errorf("container mismatch (-want +got):\n%s", diff)
+	}
+	wantEndpoints := []devfilev1.Endpoint{{Name: "http-8080", TargetPort: 8080}}
+	if diff := cmp.Diff(wantEndpoints, content.Components[0].Container.Endpoints); diff != "" {
+		t.Errorf("endpoints mismatch (-want +got):\n%s", diff)
+	}
+
+	commandLines := map[string]string{}
+	for _, command := range content.Commands {
+		commandLines[command.Id] = command.Exec.CommandLine
+	}
+	wantCommandLines := map[string]string{"build": "go mod download", "run": "go run ."}
+	if diff := cmp.Diff(wantCommandLines, commandLines); diff != "" {
+		t.Errorf("commands mismatch (-want +got):\n%s", diff)
+	}
+
+	wantWarnings := []string{
+		`the field "customizations" of the Dev Container configuration is not converted`,
+		`the port "db:5432" of another service is not forwarded, the service is reachable from the container with its name`,
+	}
+	if diff := cmp.Diff(wantWarnings, result.Warnings); diff != "" {
+		t.Errorf("warnings mismatch (-want +got):\n%s", diff)
+	}
+}
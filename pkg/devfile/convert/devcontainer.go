@@ -0,0 +1,363 @@
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+// DevcontainerFiles are the paths of the Dev Container configurations searched in a directory, by order of preference
+var DevcontainerFiles = []string{".devcontainer/devcontainer.json", ".devcontainer.json"}
+
+// supportedDevcontainerFields are the fields of the Dev Container configuration which are converted
+var supportedDevcontainerFields = map[string]bool{
+	"$schema":              true,
+	"name":                 true,
+	"image":                true,
+	"build":                true,
+	"dockerFile":           true,
+	"context":              true,
+	"dockerComposeFile":    true,
+	"service":              true,
+	"runServices":          true,
+	"workspaceFolder":      true,
+	"forwardPorts":         true,
+	"appPort":              true,
+	"containerEnv":         true,
+	"remoteEnv":            true,
+	"mounts":               true,
+	"onCreateCommand":      true,
+	"updateContentCommand": true,
+	"postCreateCommand":    true,
+	"postStartCommand":     true,
+}
+
+type devcontainer struct {
+	Image string `json:"image"`
+	Build struct {
+		Dockerfile string `json:"dockerfile"`
+		Context    string `json:"context"`
+	} `json:"build"`
+	DockerFile           string            `json:"dockerFile"`
+	Context              string            `json:"context"`
+	DockerComposeFile    json.RawMessage   `json:"dockerComposeFile"`
+	Service              string            `json:"service"`
+	WorkspaceFolder      string            `json:"workspaceFolder"`
+	ForwardPorts         []json.RawMessage `json:"forwardPorts"`
+	AppPort              json.RawMessage   `json:"appPort"`
+	ContainerEnv         map[string]string `json:"containerEnv"`
+	RemoteEnv            map[string]string `json:"remoteEnv"`
+	Mounts               []json.RawMessage `json:"mounts"`
+	OnCreateCommand      json.RawMessage   `json:"onCreateCommand"`
+	UpdateContentCommand json.RawMessage   `json:"updateContentCommand"`
+	PostCreateCommand    json.RawMessage   `json:"postCreateCommand"`
+	PostStartCommand     json.RawMessage   `json:"postStartCommand"`
+}
+
+type devcontainerMount struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Type   string `json:"type"`
+}
+
+// FindDevcontainerFile returns the path of the Dev Container configuration of the directory
+func FindDevcontainerFile(fsys filesystem.Filesystem, dir string) (string, error) {
+	for _, name := range DevcontainerFiles {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if _, err := fsys.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("no Dev Container configuration (%s) found in %s", strings.Join(DevcontainerFiles, ", "), dir)
+}
+
+// FromDevcontainer generates a Devfile named name from the Dev Container configuration of the project in projectDir.
+// The development container becomes the container component of the Devfile; if the configuration references a
+// Docker Compose file, it is converted as by FromCompose, the service of the configuration being the main service.
+// The creation commands become the build command, and the start command the run command.
+func FromDevcontainer(fsys filesystem.Filesystem, devcontainerPath string, projectDir string, name string) (Result, error) {
+	content, err := fsys.ReadFile(devcontainerPath)
+	if err != nil {
+		return Result{}, err
+	}
+	content = stripJSONC(content)
+	var config devcontainer
+	if err = json.Unmarshal(content, &config); err != nil {
+		return Result{}, fmt.Errorf("unable to parse the Dev Container configuration %s: %w", devcontainerPath, err)
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(content, &fields); err != nil {
+		return Result{}, fmt.Errorf("unable to parse the Dev Container configuration %s: %w", devcontainerPath, err)
+	}
+	dir := filepath.Dir(devcontainerPath)
+
+	var b *builder
+	if composeFiles := stringOrList(config.DockerComposeFile); len(composeFiles) > 0 {
+		if config.Service == "" {
+			return Result{}, fmt.Errorf("the service of the Docker Compose file is not defined in the Dev Container configuration %s", devcontainerPath)
+		}
+		b, err = fromCompose(fsys, joinPath(dir, composeFiles[0]), projectDir, name, config.Service)
+		if err != nil {
+			return Result{}, err
+		}
+		for _, file := range composeFiles[1:] {
+			b.warnf("only the first Docker Compose file is converted, %q is not converted", file)
+		}
+	} else {
+		b = newBuilder(name, "devcontainer")
+		if err = b.setDevcontainerImage(fsys, dir, config); err != nil {
+			return Result{}, err
+		}
+	}
+
+	unsupported := make([]string, 0, len(fields))
+	for field := range fields {
+		if !supportedDevcontainerFields[field] {
+			unsupported = append(unsupported, field)
+		}
+	}
+	sort.Strings(unsupported)
+	for _, field := range unsupported {
+		b.warnf("the field %q of the Dev Container configuration is not converted", field)
+	}
+
+	variables := strings.NewReplacer(
+		"${containerWorkspaceFolder}", "${PROJECT_SOURCE}",
+		"${localWorkspaceFolderBasename}", filepath.Base(projectDir),
+	)
+	if config.WorkspaceFolder != "" {
+		b.setSourceMapping(variables.Replace(config.WorkspaceFolder))
+	}
+
+	for _, raw := range config.ForwardPorts {
+		b.convertDevcontainerPort(raw)
+	}
+	if len(config.AppPort) > 0 {
+		var ports []json.RawMessage
+		if err = json.Unmarshal(config.AppPort, &ports); err != nil {
+			ports = []json.RawMessage{config.AppPort}
+		}
+		for _, raw := range ports {
+			b.convertDevcontainerPort(raw)
+		}
+	}
+
+	env := map[string]string{}
+	for key, value := range config.ContainerEnv {
+		env[key] = value
+	}
+	for key, value := range config.RemoteEnv {
+		env[key] = value
+	}
+	for _, key := range sortedKeys(env) {
+		value := variables.Replace(env[key])
+		if strings.Contains(value, "${") {
+			b.warnf("the value of the environment variable %q references variables of the Dev Container configuration: %q", key, value)
+		}
+		b.setEnv(key, value)
+	}
+
+	for i, raw := range config.Mounts {
+		mount, err := parseDevcontainerMount(raw)
+		if err != nil {
+			b.warnf("the mount %s is invalid: %v", string(raw), err)
+			continue
+		}
+		switch {
+		case mount.Type == "bind" && mount.Source == "${localWorkspaceFolder}":
+			b.setSourceMapping(mount.Target)
+		case mount.Type == "bind":
+			b.warnf("the bind mount of %q is not supported, the directory is not available in the container", mount.Source)
+		case mount.Type == "tmpfs":
+			b.addVolume(fmt.Sprintf("tmpfs-%d", i), mount.Target, true)
+		default:
+			b.addVolume(defaultString(variables.Replace(mount.Source), fmt.Sprintf("volume-%d", i)), mount.Target, false)
+		}
+	}
+
+	var buildCommands []string
+	for _, raw := range []json.RawMessage{config.OnCreateCommand, config.UpdateContentCommand, config.PostCreateCommand} {
+		if commandLine := b.lifecycleCommand(raw); commandLine != "" {
+			buildCommands = append(buildCommands, commandLine)
+		}
+	}
+	if len(buildCommands) > 0 {
+		b.setCommand(buildCommandId, devfilev1.BuildCommandGroupKind, variables.Replace(strings.Join(buildCommands, " && ")), "")
+	}
+	if commandLine := b.lifecycleCommand(config.PostStartCommand); commandLine != "" {
+		b.setCommand(runCommandId, devfilev1.RunCommandGroupKind, variables.Replace(commandLine), "")
+	}
+	return b.result()
+}
+
+// setDevcontainerImage sets the image of the container, defined by an image or a Dockerfile
+func (b *builder) setDevcontainerImage(fsys filesystem.Filesystem, dir string, config devcontainer) error {
+	if config.Image != "" {
+		b.setImage(config.Image)
+		return nil
+	}
+	dockerfile := defaultString(config.Build.Dockerfile, config.DockerFile)
+	if dockerfile == "" {
+		return fmt.Errorf("the Dev Container configuration defines neither an image, a Dockerfile nor a Docker Compose file")
+	}
+	baseImage, err := dockerfileBaseImage(fsys, joinPath(dir, dockerfile))
+	if err != nil {
+		return fmt.Errorf("unable to determine the image of the development container: %w", err)
+	}
+	b.setImage(baseImage)
+	b.warnf("the development container is built from a Dockerfile, the container uses its base image %q: "+
+		"the other instructions of the Dockerfile are not run", baseImage)
+	return nil
+}
+
+// convertDevcontainerPort converts a forwarded port, defined as a number, or as "host:port" for the ports of other services
+func (b *builder) convertDevcontainerPort(raw json.RawMessage) {
+	var port int
+	if err := json.Unmarshal(raw, &port); err == nil {
+		b.addEndpoint(port, "")
+		return
+	}
+	var spec string
+	if err := json.Unmarshal(raw, &spec); err != nil {
+		b.warnf("the port %s is invalid", string(raw))
+		return
+	}
+	if port, err := strconv.Atoi(spec); err == nil {
+		b.addEndpoint(port, "")
+		return
+	}
+	b.warnf("the port %q of another service is not forwarded, the service is reachable from the container with its name", spec)
+}
+
+// lifecycleCommand returns the command line of a lifecycle command, defined as a string, a list of arguments,
+// or a mapping of commands run in parallel
+func (b *builder) lifecycleCommand(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var commandLine string
+	if err := json.Unmarshal(raw, &commandLine); err == nil {
+		return commandLine
+	}
+	var args []string
+	if err := json.Unmarshal(raw, &args); err == nil {
+		return shellCommand(args).commandLine()
+	}
+	var parallel map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &parallel); err != nil {
+		b.warnf("the command %s is invalid", string(raw))
+		return ""
+	}
+	names := make([]string, 0, len(parallel))
+	for name := range parallel {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	commandLines := make([]string, 0, len(parallel))
+	for _, name := range names {
+		if commandLine := b.lifecycleCommand(parallel[name]); commandLine != "" {
+			commandLines = append(commandLines, commandLine)
+		}
+	}
+	b.warnf("the commands %s are run sequentially, instead of in parallel", strings.Join(names, ", "))
+	return strings.Join(commandLines, " && ")
+}
+
+// parseDevcontainerMount parses a mount, defined as a mapping or as a string "source=...,target=...,type=..."
+func parseDevcontainerMount(raw json.RawMessage) (devcontainerMount, error) {
+	var mount devcontainerMount
+	var spec string
+	if err := json.Unmarshal(raw, &spec); err != nil {
+		err = json.Unmarshal(raw, &mount)
+		return mount, err
+	}
+	for _, option := range strings.Split(spec, ",") {
+		parts := strings.SplitN(option, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		switch strings.TrimSpace(parts[0]) {
+		case "source", "src":
+			mount.Source = parts[1]
+		case "target", "destination", "dst":
+			mount.Target = parts[1]
+		case "type":
+			mount.Type = parts[1]
+		}
+	}
+	if mount.Target == "" {
+		return mount, fmt.Errorf("no target defined")
+	}
+	return mount, nil
+}
+
+// stringOrList decodes a JSON value defined as a string or as a list of strings
+func stringOrList(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return []string{value}
+	}
+	var values []string
+	_ = json.Unmarshal(raw, &values)
+	return values
+}
+
+// stripJSONC removes the comments and the trailing commas of JSON with comments (JSONC)
+func stripJSONC(content []byte) []byte {
+	var (
+		result   []byte
+		inString bool
+	)
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case inString:
+			result = append(result, c)
+			if c == '\\' && i+1 < len(content) {
+				i++
+				result = append(result, content[i])
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+			result = append(result, c)
+		case c == '/' && i+1 < len(content) && content[i+1] == '/':
+			for i < len(content) && content[i] != '\n' {
+				i++
+			}
+			if i < len(content) {
+				result = append(result, '\n')
+			}
+		case c == '/' && i+1 < len(content) && content[i+1] == '*':
+			i += 2
+			for i+1 < len(content) && !(content[i] == '*' && content[i+1] == '/') {
+				i++
+			}
+			i++
+		case c == '}' || c == ']':
+			// remove a trailing comma before the end of an object or an array
+			j := len(result) - 1
+			for j >= 0 && (result[j] == ' ' || result[j] == '\t' || result[j] == '\n' || result[j] == '\r') {
+				j--
+			}
+			if j >= 0 && result[j] == ',' {
+				result = append(result[:j], result[j+1:]...)
+			}
+			result = append(result, c)
+		default:
+			result = append(result, c)
+		}
+	}
+	return result
+}
@@ -0,0 +1,370 @@
+// Package edit modifies the components and commands of a Devfile, preserving the formatting and comments
+// of the parts of the Devfile which are not modified
+package edit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	"gopkg.in/yaml.v3"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/devfile/validate"
+	"github.com/redhat-developer/odo/pkg/devfile/yamlnode"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+// commandTypes are the fields defining the type of a command, which can have a group
+var commandTypes = []string{"exec", "composite", "apply"}
+
+// Devfile is a Devfile being edited
+type Devfile struct {
+	doc  *yaml.Node
+	root *yaml.Node
+}
+
+// Parse parses the content of a Devfile to edit it
+func Parse(content []byte) (*Devfile, error) {
+	doc, err := yamlnode.ParseDocument(content)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the devfile: %w", err)
+	}
+	return &Devfile{doc: doc, root: doc.Content[0]}, nil
+}
+
+// Bytes returns the content of the edited Devfile
+func (d *Devfile) Bytes() ([]byte, error) {
+	return yamlnode.Encode(d.doc)
+}
+
+// EditFile applies the change to the Devfile file.
+// The file is written only if the result is a valid Devfile, otherwise the problems found are returned as an error.
+func EditFile(fsys filesystem.Filesystem, devfilePath string, change func(d *Devfile) error) error {
+	content, err := fsys.ReadFile(devfilePath)
+	if err != nil {
+		return err
+	}
+	d, err := Parse(content)
+	if err != nil {
+		return err
+	}
+	if err = change(d); err != nil {
+		return err
+	}
+	edited, err := d.Bytes()
+	if err != nil {
+		return err
+	}
+
+	var problems []string
+	for _, diagnostic := range validate.DiagnoseContent(devfilePath, edited) {
+		if diagnostic.Severity == api.DiagnosticSeverityError {
+			problems = append(problems, diagnostic.Message)
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("the devfile would be invalid after this change, it has not been modified:\n  - %s", strings.Join(problems, "\n  - "))
+	}
+
+	info, err := fsys.Stat(devfilePath)
+	if err != nil {
+		return err
+	}
+	return fsys.WriteFile(devfilePath, edited, info.Mode().Perm())
+}
+
+// AddComponent adds the component at the end of the components of the Devfile
+func (d *Devfile) AddComponent(component devfilev1.Component) error {
+	if yamlnode.NamedItem(yamlnode.MappingValue(d.root, "components"), component.Name) != nil {
+		return fmt.Errorf("a component named %q already exists", component.Name)
+	}
+	return appendItem(d.root, "components", component)
+}
+
+// RemoveComponent removes the component from the Devfile
+func (d *Devfile) RemoveComponent(name string) error {
+	if !yamlnode.RemoveNamedItem(yamlnode.MappingValue(d.root, "components"), name) {
+		return fmt.Errorf("component %q not found", name)
+	}
+	removeIfEmpty(d.root, "components")
+	return nil
+}
+
+// RemoveContainer removes the container component from the Devfile
+func (d *Devfile) RemoveContainer(name string) error {
+	if _, err := d.container(name); err != nil {
+		return err
+	}
+	return d.RemoveComponent(name)
+}
+
+// AddEndpoint adds the endpoint to the container component
+func (d *Devfile) AddEndpoint(containerName string, endpoint devfilev1.Endpoint) error {
+	container, err := d.container(containerName)
+	if err != nil {
+		return err
+	}
+	if owner := d.endpointOwner(endpoint.Name); owner != "" {
+		return fmt.Errorf("an endpoint named %q already exists in the component %q", endpoint.Name, owner)
+	}
+	return appendItem(container, "endpoints", endpoint)
+}
+
+// RemoveEndpoint removes the endpoint from the container component defining it
+func (d *Devfile) RemoveEndpoint(name string) error {
+	owner := d.endpointOwner(name)
+	if owner == "" {
+		return fmt.Errorf("endpoint %q not found", name)
+	}
+	container, err := d.container(owner)
+	if err != nil {
+		return err
+	}
+	yamlnode.RemoveNamedItem(yamlnode.MappingValue(container, "endpoints"), name)
+	removeIfEmpty(container, "endpoints")
+	return nil
+}
+
+// SetEnv sets the environment variables of the container component.
+// The values of existing variables are replaced if overwrite is true, otherwise an error is returned.
+func (d *Devfile) SetEnv(containerName string, envVars []devfilev1.EnvVar, overwrite bool) error {
+	container, err := d.container(containerName)
+	if err != nil {
+		return err
+	}
+	for _, envVar := range envVars {
+		existing := yamlnode.NamedItem(yamlnode.MappingValue(container, "env"), envVar.Name)
+		if existing == nil {
+			if err = appendItem(container, "env", envVar); err != nil {
+				return err
+			}
+			continue
+		}
+		if !overwrite {
+			return fmt.Errorf("the environment variable %q is already defined in the component %q", envVar.Name, containerName)
+		}
+		if value := yamlnode.MappingValue(existing, "value"); value != nil && value.Kind == yaml.ScalarNode {
+			// modify the node in place, to keep its comments
+			value.Value, value.Tag, value.Style = envVar.Value, "!!str", 0
+			continue
+		}
+		yamlnode.SetMappingValue(existing, "value", &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: envVar.Value})
+	}
+	return nil
+}
+
+// RemoveEnv removes the environment variables from the container component
+func (d *Devfile) RemoveEnv(containerName string, names []string) error {
+	container, err := d.container(containerName)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if !yamlnode.RemoveNamedItem(yamlnode.MappingValue(container, "env"), name) {
+			return fmt.Errorf("the environment variable %q is not defined in the component %q", name, containerName)
+		}
+	}
+	removeIfEmpty(container, "env")
+	return nil
+}
+
+// AddVolumeMount mounts a volume in the container component
+func (d *Devfile) AddVolumeMount(containerName string, mount devfilev1.VolumeMount) error {
+	container, err := d.container(containerName)
+	if err != nil {
+		return err
+	}
+	if yamlnode.NamedItem(yamlnode.MappingValue(container, "volumeMounts"), mount.Name) != nil {
+		return fmt.Errorf("the volume %q is already mounted in the component %q", mount.Name, containerName)
+	}
+	return appendItem(container, "volumeMounts", mount)
+}
+
+// RemoveVolume removes the volume component, and its mounts from the container components
+func (d *Devfile) RemoveVolume(name string) error {
+	component := yamlnode.NamedItem(yamlnode.MappingValue(d.root, "components"), name)
+	if yamlnode.MappingValue(component, "volume") == nil {
+		return fmt.Errorf("volume component %q not found", name)
+	}
+	for _, item := range yamlnode.SequenceItems(yamlnode.MappingValue(d.root, "components")) {
+		container := yamlnode.MappingValue(item, "container")
+		if container == nil {
+			continue
+		}
+		yamlnode.RemoveNamedItem(yamlnode.MappingValue(container, "volumeMounts"), name)
+		removeIfEmpty(container, "volumeMounts")
+	}
+	return d.RemoveComponent(name)
+}
+
+// AddCommand adds the command at the end of the commands of the Devfile.
+// If the command is the default one of its group, the other commands of the group are not default anymore.
+func (d *Devfile) AddCommand(command devfilev1.Command) error {
+	if yamlnode.NamedItem(yamlnode.MappingValue(d.root, "commands"), command.Id) != nil {
+		return fmt.Errorf("a command with id %q already exists", command.Id)
+	}
+	if group := common.GetGroup(command); group != nil && group.IsDefault != nil && *group.IsDefault {
+		d.unsetDefault(group.Kind, command.Id)
+	}
+	return appendItem(d.root, "commands", command)
+}
+
+// RemoveCommand removes the command from the Devfile
+func (d *Devfile) RemoveCommand(id string) error {
+	if !yamlnode.RemoveNamedItem(yamlnode.MappingValue(d.root, "commands"), id) {
+		return fmt.Errorf("command %q not found", id)
+	}
+	removeIfEmpty(d.root, "commands")
+	return nil
+}
+
+// SetCommandGroup sets the group of the command, or removes it from its group if group is nil.
+// If the command is the default one of the group, the other commands of the group are not default anymore.
+func (d *Devfile) SetCommandGroup(id string, group *devfilev1.CommandGroup) error {
+	command := yamlnode.NamedItem(yamlnode.MappingValue(d.root, "commands"), id)
+	if command == nil {
+		return fmt.Errorf("command %q not found", id)
+	}
+	body := commandBody(command)
+	if body == nil {
+		return fmt.Errorf("the command %q cannot be part of a group, only exec, composite and apply commands can", id)
+	}
+	if group == nil {
+		yamlnode.RemoveMappingKey(body, "group")
+		return nil
+	}
+	if group.IsDefault != nil && *group.IsDefault {
+		d.unsetDefault(group.Kind, id)
+	}
+	node, err := yamlnode.FromValue(group)
+	if err != nil {
+		return err
+	}
+	yamlnode.SetMappingValue(body, "group", node)
+	return nil
+}
+
+// container returns the container node of the container component
+func (d *Devfile) container(name string) (*yaml.Node, error) {
+	component := yamlnode.NamedItem(yamlnode.MappingValue(d.root, "components"), name)
+	container := yamlnode.MappingValue(component, "container")
+	if container == nil {
+		return nil, fmt.Errorf("container component %q not found", name)
+	}
+	return container, nil
+}
+
+// endpointOwner returns the name of the container component defining the endpoint, or an empty string if not found
+func (d *Devfile) endpointOwner(name string) string {
+	for _, component := range yamlnode.SequenceItems(yamlnode.MappingValue(d.root, "components")) {
+		for _, kind := range []string{"container", "kubernetes", "openshift"} {
+			if yamlnode.NamedItem(yamlnode.MappingValue(yamlnode.MappingValue(component, kind), "endpoints"), name) != nil {
+				return yamlnode.ItemKey(component)
+			}
+		}
+	}
+	return ""
+}
+
+// unsetDefault removes the isDefault field from the commands of the group kind, except the command with the id
+func (d *Devfile) unsetDefault(kind devfilev1.CommandGroupKind, id string) {
+	for _, command := range yamlnode.SequenceItems(yamlnode.MappingValue(d.root, "commands")) {
+		if yamlnode.ItemKey(command) == id {
+			continue
+		}
+		group := yamlnode.MappingValue(commandBody(command), "group")
+		if yamlnode.ScalarValue(group, "kind") == string(kind) {
+			yamlnode.RemoveMappingKey(group, "isDefault")
+		}
+	}
+}
+
+// commandBody returns the node defining the command which can have a group (exec, composite or apply), or nil
+func commandBody(command *yaml.Node) *yaml.Node {
+	for _, commandType := range commandTypes {
+		if body := yamlnode.MappingValue(command, commandType); body != nil {
+			return body
+		}
+	}
+	return nil
+}
+
+// appendItem appends the value to the sequence of the field of the mapping node, creating the field if needed
+func appendItem(node *yaml.Node, field string, value interface{}) error {
+	item, err := yamlnode.FromValue(value)
+	if err != nil {
+		return err
+	}
+	sequence := yamlnode.MappingValue(node, field)
+	if sequence == nil || sequence.Kind != yaml.SequenceNode {
+		sequence = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		yamlnode.SetMappingValue(node, field, sequence)
+	}
+	sequence.Content = append(sequence.Content, item)
+	return nil
+}
+
+// removeIfEmpty removes the field from the mapping node if its value is an empty sequence
+func removeIfEmpty(node *yaml.Node, field string) {
+	if sequence := yamlnode.MappingValue(node, field); sequence != nil && sequence.Kind == yaml.SequenceNode && len(sequence.Content) == 0 {
+		yamlnode.RemoveMappingKey(node, field)
+	}
+}
+
+// ParseEnvVars parses the environment variables defined as NAME=VALUE pairs
+func ParseEnvVars(pairs []string) ([]devfilev1.EnvVar, error) {
+	var envVars []devfilev1.EnvVar
+	names := map[string]bool{}
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) < 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid environment variable %q, please specify a NAME=VALUE pair", pair)
+		}
+		if names[parts[0]] {
+			return nil, fmt.Errorf("multiple values found for the environment variable %q", parts[0])
+		}
+		names[parts[0]] = true
+		envVars = append(envVars, devfilev1.EnvVar{Name: parts[0], Value: parts[1]})
+	}
+	return envVars, nil
+}
+
+// ContainerName returns the name of the container component to edit: name if not empty,
+// or the name of the only container component of the Devfile
+func ContainerName(devfileObj parser.DevfileObj, name string) (string, error) {
+	containers, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{ComponentType: devfilev1.ContainerComponentType},
+	})
+	if err != nil {
+		return "", err
+	}
+	if name != "" {
+		for _, container := range containers {
+			if container.Name == name {
+				return name, nil
+			}
+		}
+		return "", fmt.Errorf("container component %q not found", name)
+	}
+	switch len(containers) {
+	case 0:
+		return "", errors.New("the devfile does not define any container component")
+	case 1:
+		return containers[0].Name, nil
+	}
+	return "", errors.New("the devfile defines several container components, please specify the container component with --container")
+}
+
+// ValidateGroupKind returns an error if kind is not empty and is not a kind of command group
+func ValidateGroupKind(kind string) error {
+	switch devfilev1.CommandGroupKind(kind) {
+	case "", devfilev1.BuildCommandGroupKind, devfilev1.RunCommandGroupKind, devfilev1.TestCommandGroupKind,
+		devfilev1.DebugCommandGroupKind, devfilev1.DeployCommandGroupKind:
+		return nil
+	}
+	return fmt.Errorf("invalid group %q, must be one of build, run, test, debug or deploy", kind)
+}
@@ -0,0 +1,264 @@
+package edit
+
+import (
+	"testing"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/pointer"
+)
+
+const devfileContent = `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+components:
+  # the runtime container
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-16:latest
+      env:
+        - name: DEBUG
+          value: "false" # debug mode
+      endpoints:
+        - name: http
+          targetPort: 3000
+commands:
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+      group:
+        kind: run
+        isDefault: true
+`
+
+func TestDevfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(d *Devfile) error
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "add a container and an endpoint",
+			change: func(d *Devfile) error {
+				err := d.AddComponent(devfilev1.Component{
+					Name: "db",
+					ComponentUnion: devfilev1.ComponentUnion{
+						Container: &devfilev1.ContainerComponent{
+							Container: devfilev1.Container{Image: "postgres:14", MemoryLimit: "512Mi"},
+						},
+					},
+				})
+				if err != nil {
+					return err
+				}
+				return d.AddEndpoint("db", devfilev1.Endpoint{Name: "pg", TargetPort: 5432, Exposure: devfilev1.InternalEndpointExposure, Protocol: devfilev1.TCPEndpointProtocol})
+			},
+			want: `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+components:
+  # the runtime container
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-16:latest
+      env:
+        - name: DEBUG
+          value: "false" # debug mode
+      endpoints:
+        - name: http
+          targetPort: 3000
+  - name: db
+    container:
+      image: postgres:14
+      memoryLimit: 512Mi
+      endpoints:
+        - name: pg
+          targetPort: 5432
+          exposure: internal
+          protocol: tcp
+commands:
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+      group:
+        kind: run
+        isDefault: true
+`,
+		},
+		{
+			name: "add an existing endpoint",
+			change: func(d *Devfile) error {
+				return d.AddEndpoint("runtime", devfilev1.Endpoint{Name: "http", TargetPort: 8080})
+			},
+			wantErr: true,
+		},
+		{
+			name: "set and remove env vars",
+			change: func(d *Devfile) error {
+				err := d.SetEnv("runtime", []devfilev1.EnvVar{{Name: "DEBUG", Value: "true"}, {Name: "PORT", Value: "3000"}}, true)
+				if err != nil {
+					return err
+				}
+				return d.RemoveEnv("runtime", []string{"PORT"})
+			},
+			want: `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+components:
+  # the runtime container
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-16:latest
+      env:
+        - name: DEBUG
+          value: "true" # debug mode
+      endpoints:
+        - name: http
+          targetPort: 3000
+commands:
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+      group:
+        kind: run
+        isDefault: true
+`,
+		},
+		{
+			name: "add an existing env var",
+			change: func(d *Devfile) error {
+				return d.SetEnv("runtime", []devfilev1.EnvVar{{Name: "DEBUG", Value: "true"}}, false)
+			},
+			wantErr: true,
+		},
+		{
+			name: "add a default command and remove the endpoint",
+			change: func(d *Devfile) error {
+				err := d.AddCommand(devfilev1.Command{
+					Id: "run-debug",
+					CommandUnion: devfilev1.CommandUnion{
+						Exec: &devfilev1.ExecCommand{
+							LabeledCommand: devfilev1.LabeledCommand{
+								BaseCommand: devfilev1.BaseCommand{
+									Group: &devfilev1.CommandGroup{Kind: devfilev1.RunCommandGroupKind, IsDefault: pointer.Bool(true)},
+								},
+							},
+							Component:   "runtime",
+							CommandLine: "npm run debug",
+						},
+					},
+				})
+				if err != nil {
+					return err
+				}
+				return d.RemoveEndpoint("http")
+			},
+			want: `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+components:
+  # the runtime container
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-16:latest
+      env:
+        - name: DEBUG
+          value: "false" # debug mode
+commands:
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+      group:
+        kind: run
+  - id: run-debug
+    exec:
+      group:
+        kind: run
+        isDefault: true
+      commandLine: npm run debug
+      component: runtime
+`,
+		},
+		{
+			name: "add and remove a volume",
+			change: func(d *Devfile) error {
+				err := d.AddComponent(devfilev1.Component{
+					Name: "data",
+					ComponentUnion: devfilev1.ComponentUnion{
+						Volume: &devfilev1.VolumeComponent{Volume: devfilev1.Volume{Size: "1Gi"}},
+					},
+				})
+				if err != nil {
+					return err
+				}
+				if err = d.AddVolumeMount("runtime", devfilev1.VolumeMount{Name: "data", Path: "/data"}); err != nil {
+					return err
+				}
+				return d.RemoveVolume("data")
+			},
+			want: devfileContent,
+		},
+		{
+			name: "change the group of a command",
+			change: func(d *Devfile) error {
+				return d.SetCommandGroup("run", &devfilev1.CommandGroup{Kind: devfilev1.DebugCommandGroupKind})
+			},
+			want: `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+components:
+  # the runtime container
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-16:latest
+      env:
+        - name: DEBUG
+          value: "false" # debug mode
+      endpoints:
+        - name: http
+          targetPort: 3000
+commands:
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+      group:
+        kind: debug
+`,
+		},
+		{
+			name: "remove a missing command",
+			change: func(d *Devfile) error {
+				return d.RemoveCommand("build")
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := Parse([]byte(devfileContent))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = tt.change(d)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got, err := d.Bytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, string(got)); diff != "" {
+				t.Errorf("Devfile mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package image
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ValidateBuildArgs checks that the build arguments are in the form KEY=VALUE, or KEY to use the value of the environment variable
+func ValidateBuildArgs(buildArgs []string) error {
+	for _, arg := range buildArgs {
+		if strings.SplitN(arg, "=", 2)[0] == "" {
+			return fmt.Errorf("invalid build argument %q, the format should be KEY=VALUE", arg)
+		}
+	}
+	return nil
+}
+
+// ValidateSecrets checks that the build secrets are in the form id=ID,src=PATH or id=ID,env=VARIABLE,
+// and that the files exist
+func ValidateSecrets(secrets []string) error {
+	for _, secret := range secrets {
+		fields := map[string]string{}
+		for _, field := range strings.Split(secret, ",") {
+			parts := strings.SplitN(field, "=", 2)
+			if len(parts) != 2 || parts[1] == "" {
+				return fmt.Errorf("invalid secret %q, the format should be id=ID,src=PATH", secret)
+			}
+			fields[parts[0]] = parts[1]
+		}
+		if fields["id"] == "" {
+			return fmt.Errorf("invalid secret %q, the id field is missing", secret)
+		}
+		if src, ok := fields["src"]; ok {
+			if _, err := os.Stat(src); err != nil {
+				return fmt.Errorf("invalid secret %q: %w", fields["id"], err)
+			}
+		} else if fields["env"] == "" {
+			return fmt.Errorf("invalid secret %q, one of the src or env fields is required", secret)
+		}
+	}
+	return nil
+}
+
+// getBuildArgs returns the arguments passed to the build command, from the arguments defined in the devfile,
+// merged with the build arguments, target and secrets defined in options.
+// The build arguments and target defined in options override the ones defined in the devfile.
+func getBuildArgs(devfileArgs []string, options BuildOptions) []string {
+	overridden := map[string]bool{}
+	for _, arg := range options.BuildArgs {
+		overridden[buildArgKey(arg)] = true
+	}
+
+	result := make([]string, 0, len(devfileArgs)+2*(len(options.BuildArgs)+len(options.Secrets))+2)
+	for i := 0; i < len(devfileArgs); i++ {
+		arg := devfileArgs[i]
+		switch {
+		case arg == "--build-arg" && i+1 < len(devfileArgs):
+			if overridden[buildArgKey(devfileArgs[i+1])] {
+				i++
+				continue
+			}
+		case strings.HasPrefix(arg, "--build-arg="):
+			if overridden[buildArgKey(strings.TrimPrefix(arg, "--build-arg="))] {
+				continue
+			}
+		case arg == "--target" && i+1 < len(devfileArgs):
+			if options.Target != "" {
+				i++
+				continue
+			}
+		case strings.HasPrefix(arg, "--target="):
+			if options.Target != "" {
+				continue
+			}
+		}
+		result = append(result, arg)
+	}
+
+	for _, arg := range options.BuildArgs {
+		result = append(result, "--build-arg", arg)
+	}
+	if options.Target != "" {
+		result = append(result, "--target", options.Target)
+	}
+	// The secrets are mounted only while running the build instructions using them, and are never stored in the image layers
+	for _, secret := range options.Secrets {
+		result = append(result, "--secret", secret)
+	}
+	return result
+}
+
+// buildArgKey returns the key of a build argument in the form KEY=VALUE
+func buildArgKey(arg string) string {
+	return strings.SplitN(arg, "=", 2)[0]
+}
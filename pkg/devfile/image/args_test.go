@@ -0,0 +1,55 @@
+package image
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateSecrets(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "secret")
+	if err := os.WriteFile(src, []byte("content"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		secrets []string
+		wantErr bool
+	}{
+		{
+			name:    "file",
+			secrets: []string{"id=token,src=" + src},
+		},
+		{
+			name:    "environment variable",
+			secrets: []string{"id=token,env=TOKEN"},
+		},
+		{
+			name:    "missing id",
+			secrets: []string{"src=" + src},
+			wantErr: true,
+		},
+		{
+			name:    "missing source",
+			secrets: []string{"id=token"},
+			wantErr: true,
+		},
+		{
+			name:    "file not found",
+			secrets: []string{"id=token,src=" + src + ".notfound"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid format",
+			secrets: []string{"token"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateSecrets(tt.secrets); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSecrets() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package image
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	gitignore "github.com/sabhiram/go-gitignore"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+const (
+	// digestsFileName is the name of the file, inside the .odo directory, storing the digests of the last images built
+	digestsFileName = "image-digests.json"
+	// dockerIgnoreFile is the name of the file containing the patterns of files to exclude from the build context
+	dockerIgnoreFile = ".dockerignore"
+)
+
+// imageDigest is the information recorded after an image has been successfully built
+type imageDigest struct {
+	// Digest of the build context, Dockerfile and build arguments used to build the image
+	Digest string `json:"digest"`
+	// Pushed indicates if the image has been pushed to its registry after being built
+	Pushed bool `json:"pushed"`
+	// LoadedInto is the local cluster into which the image has been loaded after being built, if any
+	LoadedInto string `json:"loadedInto,omitempty"`
+}
+
+// getDigestsFilePath returns the path of the file storing the image digests for the devfile in devfilePath directory
+func getDigestsFilePath(devfilePath string) string {
+	return filepath.Join(devfilePath, util.DotOdoDirectory, digestsFileName)
+}
+
+// readDigests returns the image digests recorded for the devfile in devfilePath directory.
+// An empty map is returned if no digest has been recorded yet.
+func readDigests(fsys filesystem.Filesystem, devfilePath string) (map[string]imageDigest, error) {
+	result := map[string]imageDigest{}
+	content, err := fsys.ReadFile(getDigestsFilePath(devfilePath))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return result, nil
+		}
+		return nil, err
+	}
+	err = json.Unmarshal(content, &result)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read image digests from %q: %w", getDigestsFilePath(devfilePath), err)
+	}
+	return result, nil
+}
+
+// recordDigest saves the digest for the imageName image
+func recordDigest(fsys filesystem.Filesystem, devfilePath string, imageName string, digest imageDigest) error {
+	digests, err := readDigests(fsys, devfilePath)
+	if err != nil {
+		return err
+	}
+	digests[imageName] = digest
+	content, err := json.MarshalIndent(digests, "", " ")
+	if err != nil {
+		return err
+	}
+	filename := getDigestsFilePath(devfilePath)
+	err = fsys.MkdirAll(filepath.Dir(filename), 0750)
+	if err != nil {
+		return err
+	}
+	return fsys.WriteFile(filename, content, 0644)
+}
+
+// computeDigest computes a digest of all the inputs of an image build:
+// the image name, the target platforms, the content of the Dockerfile, the build arguments and the content
+// of the files in the build context not excluded by a .dockerignore file.
+func computeDigest(fsys filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, options BuildOptions) (string, error) {
+	if image.Dockerfile == nil {
+		return "", errors.New("only images built from a Dockerfile are supported")
+	}
+
+	h := sha256.New()
+	writeField(h, "image", image.ImageName)
+	for _, arg := range getBuildArgs(image.Dockerfile.Args, options) {
+		writeField(h, "arg", arg)
+	}
+	for _, platform := range options.Platforms {
+		writeField(h, "platform", platform)
+	}
+
+	dockerfile, isTemp, err := resolveAndDownloadDockerfile(fsys, image.Dockerfile.Uri)
+	if isTemp {
+		defer func(path string) {
+			if e := fsys.Remove(path); e != nil {
+				klog.V(3).Infof("could not remove temporary Dockerfile at path %q: %v", path, e)
+			}
+		}(dockerfile)
+	}
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsAbs(dockerfile) {
+		dockerfile = filepath.Join(devfilePath, dockerfile)
+	}
+	content, err := fsys.ReadFile(dockerfile)
+	if err != nil {
+		return "", err
+	}
+	writeField(h, "dockerfile", string(content))
+
+	err = hashBuildContext(fsys, h, resolveBuildContext(image, devfilePath))
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// resolveBuildContext returns the absolute path of the build context of the image
+func resolveBuildContext(image *devfile.ImageComponent, devfilePath string) string {
+	buildContext := image.Dockerfile.BuildContext
+	if buildContext == "" {
+		return devfilePath
+	}
+	buildContext = os.Expand(buildContext, func(name string) string {
+		switch name {
+		case "PROJECTS_ROOT", "PROJECT_SOURCE":
+			return devfilePath
+		}
+		return os.Getenv(name)
+	})
+	if !filepath.IsAbs(buildContext) {
+		buildContext = filepath.Join(devfilePath, buildContext)
+	}
+	return buildContext
+}
+
+// hashBuildContext writes into h the path, mode and content of all the files under buildContext,
+// except the ones matching the rules of the .dockerignore file and the .odo directory
+func hashBuildContext(fsys filesystem.Filesystem, h hash.Hash, buildContext string) error {
+	var ignoreRules []string
+	content, err := fsys.ReadFile(filepath.Join(buildContext, dockerIgnoreFile))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ignoreRules = append(ignoreRules, line)
+	}
+	ignoreMatcher := gitignore.CompileIgnoreLines(ignoreRules...)
+
+	return fsys.Walk(buildContext, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(buildContext, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+		if info.IsDir() {
+			if info.Name() == util.DotOdoDirectory || ignoreMatcher.MatchesPath(rel+"/") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if ignoreMatcher.MatchesPath(rel) {
+			return nil
+		}
+		writeField(h, "file", fmt.Sprintf("%s:%o:%d", rel, info.Mode(), info.Size()))
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := fsys.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(h, f)
+		return err
+	})
+}
+
+// writeField writes a named value into h, delimited so that consecutive fields cannot be confused
+func writeField(h hash.Hash, name string, value string) {
+	_, _ = fmt.Fprintf(h, "%s\x00%d\x00%s\x00", name, len(value), value)
+}
@@ -0,0 +1,269 @@
+package image
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/golang/mock/gomock"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+func TestComputeDigest(t *testing.T) {
+	devfilePath, err := filepath.Abs(filepath.Join("home", "user", "project1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	image := &devfile.ImageComponent{
+		Image: devfile.Image{
+			ImageName: "registry.io/myimagename:tag",
+			ImageUnion: devfile.ImageUnion{
+				Dockerfile: &devfile.DockerfileImage{
+					DockerfileSrc: devfile.DockerfileSrc{
+						Uri: "./Dockerfile",
+					},
+					Dockerfile: devfile.Dockerfile{
+						BuildContext: "${PROJECTS_ROOT}",
+						Args:         []string{"--build-arg", "A=B"},
+					},
+				},
+			},
+		},
+	}
+
+	newFs := func(t *testing.T) filesystem.Filesystem {
+		fs := filesystem.NewFakeFs()
+		files := map[string]string{
+			"Dockerfile":    "FROM scratch",
+			".dockerignore": "# comment\n*.log\ntmp/\n",
+			"main.go":       "package main",
+			"app.log":       "a log",
+			"tmp/file":      "a temporary file",
+			".odo/devstate": "{}",
+		}
+		for name, content := range files {
+			path := filepath.Join(devfilePath, name)
+			if err := fs.MkdirAll(filepath.Dir(path), 0750); err != nil {
+				t.Fatal(err)
+			}
+			if err := fs.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return fs
+	}
+
+	tests := []struct {
+		name       string
+		modify     func(fs filesystem.Filesystem, image *devfile.ImageComponent) error
+		wantChange bool
+	}{
+		{
+			name:       "no change",
+			modify:     func(filesystem.Filesystem, *devfile.ImageComponent) error { return nil },
+			wantChange: false,
+		},
+		{
+			name: "source file modified",
+			modify: func(fs filesystem.Filesystem, _ *devfile.ImageComponent) error {
+				return fs.WriteFile(filepath.Join(devfilePath, "main.go"), []byte("package other"), 0644)
+			},
+			wantChange: true,
+		},
+		{
+			name: "Dockerfile modified",
+			modify: func(fs filesystem.Filesystem, _ *devfile.ImageComponent) error {
+				return fs.WriteFile(filepath.Join(devfilePath, "Dockerfile"), []byte("FROM busybox"), 0644)
+			},
+			wantChange: true,
+		},
+		{
+			name: "build args modified",
+			modify: func(_ filesystem.Filesystem, image *devfile.ImageComponent) error {
+				image.Dockerfile.Args = []string{"--build-arg", "A=C"}
+				return nil
+			},
+			wantChange: true,
+		},
+		{
+			name: "file ignored by .dockerignore modified",
+			modify: func(fs filesystem.Filesystem, _ *devfile.ImageComponent) error {
+				return fs.WriteFile(filepath.Join(devfilePath, "app.log"), []byte("another log"), 0644)
+			},
+			wantChange: false,
+		},
+		{
+			name: "file in directory ignored by .dockerignore added",
+			modify: func(fs filesystem.Filesystem, _ *devfile.ImageComponent) error {
+				return fs.WriteFile(filepath.Join(devfilePath, "tmp", "other"), []byte("content"), 0644)
+			},
+			wantChange: false,
+		},
+		{
+			name: "file in .odo directory modified",
+			modify: func(fs filesystem.Filesystem, _ *devfile.ImageComponent) error {
+				return fs.WriteFile(filepath.Join(devfilePath, ".odo", "devstate"), []byte(`{"a": 1}`), 0644)
+			},
+			wantChange: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := newFs(t)
+			img := image.DeepCopy()
+			before, err := computeDigest(fs, img, devfilePath, BuildOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			err = tt.modify(fs, img)
+			if err != nil {
+				t.Fatal(err)
+			}
+			after, err := computeDigest(fs, img, devfilePath, BuildOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotChange := before != after; gotChange != tt.wantChange {
+				t.Errorf("digest changed: %v, want %v", gotChange, tt.wantChange)
+			}
+		})
+	}
+}
+
+func TestBuildPushImageSkipsUpToDate(t *testing.T) {
+	devfilePath := filepath.Join("home", "user", "project1")
+	image := &devfile.ImageComponent{
+		Image: devfile.Image{
+			ImageName: "registry.io/myimagename:tag",
+			ImageUnion: devfile.ImageUnion{
+				Dockerfile: &devfile.DockerfileImage{
+					DockerfileSrc: devfile.DockerfileSrc{
+						Uri: "./Dockerfile",
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		firstPush       bool
+		push            bool
+		force           bool
+		exists          bool
+		firstLoadedInto string
+		cluster         LocalCluster
+		wantBuildCalled bool
+	}{
+		{
+			name:            "same inputs, image exists",
+			exists:          true,
+			wantBuildCalled: false,
+		},
+		{
+			name:            "same inputs, image does not exist anymore",
+			exists:          false,
+			wantBuildCalled: true,
+		},
+		{
+			name:            "same inputs, force",
+			exists:          true,
+			force:           true,
+			wantBuildCalled: true,
+		},
+		{
+			name:            "same inputs, push requested but not pushed at last build",
+			exists:          true,
+			push:            true,
+			wantBuildCalled: true,
+		},
+		{
+			name:            "same inputs, push requested and pushed at last build",
+			exists:          true,
+			firstPush:       true,
+			push:            true,
+			wantBuildCalled: false,
+		},
+		{
+			name:            "same inputs, load requested and loaded into the same cluster",
+			exists:          true,
+			firstLoadedInto: "kind/dev",
+			cluster:         LocalCluster{Type: KindCluster, Name: "dev"},
+			wantBuildCalled: false,
+		},
+		{
+			name:            "same inputs, load requested but not loaded at last build",
+			exists:          true,
+			cluster:         LocalCluster{Type: KindCluster, Name: "dev"},
+			wantBuildCalled: true,
+		},
+		{
+			name:            "same inputs, load requested but loaded into the cluster of another context",
+			exists:          true,
+			firstLoadedInto: "kind/dev",
+			cluster:         LocalCluster{Type: KindCluster, Name: "test"},
+			wantBuildCalled: true,
+		},
+		{
+			name:            "same inputs, load requested but loaded into another type of cluster",
+			exists:          true,
+			firstLoadedInto: "kind/dev",
+			cluster:         LocalCluster{Type: MinikubeCluster, Name: "dev"},
+			wantBuildCalled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getLocalCluster = func() (LocalCluster, error) {
+				return tt.cluster, nil
+			}
+			defer func() {
+				getLocalCluster = DetectLocalCluster
+			}()
+			options := BuildOptions{Force: tt.force, Load: tt.cluster.Type != ""}
+
+			fs := filesystem.NewFakeFs()
+			if err := fs.MkdirAll(devfilePath, 0750); err != nil {
+				t.Fatal(err)
+			}
+			if err := fs.WriteFile(filepath.Join(devfilePath, "Dockerfile"), []byte("FROM scratch"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			digest, err := computeDigest(fs, image, devfilePath, BuildOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = recordDigest(fs, devfilePath, image.ImageName, imageDigest{Digest: digest, Pushed: tt.firstPush, LoadedInto: tt.firstLoadedInto})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctrl := gomock.NewController(t)
+			backend := NewMockBackend(ctrl)
+			backend.EXPECT().Exists(image.ImageName).Return(tt.exists).AnyTimes()
+			// the image is saved into an archive before being loaded, which stops the test before running the load command
+			errSave := errors.New("unable to save the image")
+			if tt.wantBuildCalled {
+				backend.EXPECT().Build(fs, image, devfilePath, options).Return(nil).Times(1)
+				if tt.push {
+					backend.EXPECT().Push(image.ImageName, options).Return(nil).Times(1)
+				}
+				if options.Load {
+					backend.EXPECT().Save(image.ImageName, gomock.Any()).Return(errSave).Times(1)
+				}
+			}
+
+			err = buildPushImage(backend, fs, image, devfilePath, tt.push, options)
+			if tt.wantBuildCalled && options.Load {
+				if !errors.Is(err, errSave) {
+					t.Errorf("expected the image to be loaded again, got error %v", err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			ctrl.Finish()
+		})
+	}
+}
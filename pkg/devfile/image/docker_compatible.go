@@ -20,16 +20,32 @@ import (
 // DockerCompatibleBackend uses a CLI compatible with the docker CLI (at least docker itself and podman)
 type DockerCompatibleBackend struct {
 	name string
+
+	// buildxCommands contains, for each image built for multiple platforms with docker buildx, the command used to build it.
+	// A multi-platform image cannot be loaded into the local docker storage, and the same command, with the --push flag,
+	// needs to be run again (using the buildx cache) to push it.
+	buildxCommands map[string][]string
 }
 
 var _ Backend = (*DockerCompatibleBackend)(nil)
 
 func NewDockerCompatibleBackend(name string) *DockerCompatibleBackend {
-	return &DockerCompatibleBackend{name: name}
+	return &DockerCompatibleBackend{
+		name:           name,
+		buildxCommands: map[string][]string{},
+	}
 }
 
 // Build an image, as defined in devfile, using a Docker compatible CLI
-func (o *DockerCompatibleBackend) Build(fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string) error {
+// If platforms are specified in options, a manifest list is built (using podman --manifest or docker buildx)
+func (o *DockerCompatibleBackend) Build(fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, options BuildOptions) error {
+
+	if len(options.Platforms) > 0 {
+		err := o.checkPlatformsSupported(options.Platforms)
+		if err != nil {
+			return err
+		}
+	}
 
 	dockerfile, isTemp, err := resolveAndDownloadDockerfile(fs, image.Dockerfile.Uri)
 	if isTemp {
@@ -57,16 +73,32 @@ func (o *DockerCompatibleBackend) Build(fs filesystem.Filesystem, image *devfile
 		return err
 	}
 
-	shellCmd := getShellCommand(o.name, image, devfilePath, dockerfile)
+	if len(options.Platforms) > 0 && isPodman(o.name) {
+		// Building with --manifest adds the images to the manifest list if it already exists,
+		// remove it so that it contains only the images of this build
+		rmCmd := exec.Command(o.name, "manifest", "rm", image.ImageName)
+		if e := rmCmd.Run(); e != nil {
+			klog.V(4).Infof("no manifest list %q removed: %v", image.ImageName, e)
+		}
+	}
+
+	shellCmd := getShellCommand(o.name, image, devfilePath, dockerfile, options)
 	klog.V(4).Infof("Running command: %v", shellCmd)
 	for i, cmd := range shellCmd {
 		shellCmd[i] = os.ExpandEnv(cmd)
 	}
+	if isBuildxMultiPlatform(o.name, options) {
+		o.buildxCommands[image.ImageName] = shellCmd
+	}
 	cmd := exec.Command(shellCmd[0], shellCmd[1:]...)
 	cmdEnv := []string{
 		"PROJECTS_ROOT=" + devfilePath,
 		"PROJECT_SOURCE=" + devfilePath,
 	}
+	if len(options.Secrets) > 0 && !isPodman(o.name) {
+		// Build secrets are supported by docker with BuildKit only
+		cmdEnv = append(cmdEnv, "DOCKER_BUILDKIT=1")
+	}
 	cmd.Env = append(os.Environ(), cmdEnv...)
 	cmd.Stdout = log.GetStdout()
 	cmd.Stderr = log.GetStderr()
@@ -76,6 +108,10 @@ func (o *DockerCompatibleBackend) Build(fs filesystem.Filesystem, image *devfile
 	defer color.Unset()
 	err = cmd.Run()
 	if err != nil {
+		if len(options.Platforms) > 0 {
+			return fmt.Errorf("error running %s command to build image for platforms %s (building for a platform different from the one of the host requires emulation, see https://github.com/multiarch/qemu-user-static): %w",
+				o.name, strings.Join(options.Platforms, ","), err)
+		}
 		return fmt.Errorf("error running %s command: %w", o.name, err)
 	}
 
@@ -117,7 +153,9 @@ func resolveAndDownloadDockerfile(fs filesystem.Filesystem, uri string) (string,
 
 // getShellCommand creates the docker compatible build command from detected backend,
 // container image and devfile path
-func getShellCommand(cmdName string, image *devfile.ImageComponent, devfilePath string, dockerfilePath string) []string {
+// When platforms are specified in options, the command builds a manifest list, using the --manifest flag with podman,
+// or docker buildx with docker
+func getShellCommand(cmdName string, image *devfile.ImageComponent, devfilePath string, dockerfilePath string, options BuildOptions) []string {
 	var shellCmd []string
 	imageName := image.ImageName
 	dockerfile := dockerfilePath
@@ -128,31 +166,66 @@ func getShellCommand(cmdName string, image *devfile.ImageComponent, devfilePath
 	if buildpath == "" {
 		buildpath = devfilePath
 	}
-	args := image.Dockerfile.Args
-	shellCmd = []string{
-		cmdName,
-		"build",
-		"-t",
-		imageName,
+	args := getBuildArgs(image.Dockerfile.Args, options)
+
+	switch {
+	case len(options.Platforms) == 0:
+		shellCmd = []string{cmdName, "build", "-t", imageName}
+	case isPodman(cmdName):
+		shellCmd = []string{cmdName, "build", "--platform", strings.Join(options.Platforms, ","), "--manifest", imageName}
+	default:
+		shellCmd = []string{cmdName, "buildx", "build", "--platform", strings.Join(options.Platforms, ","), "-t", imageName}
+		if !isBuildxMultiPlatform(cmdName, options) {
+			// An image built for a single platform can be loaded into the local docker storage, and pushed from there
+			shellCmd = append(shellCmd, "--load")
+		}
+	}
+	shellCmd = append(shellCmd,
 		"-f",
 		dockerfile,
 		buildpath,
-	}
+	)
 	if len(args) > 0 {
 		shellCmd = append(shellCmd, args...)
 	}
 	return shellCmd
 }
 
+// isPodman returns true if the docker compatible CLI cmdName is podman
+func isPodman(cmdName string) bool {
+	return strings.Contains(filepath.Base(cmdName), "podman")
+}
+
+// isBuildxMultiPlatform returns true if the image is built with docker buildx for several platforms
+func isBuildxMultiPlatform(cmdName string, options BuildOptions) bool {
+	return !isPodman(cmdName) && len(options.Platforms) > 1
+}
+
 // Push an image to its registry using a Docker compatible CLI
-func (o *DockerCompatibleBackend) Push(image string) error {
+// If platforms are specified in options, the manifest list and all the images it references are pushed
+func (o *DockerCompatibleBackend) Push(image string, options BuildOptions) error {
 
 	// We use a "No Spin" since we are outputting to stdout / stderr
 	pushSpinner := log.SpinnerNoSpin("Pushing image to container registry")
 	defer pushSpinner.End(false)
-	klog.V(4).Infof("Running command: %s push %s", o.name, image)
 
-	cmd := exec.Command(o.name, "push", image)
+	var shellCmd []string
+	switch {
+	case len(options.Platforms) > 0 && isPodman(o.name):
+		shellCmd = []string{o.name, "manifest", "push", "--all", image, "docker://" + image}
+	case isBuildxMultiPlatform(o.name, options):
+		buildCmd, ok := o.buildxCommands[image]
+		if !ok {
+			return fmt.Errorf("image %q must be built before being pushed", image)
+		}
+		// The layers are taken from the buildx cache populated during the build
+		shellCmd = append(buildCmd[:len(buildCmd):len(buildCmd)], "--push")
+	default:
+		shellCmd = []string{o.name, "push", image}
+	}
+	klog.V(4).Infof("Running command: %v", shellCmd)
+
+	cmd := exec.Command(shellCmd[0], shellCmd[1:]...)
 
 	cmd.Stdout = log.GetStdout()
 	cmd.Stderr = log.GetStderr()
@@ -169,6 +242,62 @@ func (o *DockerCompatibleBackend) Push(image string) error {
 	return nil
 }
 
+// Exists returns true if the image is present in the local storage, using a Docker compatible CLI
+func (o *DockerCompatibleBackend) Exists(image string) bool {
+	klog.V(4).Infof("Running command: %s image inspect %s", o.name, image)
+	cmd := exec.Command(o.name, "image", "inspect", image)
+	return cmd.Run() == nil
+}
+
+// Save an image from the local storage into an archive, using a Docker compatible CLI
+func (o *DockerCompatibleBackend) Save(image string, dest string) error {
+	klog.V(4).Infof("Running command: %s save -o %s %s", o.name, dest, image)
+	cmd := exec.Command(o.name, "save", "-o", dest, image)
+	cmd.Stderr = log.GetStderr()
+	err := cmd.Run()
+	if err != nil {
+		return fmt.Errorf("error running %s command: %w", o.name, err)
+	}
+	return nil
+}
+
+// RepoDigest returns the reference by digest of the image in its registry, using a Docker compatible CLI
+func (o *DockerCompatibleBackend) RepoDigest(image string) (string, error) {
+	format := "{{range .RepoDigests}}{{println .}}{{end}}"
+	klog.V(4).Infof("Running command: %s image inspect --format %s %s", o.name, format, image)
+	out, err := exec.Command(o.name, "image", "inspect", "--format", format, image).Output()
+	if err != nil {
+		return "", fmt.Errorf("error running %s command: %w", o.name, err)
+	}
+	return selectRepoDigest(image, strings.Fields(string(out))), nil
+}
+
+// selectRepoDigest returns the digest, from the repo digests of an image, referencing the repository of the image name
+func selectRepoDigest(image string, repoDigests []string) string {
+	repository := getRepository(image)
+	for _, repoDigest := range repoDigests {
+		digestRepository := getRepository(repoDigest)
+		// Depending on the backend, the default registry may or may not be part of the repository
+		if digestRepository == repository ||
+			strings.HasSuffix(digestRepository, "/"+repository) ||
+			strings.HasSuffix(repository, "/"+digestRepository) {
+			return repoDigest
+		}
+	}
+	return ""
+}
+
+// getRepository returns the repository of an image reference, without tag nor digest
+func getRepository(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image
+}
+
 // String return the name of the docker compatible CLI used
 func (o *DockerCompatibleBackend) String() string {
 	return o.name
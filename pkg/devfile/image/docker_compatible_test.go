@@ -21,6 +21,7 @@ func TestGetShellCommand(t *testing.T) {
 		cmdName     string
 		image       *devfile.ImageComponent
 		devfilePath string
+		options     BuildOptions
 		want        []string
 	}{
 		{
@@ -133,11 +134,103 @@ func TestGetShellCommand(t *testing.T) {
 				"cli", "build", "-t", "registry.io/myimagename:tag", "-f", filepath.Join("/", "path", "to", "Dockerfile.rhel"), devfilePath,
 			},
 		},
+		{
+			name:    "podman with several platforms",
+			cmdName: "podman",
+			image: &devfile.ImageComponent{
+				Image: devfile.Image{
+					ImageName: "registry.io/myimagename:tag",
+					ImageUnion: devfile.ImageUnion{
+						Dockerfile: &devfile.DockerfileImage{
+							DockerfileSrc: devfile.DockerfileSrc{
+								Uri: "Dockerfile",
+							},
+						},
+					},
+				},
+			},
+			devfilePath: devfilePath,
+			options:     BuildOptions{Platforms: []string{"linux/amd64", "linux/arm64"}},
+			want: []string{
+				"podman", "build", "--platform", "linux/amd64,linux/arm64", "--manifest", "registry.io/myimagename:tag", "-f", filepath.Join(devfilePath, "Dockerfile"), devfilePath,
+			},
+		},
+		{
+			name:    "docker with several platforms",
+			cmdName: "docker",
+			image: &devfile.ImageComponent{
+				Image: devfile.Image{
+					ImageName: "registry.io/myimagename:tag",
+					ImageUnion: devfile.ImageUnion{
+						Dockerfile: &devfile.DockerfileImage{
+							DockerfileSrc: devfile.DockerfileSrc{
+								Uri: "Dockerfile",
+							},
+						},
+					},
+				},
+			},
+			devfilePath: devfilePath,
+			options:     BuildOptions{Platforms: []string{"linux/amd64", "linux/arm64"}},
+			want: []string{
+				"docker", "buildx", "build", "--platform", "linux/amd64,linux/arm64", "-t", "registry.io/myimagename:tag", "-f", filepath.Join(devfilePath, "Dockerfile"), devfilePath,
+			},
+		},
+		{
+			name:    "docker with a single platform",
+			cmdName: "docker",
+			image: &devfile.ImageComponent{
+				Image: devfile.Image{
+					ImageName: "registry.io/myimagename:tag",
+					ImageUnion: devfile.ImageUnion{
+						Dockerfile: &devfile.DockerfileImage{
+							DockerfileSrc: devfile.DockerfileSrc{
+								Uri: "Dockerfile",
+							},
+						},
+					},
+				},
+			},
+			devfilePath: devfilePath,
+			options:     BuildOptions{Platforms: []string{"linux/arm64"}},
+			want: []string{
+				"docker", "buildx", "build", "--platform", "linux/arm64", "-t", "registry.io/myimagename:tag", "--load", "-f", filepath.Join(devfilePath, "Dockerfile"), devfilePath,
+			},
+		},
+		{
+			name:    "build args, target and secrets from the options",
+			cmdName: "cli",
+			image: &devfile.ImageComponent{
+				Image: devfile.Image{
+					ImageName: "registry.io/myimagename:tag",
+					ImageUnion: devfile.ImageUnion{
+						Dockerfile: &devfile.DockerfileImage{
+							DockerfileSrc: devfile.DockerfileSrc{
+								Uri: "Dockerfile",
+							},
+							Dockerfile: devfile.Dockerfile{
+								Args: []string{"--build-arg", "A=devfile", "--build-arg=B=devfile", "--target", "dev"},
+							},
+						},
+					},
+				},
+			},
+			devfilePath: devfilePath,
+			options: BuildOptions{
+				BuildArgs: []string{"A=cli"},
+				Target:    "prod",
+				Secrets:   []string{"id=npmrc,src=/home/user/.npmrc"},
+			},
+			want: []string{
+				"cli", "build", "-t", "registry.io/myimagename:tag", "-f", filepath.Join(devfilePath, "Dockerfile"), devfilePath,
+				"--build-arg=B=devfile", "--build-arg", "A=cli", "--target", "prod", "--secret", "id=npmrc,src=/home/user/.npmrc",
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := getShellCommand(tt.cmdName, tt.image, tt.devfilePath, tt.image.Dockerfile.Uri)
+			got := getShellCommand(tt.cmdName, tt.image, tt.devfilePath, tt.image.Dockerfile.Uri, tt.options)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
 				t.Errorf("getShellCommand() mismatch (-want +got):\n%s", diff)
 			}
@@ -9,6 +9,7 @@ import (
 
 	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	"k8s.io/klog"
 
 	envcontext "github.com/redhat-developer/odo/pkg/config/context"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
@@ -21,9 +22,16 @@ import (
 type Backend interface {
 	// Build the image as defined in the devfile.
 	// The filesystem specified will be used to download and store the Dockerfile if it is referenced as a remote URL.
-	Build(fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string) error
+	Build(fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, options BuildOptions) error
 	// Push the image to its registry as defined in the devfile
-	Push(image string) error
+	Push(image string, options BuildOptions) error
+	// Exists returns true if the image is present in the local storage of the backend
+	Exists(image string) bool
+	// Save the image from the local storage of the backend into the dest archive
+	Save(image string, dest string) error
+	// RepoDigest returns the reference by digest (name@sha256:...) of the image in its registry,
+	// or an empty string if the image has not been pushed
+	RepoDigest(image string) (string, error)
 	// Return the name of the backend
 	String() string
 }
@@ -32,6 +40,8 @@ var lookPathCmd = exec.LookPath
 
 // BuildPushImages build all images defined in the devfile with the detected backend
 // If push is true, also push the images to their registries
+// Images whose build inputs did not change since their last build are not built again,
+// unless the Force build option is set in ctx
 func BuildPushImages(ctx context.Context, fs filesystem.Filesystem, push bool) error {
 	var (
 		devfileObj  = odocontext.GetDevfileObj(ctx)
@@ -55,7 +65,12 @@ func BuildPushImages(ctx context.Context, fs filesystem.Filesystem, push bool) e
 	}
 
 	for _, component := range components {
-		err = buildPushImage(backend, fs, component.Image, path, push)
+		var options BuildOptions
+		options, err = getComponentBuildOptions(GetBuildOptions(ctx), component)
+		if err != nil {
+			return err
+		}
+		err = buildPushImage(backend, fs, component.Image, path, push, options)
 		if err != nil {
 			return err
 		}
@@ -65,6 +80,8 @@ func BuildPushImages(ctx context.Context, fs filesystem.Filesystem, push bool) e
 
 // BuildPushSpecificImage build an image defined in the devfile present in devfilePath
 // If push is true, also push the image to its registry
+// The image is not built again if its build inputs did not change since its last build,
+// unless the Force build option is set in ctx
 func BuildPushSpecificImage(ctx context.Context, fs filesystem.Filesystem, component devfile.Component, push bool) error {
 	var (
 		devfilePath = odocontext.GetDevfilePath(ctx)
@@ -74,36 +91,111 @@ func BuildPushSpecificImage(ctx context.Context, fs filesystem.Filesystem, compo
 	if err != nil {
 		return err
 	}
-	return buildPushImage(backend, fs, component.Image, path, push)
+	options, err := getComponentBuildOptions(GetBuildOptions(ctx), component)
+	if err != nil {
+		return err
+	}
+	return buildPushImage(backend, fs, component.Image, path, push, options)
 }
 
 // buildPushImage build an image using the provided backend
 // If push is true, also push the image to its registry
-func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, push bool) error {
+// The build and push are skipped if the digest of the build inputs matches the one recorded
+// during the last build, unless options.Force is true
+func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, push bool, options BuildOptions) error {
 	if image == nil {
 		return errors.New("image should not be nil")
 	}
+	if options.Load && len(options.Platforms) > 1 {
+		return errors.New("images built for several platforms cannot be loaded into a local cluster")
+	}
+	push = push && !options.SkipPush
+
+	var cluster LocalCluster
+	if options.Load {
+		var err error
+		cluster, err = getLocalCluster()
+		if err != nil {
+			return err
+		}
+	}
+
+	digest, err := computeDigest(fs, image, devfilePath, options)
+	if err != nil {
+		// Not being able to compute the digest must not prevent building the image
+		klog.V(4).Infof("unable to compute digest for image %q: %v", image.ImageName, err)
+	}
+	if !options.Force && digest != "" {
+		upToDate, err := isUpToDate(backend, fs, image.ImageName, devfilePath, digest, push, cluster)
+		if err != nil {
+			return err
+		}
+		if upToDate {
+			log.Sectionf("Building & Pushing Container: %s", image.ImageName)
+			log.Successf("Image %q is up to date, skipping build", image.ImageName)
+			return nil
+		}
+	}
+
 	log.Sectionf("Building & Pushing Container: %s", image.ImageName)
-	err := backend.Build(fs, image, devfilePath)
+	err = backend.Build(fs, image, devfilePath, options)
 	if err != nil {
 		return err
 	}
 	if push {
-		err = backend.Push(image.ImageName)
+		err = backend.Push(image.ImageName, options)
+		if err != nil {
+			return err
+		}
+	}
+	var loadedInto string
+	if options.Load {
+		err = loadImage(backend, fs, image.ImageName, cluster)
 		if err != nil {
 			return err
 		}
+		loadedInto = cluster.String()
+	}
+	if digest != "" {
+		err = recordDigest(fs, devfilePath, image.ImageName, imageDigest{Digest: digest, Pushed: push, LoadedInto: loadedInto})
+		if err != nil {
+			klog.V(3).Infof("unable to record digest for image %q: %v", image.ImageName, err)
+		}
 	}
 	return nil
 }
 
+// isUpToDate returns true if the image built during the last build with the same digest is still present locally,
+// has been pushed if push is true, and has been loaded into the local cluster if cluster is set.
+// An image loaded into another cluster, or into the cluster of another context or profile, is not up to date
+func isUpToDate(backend Backend, fs filesystem.Filesystem, imageName string, devfilePath string, digest string, push bool, cluster LocalCluster) (bool, error) {
+	digests, err := readDigests(fs, devfilePath)
+	if err != nil {
+		return false, err
+	}
+	recorded, found := digests[imageName]
+	if !found || recorded.Digest != digest {
+		return false, nil
+	}
+	if push && !recorded.Pushed {
+		return false, nil
+	}
+	if cluster.Type != "" && recorded.LoadedInto != cluster.String() {
+		return false, nil
+	}
+	return backend.Exists(imageName), nil
+}
+
 // selectBackend selects the container backend to use for building and pushing images
 // It will detect podman and docker CLIs (in this order),
 // or return an error if none are present locally
 func selectBackend(ctx context.Context) (Backend, error) {
 
-	podmanCmd := envcontext.GetEnvConfig(ctx).PodmanCmd
-	if _, err := lookPathCmd(podmanCmd); err == nil {
+	backend, err := lookupBackend(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if backend.String() == envcontext.GetEnvConfig(ctx).PodmanCmd {
 
 		// Podman does NOT build x86 images on Apple Silicon / M1 and we must *WARN* the user that this will not work.
 		// There is a temporary workaround in order to build x86 images on Apple Silicon / M1 by running the following commands:
@@ -115,10 +207,28 @@ func selectBackend(ctx context.Context) (Backend, error) {
 		// The open discussion is here: https://github.com/containers/podman/discussions/12899
 		//
 		// TODO: Remove this warning when Podman natively supports x86 images on Apple Silicon / M1.
-		if log.IsAppleSilicon() {
+		if log.IsAppleSilicon() && len(GetBuildOptions(ctx).Platforms) == 0 {
 			log.Warning("WARNING: Building images on Apple Silicon / M1 is not (yet) supported natively on Podman")
 			log.Warning("There is however a temporary workaround: https://github.com/containers/podman/discussions/12899")
 		}
+	}
+	return backend, nil
+}
+
+// GetRepoDigest returns the reference by digest (name@sha256:...) of the image in its registry,
+// as known by the local container backend, or an empty string if the image has not been pushed
+func GetRepoDigest(ctx context.Context, imageName string) (string, error) {
+	backend, err := lookupBackend(ctx)
+	if err != nil {
+		return "", err
+	}
+	return backend.RepoDigest(imageName)
+}
+
+// lookupBackend returns the backend to use, Podman being preferred over Docker
+func lookupBackend(ctx context.Context) (Backend, error) {
+	podmanCmd := envcontext.GetEnvConfig(ctx).PodmanCmd
+	if _, err := lookPathCmd(podmanCmd); err == nil {
 		return NewDockerCompatibleBackend(podmanCmd), nil
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"os/exec"
+	"path/filepath"
 	"testing"
 
 	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -15,10 +16,14 @@ import (
 )
 
 func TestBuildPushImage(t *testing.T) {
-	fakeFs := filesystem.NewFakeFs()
+	devfilePath := filepath.Join("home", "user", "project1")
+	dockerfile := &devfile.DockerfileImage{
+		DockerfileSrc: devfile.DockerfileSrc{
+			Uri: "./Dockerfile",
+		},
+	}
 	tests := []struct {
 		name            string
-		devfilePath     string
 		image           *devfile.ImageComponent
 		push            bool
 		BuildReturns    error
@@ -46,6 +51,9 @@ func TestBuildPushImage(t *testing.T) {
 			image: &devfile.ImageComponent{
 				Image: devfile.Image{
 					ImageName: "a name",
+					ImageUnion: devfile.ImageUnion{
+						Dockerfile: dockerfile,
+					},
 				},
 			},
 			push:            false,
@@ -58,6 +66,9 @@ func TestBuildPushImage(t *testing.T) {
 			image: &devfile.ImageComponent{
 				Image: devfile.Image{
 					ImageName: "a name",
+					ImageUnion: devfile.ImageUnion{
+						Dockerfile: dockerfile,
+					},
 				},
 			},
 			push:            true,
@@ -70,6 +81,9 @@ func TestBuildPushImage(t *testing.T) {
 			image: &devfile.ImageComponent{
 				Image: devfile.Image{
 					ImageName: "a name",
+					ImageUnion: devfile.ImageUnion{
+						Dockerfile: dockerfile,
+					},
 				},
 			},
 			push:            true,
@@ -84,6 +98,9 @@ func TestBuildPushImage(t *testing.T) {
 			image: &devfile.ImageComponent{
 				Image: devfile.Image{
 					ImageName: "a name",
+					ImageUnion: devfile.ImageUnion{
+						Dockerfile: dockerfile,
+					},
 				},
 			},
 			push:            true,
@@ -97,23 +114,40 @@ func TestBuildPushImage(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			fakeFs := filesystem.NewFakeFs()
+			if err := fakeFs.MkdirAll(devfilePath, 0750); err != nil {
+				t.Fatal(err)
+			}
+			if err := fakeFs.WriteFile(filepath.Join(devfilePath, "Dockerfile"), []byte("FROM scratch"), 0644); err != nil {
+				t.Fatal(err)
+			}
+
 			ctrl := gomock.NewController(t)
 			backend := NewMockBackend(ctrl)
 			if tt.wantBuildCalled {
-				backend.EXPECT().Build(fakeFs, tt.image, tt.devfilePath).Return(tt.BuildReturns).Times(1)
+				backend.EXPECT().Build(fakeFs, tt.image, devfilePath, BuildOptions{}).Return(tt.BuildReturns).Times(1)
 			} else {
-				backend.EXPECT().Build(fakeFs, nil, tt.devfilePath).Times(0)
+				backend.EXPECT().Build(fakeFs, nil, devfilePath, BuildOptions{}).Times(0)
 			}
 			if tt.wantPushCalled {
-				backend.EXPECT().Push(tt.image.ImageName).Return(tt.PushReturns).Times(1)
+				backend.EXPECT().Push(tt.image.ImageName, BuildOptions{}).Return(tt.PushReturns).Times(1)
 			} else {
-				backend.EXPECT().Push(nil).Times(0)
+				backend.EXPECT().Push(nil, BuildOptions{}).Times(0)
 			}
-			err := buildPushImage(backend, fakeFs, tt.image, "", tt.push)
+			err := buildPushImage(backend, fakeFs, tt.image, devfilePath, tt.push, BuildOptions{})
 
 			if tt.wantErr != (err != nil) {
 				t.Errorf("%s: Error result wanted %v, got %v", tt.name, tt.wantErr, err != nil)
 			}
+			if tt.image != nil {
+				digests, err := readDigests(fakeFs, devfilePath)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, recorded := digests[tt.image.ImageName]; recorded == tt.wantErr {
+					t.Errorf("%s: digest recorded %v, want %v", tt.name, recorded, !tt.wantErr)
+				}
+			}
 			ctrl.Finish()
 		})
 	}
@@ -0,0 +1,268 @@
+package image
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+// LocalClusterType is the type of a local cluster in which images can be loaded without using a registry
+type LocalClusterType string
+
+const (
+	KindCluster     LocalClusterType = "kind"
+	MinikubeCluster LocalClusterType = "minikube"
+	CRCCluster      LocalClusterType = "crc"
+)
+
+// LoadedImagePullPolicy is the pull policy set on the containers using images loaded into the local cluster.
+// The images are not present in any registry, and must not be pulled.
+const LoadedImagePullPolicy = corev1.PullIfNotPresent
+
+// LocalCluster is a local cluster detected from the kubeconfig context
+type LocalCluster struct {
+	Type LocalClusterType
+	// Name of the kind cluster, or of the minikube profile
+	Name string
+}
+
+// String returns the identifier of the local cluster, made of its type and the name of the cluster or profile
+func (o LocalCluster) String() string {
+	if o.Name == "" {
+		return string(o.Type)
+	}
+	return string(o.Type) + "/" + o.Name
+}
+
+// getLocalCluster returns the local cluster targeted by the current context of the kubeconfig
+var getLocalCluster = DetectLocalCluster
+
+// DetectLocalCluster detects the type of local cluster targeted by the current context of the kubeconfig.
+// An error is returned if the cluster is not a kind, minikube or CRC cluster.
+func DetectLocalCluster() (LocalCluster, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rawConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).RawConfig()
+	if err != nil {
+		return LocalCluster{}, err
+	}
+	return detectLocalCluster(rawConfig)
+}
+
+func detectLocalCluster(config clientcmdapi.Config) (LocalCluster, error) {
+	currentContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return LocalCluster{}, errors.New("no current context is defined in the kubeconfig")
+	}
+
+	// kind names the contexts "kind-<cluster name>"
+	if strings.HasPrefix(config.CurrentContext, "kind-") {
+		return LocalCluster{Type: KindCluster, Name: strings.TrimPrefix(config.CurrentContext, "kind-")}, nil
+	}
+
+	// minikube adds an extension to the contexts it creates, and names the contexts after the profile
+	if ext, found := currentContext.Extensions["context_info"]; found {
+		if content, err := json.Marshal(ext); err == nil && strings.Contains(string(content), "minikube.sigs.k8s.io") {
+			return LocalCluster{Type: MinikubeCluster, Name: config.CurrentContext}, nil
+		}
+	}
+	if config.CurrentContext == "minikube" {
+		return LocalCluster{Type: MinikubeCluster, Name: config.CurrentContext}, nil
+	}
+
+	if cluster, found := config.Clusters[currentContext.Cluster]; found && strings.Contains(cluster.Server, "api.crc.testing") {
+		return LocalCluster{Type: CRCCluster}, nil
+	}
+
+	return LocalCluster{}, fmt.Errorf("loading images is only supported on kind, minikube and CRC clusters, and the cluster of the current context %q is not recognized as one of them; push the images to a registry instead", config.CurrentContext)
+}
+
+// loadImage loads the image, built by backend, into the container storage of the local cluster
+func loadImage(backend Backend, fs filesystem.Filesystem, imageName string, cluster LocalCluster) error {
+	loadSpinner := log.SpinnerNoSpin(fmt.Sprintf("Loading image into the local %s cluster", cluster.Type))
+	defer loadSpinner.End(false)
+
+	archive, err := fs.TempFile("", "odo_*.tar")
+	if err != nil {
+		return err
+	}
+	archivePath := archive.Name()
+	_ = archive.Close()
+	defer func() {
+		if e := fs.Remove(archivePath); e != nil {
+			klog.V(3).Infof("could not remove temporary image archive at path %q: %v", archivePath, e)
+		}
+	}()
+
+	err = backend.Save(imageName, archivePath)
+	if err != nil {
+		return err
+	}
+
+	var cmd *exec.Cmd
+	switch cluster.Type {
+	case KindCluster:
+		cmd = exec.Command("kind", "load", "image-archive", archivePath, "--name", cluster.Name)
+	case MinikubeCluster:
+		cmd = exec.Command("minikube", "image", "load", archivePath, "--profile", cluster.Name)
+	case CRCCluster:
+		cmd, err = getCRCLoadCommand()
+		if err != nil {
+			return err
+		}
+		var archiveFile *os.File
+		archiveFile, err = os.Open(archivePath)
+		if err != nil {
+			return err
+		}
+		defer archiveFile.Close()
+		cmd.Stdin = archiveFile
+	}
+	klog.V(4).Infof("Running command: %v", cmd.Args)
+	cmd.Stdout = log.GetStdout()
+	cmd.Stderr = log.GetStderr()
+	err = cmd.Run()
+	if err != nil {
+		return fmt.Errorf("unable to load image %q into the %s cluster: %w", imageName, cluster.Type, err)
+	}
+
+	loadSpinner.End(true)
+	return nil
+}
+
+// getCRCLoadCommand returns the command loading the image archive, passed on its standard input,
+// into the container storage of the CRC virtual machine, shared by podman and CRI-O
+func getCRCLoadCommand() (*exec.Cmd, error) {
+	out, err := exec.Command("crc", "ip").Output()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the IP address of the CRC virtual machine: %w", err)
+	}
+	ip := strings.TrimSpace(string(out))
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+	var key string
+	for _, name := range []string{"id_ecdsa", "id_rsa"} {
+		candidate := filepath.Join(home, ".crc", "machines", "crc", name)
+		if _, err = os.Stat(candidate); err == nil {
+			key = candidate
+			break
+		}
+	}
+	if key == "" {
+		return nil, errors.New("unable to find the SSH key of the CRC virtual machine")
+	}
+
+	return exec.Command("ssh", "-i", key,
+		"-o", "StrictHostKeyChecking=no", "-o", "UserKnownHostsFile=/dev/null", "-o", "IdentitiesOnly=yes",
+		"core@"+ip, "sudo", "podman", "load"), nil
+}
+
+// getImageNames returns the names of the images built from the Image components of the devfile
+func getImageNames(devfileObj parser.DevfileObj) (map[string]bool, error) {
+	components, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{ComponentType: devfile.ImageComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]bool, len(components))
+	for _, component := range components {
+		result[normalizeImageName(component.Image.ImageName)] = true
+	}
+	return result, nil
+}
+
+// normalizeImageName adds the implicit latest tag to an image name without tag nor digest
+func normalizeImageName(name string) string {
+	if strings.Contains(name, "@") {
+		return name
+	}
+	lastPart := name[strings.LastIndex(name, "/")+1:]
+	if !strings.Contains(lastPart, ":") {
+		return name + ":latest"
+	}
+	return name
+}
+
+// SetPullPolicyForLoadedImages sets the pull policy of the containers using one of the images built from the devfile,
+// when the images are loaded into the local cluster instead of being pushed to a registry
+func SetPullPolicyForLoadedImages(options BuildOptions, devfileObj parser.DevfileObj, containers []corev1.Container) error {
+	if !options.Load {
+		return nil
+	}
+	images, err := getImageNames(devfileObj)
+	if err != nil {
+		return err
+	}
+	for i := range containers {
+		if images[normalizeImageName(containers[i].Image)] {
+			containers[i].ImagePullPolicy = LoadedImagePullPolicy
+		}
+	}
+	return nil
+}
+
+// SetPullPolicyForLoadedImagesInResource sets the pull policy of the containers defined in any pod template of the resource u
+// using one of the images built from the devfile, when the images are loaded into the local cluster instead of being pushed to a registry
+func SetPullPolicyForLoadedImagesInResource(options BuildOptions, devfileObj parser.DevfileObj, u *unstructured.Unstructured) error {
+	if !options.Load {
+		return nil
+	}
+	images, err := getImageNames(devfileObj)
+	if err != nil {
+		return err
+	}
+	setPullPolicy(u.Object, images)
+	return nil
+}
+
+// setPullPolicy walks through the object, and sets the pull policy of the containers and initContainers using one of the images
+func setPullPolicy(obj interface{}, images map[string]bool) {
+	walkContainers(obj, func(container map[string]interface{}) {
+		if image, ok := container["image"].(string); ok && images[normalizeImageName(image)] {
+			container["imagePullPolicy"] = string(LoadedImagePullPolicy)
+		}
+	})
+}
+
+// walkContainers walks through the object, and calls fn for each of the containers and initContainers it defines
+func walkContainers(obj interface{}, fn func(container map[string]interface{})) {
+	switch val := obj.(type) {
+	case map[string]interface{}:
+		for key, child := range val {
+			if key == "containers" || key == "initContainers" {
+				if list, ok := child.([]interface{}); ok {
+					for _, item := range list {
+						if container, ok := item.(map[string]interface{}); ok {
+							fn(container)
+						}
+					}
+				}
+				continue
+			}
+			walkContainers(child, fn)
+		}
+	case []interface{}:
+		for _, child := range val {
+			walkContainers(child, fn)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package image
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestDetectLocalCluster(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  clientcmdapi.Config
+		want    LocalCluster
+		wantErr bool
+	}{
+		{
+			name: "kind cluster",
+			config: clientcmdapi.Config{
+				CurrentContext: "kind-dev",
+				Contexts:       map[string]*clientcmdapi.Context{"kind-dev": {Cluster: "kind-dev"}},
+			},
+			want: LocalCluster{Type: KindCluster, Name: "dev"},
+		},
+		{
+			name: "minikube cluster with default profile",
+			config: clientcmdapi.Config{
+				CurrentContext: "minikube",
+				Contexts:       map[string]*clientcmdapi.Context{"minikube": {Cluster: "minikube"}},
+			},
+			want: LocalCluster{Type: MinikubeCluster, Name: "minikube"},
+		},
+		{
+			name: "CRC cluster",
+			config: clientcmdapi.Config{
+				CurrentContext: "default/api-crc-testing:6443/kubeadmin",
+				Contexts: map[string]*clientcmdapi.Context{
+					"default/api-crc-testing:6443/kubeadmin": {Cluster: "api-crc-testing:6443"},
+				},
+				Clusters: map[string]*clientcmdapi.Cluster{
+					"api-crc-testing:6443": {Server: "https://api.crc.testing:6443"},
+				},
+			},
+			want: LocalCluster{Type: CRCCluster},
+		},
+		{
+			name: "remote cluster",
+			config: clientcmdapi.Config{
+				CurrentContext: "prod",
+				Contexts:       map[string]*clientcmdapi.Context{"prod": {Cluster: "prod"}},
+				Clusters: map[string]*clientcmdapi.Cluster{
+					"prod": {Server: "https://api.example.com:6443"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "no current context",
+			config:  clientcmdapi.Config{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := detectLocalCluster(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("detectLocalCluster() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("detectLocalCluster() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetPullPolicy(t *testing.T) {
+	obj := map[string]interface{}{
+		"kind": "Deployment",
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"initContainers": []interface{}{
+						map[string]interface{}{"name": "init", "image": "quay.io/user/init"},
+					},
+					"containers": []interface{}{
+						map[string]interface{}{"name": "built", "image": "quay.io/user/app:latest"},
+						map[string]interface{}{"name": "other", "image": "quay.io/user/other:1.0"},
+					},
+				},
+			},
+		},
+	}
+	images := map[string]bool{
+		"quay.io/user/app:latest":  true,
+		"quay.io/user/init:latest": true,
+	}
+	setPullPolicy(obj, images)
+
+	want := map[string]interface{}{
+		"kind": "Deployment",
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"initContainers": []interface{}{
+						map[string]interface{}{"name": "init", "image": "quay.io/user/init", "imagePullPolicy": "IfNotPresent"},
+					},
+					"containers": []interface{}{
+						map[string]interface{}{"name": "built", "image": "quay.io/user/app:latest", "imagePullPolicy": "IfNotPresent"},
+						map[string]interface{}{"name": "other", "image": "quay.io/user/other:1.0"},
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, obj); diff != "" {
+		t.Errorf("setPullPolicy() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNormalizeImageName(t *testing.T) {
+	for name, want := range map[string]string{
+		"app":                           "app:latest",
+		"quay.io/user/app":              "quay.io/user/app:latest",
+		"localhost:5000/user/app":       "localhost:5000/user/app:latest",
+		"localhost:5000/user/app:1.0":   "localhost:5000/user/app:1.0",
+		"quay.io/user/app@sha256:abcde": "quay.io/user/app@sha256:abcde",
+	} {
+		if got := normalizeImageName(name); got != want {
+			t.Errorf("normalizeImageName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
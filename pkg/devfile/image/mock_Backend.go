@@ -36,31 +36,74 @@ func (m *MockBackend) EXPECT() *MockBackendMockRecorder {
 }
 
 // Build mocks base method.
-func (m *MockBackend) Build(fs filesystem.Filesystem, image *v1alpha2.ImageComponent, devfilePath string) error {
+func (m *MockBackend) Build(fs filesystem.Filesystem, image *v1alpha2.ImageComponent, devfilePath string, options BuildOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Build", fs, image, devfilePath)
+	ret := m.ctrl.Call(m, "Build", fs, image, devfilePath, options)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Build indicates an expected call of Build.
-func (mr *MockBackendMockRecorder) Build(fs, image, devfilePath interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) Build(fs, image, devfilePath, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockBackend)(nil).Build), fs, image, devfilePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockBackend)(nil).Build), fs, image, devfilePath, options)
+}
+
+// Exists mocks base method.
+func (m *MockBackend) Exists(image string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", image)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockBackendMockRecorder) Exists(image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockBackend)(nil).Exists), image)
 }
 
 // Push mocks base method.
-func (m *MockBackend) Push(image string) error {
+func (m *MockBackend) Push(image string, options BuildOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Push", image)
+	ret := m.ctrl.Call(m, "Push", image, options)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Push indicates an expected call of Push.
-func (mr *MockBackendMockRecorder) Push(image interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) Push(image, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockBackend)(nil).Push), image, options)
+}
+
+// RepoDigest mocks base method.
+func (m *MockBackend) RepoDigest(image string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepoDigest", image)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepoDigest indicates an expected call of RepoDigest.
+func (mr *MockBackendMockRecorder) RepoDigest(image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepoDigest", reflect.TypeOf((*MockBackend)(nil).RepoDigest), image)
+}
+
+// Save mocks base method.
+func (m *MockBackend) Save(image, dest string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", image, dest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockBackendMockRecorder) Save(image, dest interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockBackend)(nil).Push), image)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockBackend)(nil).Save), image, dest)
 }
 
 // String mocks base method.
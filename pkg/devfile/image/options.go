@@ -0,0 +1,42 @@
+package image
+
+import (
+	"context"
+)
+
+// BuildOptions are the options, generally coming from the command line, used to build images
+type BuildOptions struct {
+	// Force builds (and pushes) the images, even if the build inputs did not change since the last build
+	Force bool
+	// Platforms to build the images for, in the form os/arch[/variant]. If empty, the images are built for the host platform only
+	Platforms []string
+	// Load loads the images into the container storage of the local cluster (kind, minikube or CRC) after they are built
+	Load bool
+	// SkipPush does not push the images to their registries, even for commands pushing them by default
+	SkipPush bool
+	// BuildArgs are the build arguments, in the form KEY=VALUE, overriding the ones defined in the devfile
+	BuildArgs []string
+	// Target is the stage of the Dockerfile to build, overriding the one defined in the devfile
+	Target string
+	// Secrets are the secrets exposed to the build, in the form id=ID,src=PATH.
+	// Their content is never stored in the images, nor used to compute the digests of the build inputs
+	Secrets []string
+}
+
+type buildOptionsKeyType struct{}
+
+var buildOptionsKey buildOptionsKeyType
+
+// WithBuildOptions sets the options used to build images in ctx
+func WithBuildOptions(ctx context.Context, val BuildOptions) context.Context {
+	return context.WithValue(ctx, buildOptionsKey, val)
+}
+
+// GetBuildOptions gets the options used to build images from ctx
+func GetBuildOptions(ctx context.Context) BuildOptions {
+	value := ctx.Value(buildOptionsKey)
+	if cast, ok := value.(BuildOptions); ok {
+		return cast
+	}
+	return BuildOptions{}
+}
@@ -0,0 +1,29 @@
+package image
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// PinImagesInResource replaces, in the containers defined in any pod template of the resource u,
+// the images present in repoDigests by their references by digest.
+// repoDigests maps image names with their references by digest (name@sha256:...)
+func PinImagesInResource(u *unstructured.Unstructured, repoDigests map[string]string) {
+	pinned := make(map[string]string, len(repoDigests))
+	for name, repoDigest := range repoDigests {
+		if repoDigest != "" {
+			pinned[normalizeImageName(name)] = repoDigest
+		}
+	}
+	if len(pinned) == 0 {
+		return
+	}
+	walkContainers(u.Object, func(container map[string]interface{}) {
+		image, ok := container["image"].(string)
+		if !ok {
+			return
+		}
+		if repoDigest, found := pinned[normalizeImageName(image)]; found {
+			container["image"] = repoDigest
+		}
+	})
+}
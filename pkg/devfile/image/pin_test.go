@@ -0,0 +1,81 @@
+package image
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestPinImagesInResource(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"initContainers": []interface{}{
+						map[string]interface{}{"name": "init", "image": "quay.io/user/init"},
+					},
+					"containers": []interface{}{
+						map[string]interface{}{"name": "runtime", "image": "quay.io/user/app:latest"},
+						map[string]interface{}{"name": "sidecar", "image": "quay.io/user/sidecar:1.0"},
+					},
+				},
+			},
+		},
+	}}
+	PinImagesInResource(&u, map[string]string{
+		"quay.io/user/app":         "quay.io/user/app@sha256:1234",
+		"quay.io/user/init:latest": "quay.io/user/init@sha256:5678",
+		"quay.io/user/sidecar:1.0": "",
+	})
+
+	containers, _, _ := unstructured.NestedSlice(u.Object, "spec", "template", "spec", "containers")
+	initContainers, _, _ := unstructured.NestedSlice(u.Object, "spec", "template", "spec", "initContainers")
+	var got []string
+	for _, c := range append(initContainers, containers...) {
+		got = append(got, c.(map[string]interface{})["image"].(string))
+	}
+	want := []string{"quay.io/user/init@sha256:5678", "quay.io/user/app@sha256:1234", "quay.io/user/sidecar:1.0"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("PinImagesInResource() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_selectRepoDigest(t *testing.T) {
+	tests := []struct {
+		name        string
+		image       string
+		repoDigests []string
+		want        string
+	}{
+		{
+			name:        "same repository",
+			image:       "quay.io/user/app:v1",
+			repoDigests: []string{"registry.io/other@sha256:0000", "quay.io/user/app@sha256:1234"},
+			want:        "quay.io/user/app@sha256:1234",
+		},
+		{
+			name:        "default registry added by the backend",
+			image:       "user/app",
+			repoDigests: []string{"docker.io/user/app@sha256:1234"},
+			want:        "docker.io/user/app@sha256:1234",
+		},
+		{
+			name:        "registry with port",
+			image:       "localhost:5000/app",
+			repoDigests: []string{"localhost:5000/app@sha256:1234"},
+			want:        "localhost:5000/app@sha256:1234",
+		},
+		{
+			name:  "not pushed",
+			image: "quay.io/user/app:v1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := selectRepoDigest(tt.image, tt.repoDigests); got != tt.want {
+				t.Errorf("selectRepoDigest() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
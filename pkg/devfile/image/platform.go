@@ -0,0 +1,149 @@
+package image
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"k8s.io/klog"
+)
+
+// PlatformsAttribute is the attribute of an Image component defining the platforms (comma-separated) to build the image for
+const PlatformsAttribute = "dev.odo.build.platforms"
+
+// binfmtMiscDir is the directory containing the binary formats registered into the Linux kernel
+const binfmtMiscDir = "/proc/sys/fs/binfmt_misc"
+
+// qemuArchs maps Go architectures with the names of the qemu emulators
+var qemuArchs = map[string]string{
+	"386":     "i386",
+	"amd64":   "x86_64",
+	"arm":     "arm",
+	"arm64":   "aarch64",
+	"ppc64le": "ppc64le",
+	"s390x":   "s390x",
+}
+
+// ParsePlatforms parses a comma-separated list of platforms, in the form os/arch[/variant]
+func ParsePlatforms(value string) ([]string, error) {
+	var result []string
+	for _, platform := range strings.Split(value, ",") {
+		platform = strings.TrimSpace(platform)
+		if platform == "" {
+			continue
+		}
+		parts := strings.Split(platform, "/")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, fmt.Errorf("invalid platform %q, the format should be os/arch[/variant], for example linux/amd64", platform)
+		}
+		for _, part := range parts {
+			if part == "" {
+				return nil, fmt.Errorf("invalid platform %q, the format should be os/arch[/variant], for example linux/amd64", platform)
+			}
+		}
+		result = append(result, platform)
+	}
+	return result, nil
+}
+
+// getComponentBuildOptions returns the build options for the component, completing the options
+// with the platforms defined in the component's attributes, if not defined in options
+func getComponentBuildOptions(options BuildOptions, component devfile.Component) (BuildOptions, error) {
+	if len(options.Platforms) > 0 || !component.Attributes.Exists(PlatformsAttribute) {
+		return options, nil
+	}
+	var err error
+	value := component.Attributes.GetString(PlatformsAttribute, &err)
+	if err != nil {
+		return BuildOptions{}, fmt.Errorf("invalid attribute %q for component %q: %w", PlatformsAttribute, component.Name, err)
+	}
+	options.Platforms, err = ParsePlatforms(value)
+	if err != nil {
+		return BuildOptions{}, fmt.Errorf("invalid attribute %q for component %q: %w", PlatformsAttribute, component.Name, err)
+	}
+	return options, nil
+}
+
+// checkPlatformsSupported returns an error explaining why the backend is not able to build images
+// for the given platforms, if this can be detected before building
+func (o *DockerCompatibleBackend) checkPlatformsSupported(platforms []string) error {
+	if isPodman(o.name) {
+		return checkEmulatorsRegistered(platforms)
+	}
+
+	klog.V(4).Infof("Running command: %s buildx inspect", o.name)
+	out, err := exec.Command(o.name, "buildx", "inspect").Output()
+	if err != nil {
+		return fmt.Errorf("%s buildx is required to build images for the platforms %s, but it is not available: %w", o.name, strings.Join(platforms, ","), err)
+	}
+	supported := parseBuildxPlatforms(out)
+	var unsupported []string
+	for _, platform := range platforms {
+		if !supported[platform] {
+			unsupported = append(unsupported, platform)
+		}
+	}
+	if len(unsupported) > 0 {
+		var list []string
+		for platform := range supported {
+			list = append(list, platform)
+		}
+		sort.Strings(list)
+		return fmt.Errorf("the current %s buildx builder cannot build images for the platforms %s (supported platforms: %s); "+
+			"see https://docs.docker.com/build/building/multi-platform/ to install emulators or to use another builder",
+			o.name, strings.Join(unsupported, ","), strings.Join(list, ","))
+	}
+	return nil
+}
+
+// parseBuildxPlatforms returns the platforms supported by a buildx builder, from the output of the `buildx inspect` command
+func parseBuildxPlatforms(out []byte) map[string]bool {
+	result := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "Platforms:") {
+			continue
+		}
+		for _, platform := range strings.Split(strings.TrimPrefix(line, "Platforms:"), ",") {
+			// Platforms can be suffixed with a star, indicating the platforms configured by the user
+			platform = strings.TrimSuffix(strings.TrimSpace(platform), "*")
+			if platform != "" {
+				result[platform] = true
+			}
+		}
+	}
+	return result
+}
+
+// checkEmulatorsRegistered returns an error if an emulator is required to build images for some of the platforms,
+// and is not registered in the kernel.
+// The check is done only on Linux, where podman runs natively.
+func checkEmulatorsRegistered(platforms []string) error {
+	if runtime.GOOS != "linux" {
+		return nil
+	}
+	for _, platform := range platforms {
+		arch := strings.Split(platform, "/")[1]
+		if arch == runtime.GOARCH {
+			continue
+		}
+		qemuArch, ok := qemuArchs[arch]
+		if !ok {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(binfmtMiscDir, "qemu-"+qemuArch)); err != nil {
+			return fmt.Errorf("podman cannot build images for the platform %s on this %s host, as no emulator is registered for %s; "+
+				"install the qemu-user-static package (see https://github.com/multiarch/qemu-user-static) and try again",
+				platform, runtime.GOARCH, qemuArch)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package image
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParsePlatforms(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "empty value",
+			value: "",
+			want:  nil,
+		},
+		{
+			name:  "several platforms",
+			value: "linux/amd64, linux/arm64,linux/arm/v7",
+			want:  []string{"linux/amd64", "linux/arm64", "linux/arm/v7"},
+		},
+		{
+			name:    "missing architecture",
+			value:   "linux/amd64,linux",
+			wantErr: true,
+		},
+		{
+			name:    "empty architecture",
+			value:   "linux/",
+			wantErr: true,
+		},
+		{
+			name:    "too many parts",
+			value:   "linux/arm/v7/other",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePlatforms(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParsePlatforms() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ParsePlatforms() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseBuildxPlatforms(t *testing.T) {
+	out := `Name:   default
+Driver: docker
+
+Nodes:
+Name:      default
+Endpoint:  default
+Status:    running
+Buildkit:  v0.10.5
+Platforms: linux/amd64*, linux/amd64/v2, linux/arm64, linux/386
+`
+	want := map[string]bool{
+		"linux/amd64":    true,
+		"linux/amd64/v2": true,
+		"linux/arm64":    true,
+		"linux/386":      true,
+	}
+	got := parseBuildxPlatforms([]byte(out))
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("parseBuildxPlatforms() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,149 @@
+package migrate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver"
+	"gopkg.in/yaml.v3"
+
+	"github.com/redhat-developer/odo/pkg/devfile/yamlnode"
+)
+
+// SchemaVersions are the devfile schema versions supported by odo, from the oldest
+var SchemaVersions = []string{"2.0.0", "2.1.0", "2.2.0"}
+
+// schemaField is a field of the devfile supported only by some schema versions.
+// The path of the field contains the names of the fields from the root of the devfile,
+// a name ending with [] being a list.
+type schemaField struct {
+	path string
+	// since is the first schema version supporting the field
+	since string
+	// removedIn is the first schema version not supporting the field anymore
+	removedIn string
+	// replacement is the field replacing the field in the schema version removing it, with the same content
+	replacement string
+	// removeItem indicates that the item of the list containing the field is removed with the field
+	removeItem bool
+}
+
+var schemaFields = []schemaField{
+	{path: "attributes", since: "2.1.0"},
+	{path: "variables", since: "2.1.0"},
+	{path: "metadata.language", since: "2.1.0"},
+	{path: "metadata.projectType", since: "2.1.0"},
+	{path: "metadata.website", since: "2.1.0"},
+	{path: "components[].container.cpuLimit", since: "2.1.0"},
+	{path: "components[].container.cpuRequest", since: "2.1.0"},
+	{path: "components[].container.memoryRequest", since: "2.1.0"},
+	{path: "components[].volume.ephemeral", since: "2.1.0"},
+	{path: "metadata.architectures", since: "2.2.0"},
+	{path: "metadata.provider", since: "2.2.0"},
+	{path: "metadata.supportUrl", since: "2.2.0"},
+	{path: "components[].image", since: "2.2.0"},
+	{path: "components[].container.annotation", since: "2.2.0"},
+	{path: "components[].container.endpoints[].annotation", since: "2.2.0"},
+	{path: "components[].kubernetes.deployByDefault", since: "2.2.0"},
+	{path: "components[].kubernetes.endpoints[].annotation", since: "2.2.0"},
+	{path: "components[].openshift.deployByDefault", since: "2.2.0"},
+	{path: "components[].openshift.endpoints[].annotation", since: "2.2.0"},
+
+	{path: "projects[].github", removedIn: "2.1.0", replacement: "git"},
+	{path: "starterProjects[].github", removedIn: "2.1.0", replacement: "git"},
+	{path: "projects[].sparseCheckoutDirs", removedIn: "2.1.0"},
+	{path: "commands[].vscodeTask", removedIn: "2.1.0", removeItem: true},
+	{path: "commands[].vscodeLaunch", removedIn: "2.1.0", removeItem: true},
+	{path: "components[].plugin", removedIn: "2.1.0", removeItem: true},
+}
+
+// UnsupportedField is a field of the devfile which is not supported by the schema version declared by the devfile
+type UnsupportedField struct {
+	// Key is the key node of the field
+	Key *yaml.Node
+	// Path is the path of the field, with the names of the elements of lists, for example components[runtime].container.cpuLimit
+	Path string
+	// Since is the first schema version supporting the field, if the field is too recent for the declared schema version
+	Since string
+	// RemovedIn is the first schema version not supporting the field anymore, if the field is too old for the declared schema version
+	RemovedIn string
+	// Replacement is the field replacing the field removed
+	Replacement string
+}
+
+// Message describes why the field is not supported
+func (o UnsupportedField) Message(schemaVersion string) string {
+	if o.Since != "" {
+		return fmt.Sprintf("the field %s requires the schema version %s or later, but the devfile declares the schema version %s",
+			o.Path, o.Since, schemaVersion)
+	}
+	msg := fmt.Sprintf("the field %s is not supported from the schema version %s, and the devfile declares the schema version %s",
+		o.Path, o.RemovedIn, schemaVersion)
+	if o.Replacement != "" {
+		msg += fmt.Sprintf(", use the field %s instead", o.Replacement)
+	}
+	return msg
+}
+
+// UnsupportedFields returns the fields of the devfile which are not supported by the schema version.
+// No field is returned if the schema version is not valid.
+func UnsupportedFields(root *yaml.Node, schemaVersion string) []UnsupportedField {
+	version, err := semver.Parse(schemaVersion)
+	if err != nil {
+		return nil
+	}
+	var result []UnsupportedField
+	for _, field := range schemaFields {
+		if field.since != "" && !version.LT(semver.MustParse(field.since)) {
+			continue
+		}
+		if field.removedIn != "" && version.LT(semver.MustParse(field.removedIn)) {
+			continue
+		}
+		findFields(root, field.path, func(key, _, _ *yaml.Node, path string) {
+			result = append(result, UnsupportedField{
+				Key:         key,
+				Path:        path,
+				Since:       field.since,
+				RemovedIn:   field.removedIn,
+				Replacement: field.replacement,
+			})
+		})
+	}
+	return result
+}
+
+// findFields calls fn for each field of the node matching the path, with the key and value nodes of the field,
+// the mapping node containing the field, and the path of the field with the names of the elements of lists
+func findFields(node *yaml.Node, path string, fn func(key, value, parent *yaml.Node, path string)) {
+	walkFields(node, strings.Split(path, "."), "", fn)
+}
+
+func walkFields(node *yaml.Node, segments []string, path string, fn func(key, value, parent *yaml.Node, path string)) {
+	name := strings.TrimSuffix(segments[0], "[]")
+	key := yamlnode.MappingKey(node, name)
+	if key == nil {
+		return
+	}
+	value := yamlnode.MappingValue(node, name)
+	if path != "" {
+		path += "."
+	}
+	path += name
+	if len(segments) == 1 {
+		fn(key, value, node, path)
+		return
+	}
+	if !strings.HasSuffix(segments[0], "[]") {
+		walkFields(value, segments[1:], path, fn)
+		return
+	}
+	for i, item := range yamlnode.SequenceItems(value) {
+		itemKey := yamlnode.ItemKey(item)
+		if itemKey == "" {
+			itemKey = strconv.Itoa(i)
+		}
+		walkFields(item, segments[1:], fmt.Sprintf("%s[%s]", path, itemKey), fn)
+	}
+}
@@ -0,0 +1,155 @@
+// Package migrate migrates a Devfile to a newer version of the Devfile schema, converting or removing the fields
+// not supported by the new version, and reports the fields behaving differently with odo
+package migrate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v3"
+
+	"github.com/redhat-developer/odo/pkg/devfile/yamlnode"
+)
+
+// odoIgnoredFields are the fields of the devfile ignored by odo, with the behaviour of odo
+var odoIgnoredFields = []struct {
+	path     string
+	behavior string
+}{
+	{
+		path:     "components[].kubernetes.deployByDefault",
+		behavior: "the Kubernetes components not referenced by an apply command are created by odo dev, the other ones when an apply command referencing them is run",
+	},
+	{
+		path:     "components[].openshift.deployByDefault",
+		behavior: "the OpenShift components are created only when an apply command referencing them is run",
+	},
+	{
+		path:     "components[].image.autoBuild",
+		behavior: "the images are built by odo build-images, and when an apply command referencing them is run",
+	},
+	{
+		path:     "components[].container.dedicatedPod",
+		behavior: "all the containers of the component run in the same pod",
+	},
+}
+
+// Result is the result of the migration of a devfile
+type Result struct {
+	// Content is the content of the migrated devfile
+	Content []byte
+	// Changes are the changes made to the devfile
+	Changes []string
+	// Warnings are the fields removed from the devfile without equivalent, and the fields behaving differently with odo
+	Warnings []string
+}
+
+// Migrate migrates the devfile content to the schema version, which must be one of SchemaVersions and not older than
+// the schema version of the devfile:
+// - the fields replaced by other fields in the new schema version are renamed,
+// - the fields removed from the new schema version are removed, with a warning.
+// The formatting and comments of the devfile are kept.
+func Migrate(content []byte, schemaVersion string) (Result, error) {
+	target, err := parseSchemaVersion(schemaVersion)
+	if err != nil {
+		return Result{}, err
+	}
+	doc, err := yamlnode.ParseDocument(content)
+	if err != nil {
+		return Result{}, fmt.Errorf("unable to parse the devfile: %w", err)
+	}
+	root := doc.Content[0]
+	versionNode := yamlnode.MappingValue(root, "schemaVersion")
+	if versionNode == nil || versionNode.Kind != yaml.ScalarNode {
+		return Result{}, fmt.Errorf("the devfile does not declare its schema version in the schemaVersion field")
+	}
+	current, err := semver.Parse(versionNode.Value)
+	if err != nil {
+		return Result{}, fmt.Errorf("invalid schema version %q in the devfile: %w", versionNode.Value, err)
+	}
+	if current.GT(target) {
+		return Result{}, fmt.Errorf("the devfile uses the schema version %s, newer than %s; migrating to an older schema version is not supported", current, target)
+	}
+
+	var result Result
+	if current.EQ(target) {
+		result.Content = content
+	} else {
+		for _, field := range schemaFields {
+			if field.removedIn == "" {
+				continue
+			}
+			if removedIn := semver.MustParse(field.removedIn); current.GTE(removedIn) || target.LT(removedIn) {
+				continue
+			}
+			result.migrateField(root, field)
+		}
+		versionNode.Value = target.String()
+		result.Changes = append(result.Changes, fmt.Sprintf("schemaVersion changed from %s to %s", current, target))
+		if result.Content, err = yamlnode.Encode(doc); err != nil {
+			return Result{}, err
+		}
+	}
+
+	for _, field := range odoIgnoredFields {
+		findFields(root, field.path, func(_, _, _ *yaml.Node, path string) {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("odo ignores the field %s: %s", path, field.behavior))
+		})
+	}
+	return result, nil
+}
+
+// migrateField converts or removes the occurrences of a field removed from the schema
+func (o *Result) migrateField(root *yaml.Node, field schemaField) {
+	if field.removeItem {
+		// the field is the type of the items of a list at the root of the devfile, like the type of a command
+		list, itemField, _ := strings.Cut(field.path, "[].")
+		items := yamlnode.MappingValue(root, list)
+		kept := make([]*yaml.Node, 0, len(yamlnode.SequenceItems(items)))
+		for _, item := range yamlnode.SequenceItems(items) {
+			if yamlnode.MappingKey(item, itemField) == nil {
+				kept = append(kept, item)
+				continue
+			}
+			o.Warnings = append(o.Warnings, fmt.Sprintf("%s[%s] removed: the field %s is not supported from the schema version %s",
+				list, yamlnode.ItemKey(item), itemField, field.removedIn))
+		}
+		if items != nil {
+			items.Content = kept
+		}
+		return
+	}
+
+	findFields(root, field.path, func(key, _, parent *yaml.Node, path string) {
+		if field.replacement != "" && yamlnode.MappingKey(parent, field.replacement) == nil {
+			key.Value = field.replacement
+			o.Changes = append(o.Changes, fmt.Sprintf("%s replaced by %s", path, field.replacement))
+			return
+		}
+		yamlnode.RemoveMappingKey(parent, key.Value)
+		o.Warnings = append(o.Warnings, fmt.Sprintf("%s removed: the field is not supported from the schema version %s", path, field.removedIn))
+	})
+}
+
+func parseSchemaVersion(schemaVersion string) (semver.Version, error) {
+	for _, supported := range SchemaVersions {
+		if schemaVersion == supported {
+			return semver.MustParse(supported), nil
+		}
+	}
+	return semver.Version{}, fmt.Errorf("the schema version %q is not supported, the supported schema versions are: %s",
+		schemaVersion, strings.Join(SchemaVersions, ", "))
+}
+
+// Diff returns the differences between the devfile and the migrated devfile, in the unified format
+func Diff(name string, current, migrated []byte) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(current)),
+		B:        difflib.SplitLines(string(migrated)),
+		FromFile: name,
+		ToFile:   name + " (migrated)",
+		Context:  3,
+	})
+}
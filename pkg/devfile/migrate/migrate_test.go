@@ -0,0 +1,228 @@
+package migrate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/redhat-developer/odo/pkg/devfile/yamlnode"
+)
+
+func TestMigrate(t *testing.T) {
+	tests := []struct {
+		name          string
+		devfile       string
+		schemaVersion string
+		want          string
+		wantChanges   []string
+		wantWarnings  []string
+		wantErr       string
+	}{
+		{
+			name: "fields removed in 2.1.0 converted or removed",
+			devfile: `schemaVersion: 2.0.0
+metadata:
+  name: my-app
+projects:
+  - name: backend
+    # the sources
+    github:
+      remotes:
+        origin: https://github.com/org/backend
+    sparseCheckoutDirs: [src]
+components:
+  - name: runtime
+    container:
+      image: node:18
+  - name: theia
+    plugin:
+      id: eclipse/che-theia/latest
+commands:
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+  - id: debug-task
+    vscodeTask:
+      inlined: "{}"
+`,
+			schemaVersion: "2.1.0",
+			want: `schemaVersion: 2.1.0
+metadata:
+  name: my-app
+projects:
+  - name: backend
+    # the sources
+    git:
+      remotes:
+        origin: https://github.com/org/backend
+components:
+  - name: runtime
+    container:
+      image: node:18
+commands:
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+`,
+			wantChanges: []string{
+				"projects[backend].github replaced by git",
+				"schemaVersion changed from 2.0.0 to 2.1.0",
+			},
+			wantWarnings: []string{
+				"projects[backend].sparseCheckoutDirs removed: the field is not supported from the schema version 2.1.0",
+				"commands[debug-task] removed: the field vscodeTask is not supported from the schema version 2.1.0",
+				"components[theia] removed: the field plugin is not supported from the schema version 2.1.0",
+			},
+		},
+		{
+			name: "fields ignored by odo reported",
+			devfile: `schemaVersion: 2.1.0
+metadata:
+  name: my-app
+components:
+  - name: runtime
+    container:
+      image: node:18
+      dedicatedPod: true
+`,
+			schemaVersion: "2.2.0",
+			want: `schemaVersion: 2.2.0
+metadata:
+  name: my-app
+components:
+  - name: runtime
+    container:
+      image: node:18
+      dedicatedPod: true
+`,
+			wantChanges: []string{"schemaVersion changed from 2.1.0 to 2.2.0"},
+			wantWarnings: []string{
+				"odo ignores the field components[runtime].container.dedicatedPod: all the containers of the component run in the same pod",
+			},
+		},
+		{
+			name:          "same schema version",
+			devfile:       "schemaVersion: 2.2.0\nmetadata: {name: my-app}\n",
+			schemaVersion: "2.2.0",
+			want:          "schemaVersion: 2.2.0\nmetadata: {name: my-app}\n",
+		},
+		{
+			name:          "older schema version",
+			devfile:       "schemaVersion: 2.2.0\n",
+			schemaVersion: "2.1.0",
+			wantErr:       "migrating to an older schema version is not supported",
+		},
+		{
+			name:          "unsupported schema version",
+			devfile:       "schemaVersion: 2.0.0\n",
+			schemaVersion: "2.3.0",
+			wantErr:       "the schema version \"2.3.0\" is not supported",
+		},
+		{
+			name:          "no schema version",
+			devfile:       "metadata:\n  name: my-app\n",
+			schemaVersion: "2.2.0",
+			wantErr:       "the devfile does not declare its schema version",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Migrate([]byte(tt.devfile), tt.schemaVersion)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Migrate() error = %v, want an error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Migrate() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, string(got.Content)); diff != "" {
+				t.Errorf("Migrate() content mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantChanges, got.Changes, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Migrate() changes mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantWarnings, got.Warnings, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Migrate() warnings mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnsupportedFields(t *testing.T) {
+	devfile := `schemaVersion: 2.1.0
+variables:
+  VERSION: "1"
+projects:
+  - name: backend
+    github:
+      remotes:
+        origin: https://github.com/org/backend
+components:
+  - name: runtime
+    container:
+      image: node:18
+      cpuLimit: 500m
+      endpoints:
+        - name: http
+          targetPort: 3000
+          annotation:
+            key: value
+  - name: build
+    image:
+      imageName: my-image
+`
+	doc, err := yamlnode.ParseDocument([]byte(devfile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		schemaVersion string
+		want          []string
+	}{
+		{
+			schemaVersion: "2.0.0",
+			want: []string{
+				"variables since 2.1.0",
+				"components[runtime].container.cpuLimit since 2.1.0",
+				"components[build].image since 2.2.0",
+				"components[runtime].container.endpoints[http].annotation since 2.2.0",
+			},
+		},
+		{
+			schemaVersion: "2.1.0",
+			want: []string{
+				"components[build].image since 2.2.0",
+				"components[runtime].container.endpoints[http].annotation since 2.2.0",
+				"projects[backend].github removed in 2.1.0",
+			},
+		},
+		{
+			schemaVersion: "2.2.0",
+			want:          []string{"projects[backend].github removed in 2.1.0"},
+		},
+		{
+			schemaVersion: "invalid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.schemaVersion, func(t *testing.T) {
+			var got []string
+			for _, field := range UnsupportedFields(doc.Content[0], tt.schemaVersion) {
+				if field.Since != "" {
+					got = append(got, field.Path+" since "+field.Since)
+				} else {
+					got = append(got, field.Path+" removed in "+field.RemovedIn)
+				}
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("UnsupportedFields() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
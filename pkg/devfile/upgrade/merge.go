@@ -0,0 +1,181 @@
+package upgrade
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/redhat-developer/odo/pkg/devfile/yamlnode"
+)
+
+// Conflict is a change of the local Devfile conflicting with a change of the stack.
+// The local value is kept in the merged Devfile.
+type Conflict struct {
+	// Path is the path of the conflicting field in the Devfile, for example components[runtime].container.image
+	Path string `json:"path"`
+	// Reason describes the changes in conflict
+	Reason string `json:"reason"`
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s: %s", c.Path, c.Reason)
+}
+
+// Merge three-way merges the local Devfile with the target version of the stack, using the version
+// of the stack the local Devfile has been created from as the merge base:
+//   - the fields changed only in the stack are updated,
+//   - the fields changed only locally (endpoints, env, variables, ...) are kept,
+//   - the fields changed both locally and in the stack are conflicting, and the local values are kept.
+//
+// The elements of lists identified by a name or an id (components, commands, endpoints, env, ...) are merged by name or id.
+// The formatting and comments of the local Devfile are preserved for the fields which are not updated.
+func Merge(base, local, target []byte) ([]byte, []Conflict, error) {
+	baseNode, err := yamlnode.ParseDocument(base)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse the Devfile of the base version: %w", err)
+	}
+	localNode, err := yamlnode.ParseDocument(local)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse the local Devfile: %w", err)
+	}
+	targetNode, err := yamlnode.ParseDocument(target)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse the Devfile of the target version: %w", err)
+	}
+
+	m := merger{}
+	merged := m.merge(baseNode.Content[0], localNode.Content[0], targetNode.Content[0], "")
+	localNode.Content[0] = merged
+
+	content, err := yamlnode.Encode(localNode)
+	if err != nil {
+		return nil, nil, err
+	}
+	return content, m.conflicts, nil
+}
+
+type merger struct {
+	conflicts []Conflict
+}
+
+func (m *merger) conflict(path string, reason string) {
+	m.conflicts = append(m.conflicts, Conflict{Path: path, Reason: reason})
+}
+
+// merge returns the result of merging the local and target nodes, based on the base node.
+// base is nil if the field does not exist in the base version.
+func (m *merger) merge(base, local, target *yaml.Node, path string) *yaml.Node {
+	switch {
+	case yamlnode.Equal(local, target):
+		return local
+	case base != nil && yamlnode.Equal(local, base):
+		return target
+	case base != nil && yamlnode.Equal(target, base):
+		return local
+	}
+
+	if local.Kind == yaml.MappingNode && target.Kind == yaml.MappingNode && (base == nil || base.Kind == yaml.MappingNode) {
+		return m.mergeMapping(base, local, target, path)
+	}
+	if isNamedSequence(local) && isNamedSequence(target) && (base == nil || isNamedSequence(base)) {
+		return m.mergeNamedSequence(base, local, target, path)
+	}
+	m.conflict(path, "modified locally and in the stack")
+	return local
+}
+
+func (m *merger) mergeMapping(base, local, target *yaml.Node, path string) *yaml.Node {
+	result := *local
+	result.Content = nil
+	for i := 0; i+1 < len(local.Content); i += 2 {
+		key, value := local.Content[i], local.Content[i+1]
+		fieldPath := joinPath(path, key.Value)
+		baseValue := yamlnode.MappingValue(base, key.Value)
+		targetValue := yamlnode.MappingValue(target, key.Value)
+		switch {
+		case targetValue == nil && baseValue == nil:
+			// added locally
+		case targetValue == nil && yamlnode.Equal(value, baseValue):
+			// removed in the stack
+			continue
+		case targetValue == nil:
+			m.conflict(fieldPath, "removed in the stack and modified locally")
+		default:
+			value = m.merge(baseValue, value, targetValue, fieldPath)
+		}
+		result.Content = append(result.Content, key, value)
+	}
+	for i := 0; i+1 < len(target.Content); i += 2 {
+		key, value := target.Content[i], target.Content[i+1]
+		if yamlnode.MappingValue(local, key.Value) != nil {
+			continue
+		}
+		baseValue := yamlnode.MappingValue(base, key.Value)
+		switch {
+		case baseValue == nil:
+			// added in the stack
+			result.Content = append(result.Content, key, value)
+		case !yamlnode.Equal(value, baseValue):
+			m.conflict(joinPath(path, key.Value), "modified in the stack and removed locally")
+		}
+	}
+	return &result
+}
+
+func (m *merger) mergeNamedSequence(base, local, target *yaml.Node, path string) *yaml.Node {
+	result := *local
+	result.Content = nil
+	for _, item := range local.Content {
+		name := yamlnode.ItemKey(item)
+		itemPath := fmt.Sprintf("%s[%s]", path, name)
+		baseItem := yamlnode.NamedItem(base, name)
+		targetItem := yamlnode.NamedItem(target, name)
+		switch {
+		case targetItem == nil && baseItem == nil:
+			// added locally
+		case targetItem == nil && yamlnode.Equal(item, baseItem):
+			// removed in the stack
+			continue
+		case targetItem == nil:
+			m.conflict(itemPath, "removed in the stack and modified locally")
+		default:
+			item = m.merge(baseItem, item, targetItem, itemPath)
+		}
+		result.Content = append(result.Content, item)
+	}
+	for _, item := range target.Content {
+		name := yamlnode.ItemKey(item)
+		if yamlnode.NamedItem(local, name) != nil {
+			continue
+		}
+		baseItem := yamlnode.NamedItem(base, name)
+		switch {
+		case baseItem == nil:
+			// added in the stack
+			result.Content = append(result.Content, item)
+		case !yamlnode.Equal(item, baseItem):
+			m.conflict(fmt.Sprintf("%s[%s]", path, name), "modified in the stack and removed locally")
+		}
+	}
+	return &result
+}
+
+// isNamedSequence returns true if the node is a sequence of mappings, all having a name or an id
+func isNamedSequence(node *yaml.Node) bool {
+	if node.Kind != yaml.SequenceNode {
+		return false
+	}
+	for _, item := range node.Content {
+		if yamlnode.ItemKey(item) == "" {
+			return false
+		}
+	}
+	return true
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,208 @@
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const baseDevfile = `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+  displayName: Node.js Runtime
+  version: 2.1.0
+components:
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-16:1-37
+      memoryLimit: 1024Mi
+      endpoints:
+        - name: http-node
+          targetPort: 3000
+commands:
+  - id: install
+    exec:
+      component: runtime
+      commandLine: npm install
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+`
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name          string
+		local         string
+		target        string
+		want          string
+		wantConflicts []Conflict
+	}{
+		{
+			name: "changes of the stack applied and local customizations kept",
+			local: `schemaVersion: 2.1.0
+metadata:
+  # the name of my component
+  name: my-app
+  displayName: Node.js Runtime
+  version: 2.1.0
+components:
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-16:1-37
+      memoryLimit: 1024Mi
+      env:
+        - name: DEBUG
+          value: "true"
+      endpoints:
+        - name: http-node
+          targetPort: 8080
+commands:
+  - id: install
+    exec:
+      component: runtime
+      commandLine: npm install
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+`,
+			target: `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+  displayName: Node.js Runtime
+  version: 2.1.1
+components:
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-18:1-10
+      memoryLimit: 1024Mi
+      endpoints:
+        - name: http-node
+          targetPort: 3000
+        - name: debug
+          targetPort: 5858
+commands:
+  - id: install
+    exec:
+      component: runtime
+      commandLine: npm ci
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+`,
+			want: `schemaVersion: 2.1.0
+metadata:
+  # the name of my component
+  name: my-app
+  displayName: Node.js Runtime
+  version: 2.1.1
+components:
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-18:1-10
+      memoryLimit: 1024Mi
+      env:
+        - name: DEBUG
+          value: "true"
+      endpoints:
+        - name: http-node
+          targetPort: 8080
+        - name: debug
+          targetPort: 5858
+commands:
+  - id: install
+    exec:
+      component: runtime
+      commandLine: npm ci
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+`,
+		},
+		{
+			name: "conflicting changes keep the local values",
+			local: `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+  displayName: Node.js Runtime
+  version: 2.1.0
+components:
+  - name: runtime
+    container:
+      image: quay.io/me/nodejs:latest
+      memoryLimit: 1024Mi
+      endpoints:
+        - name: http-node
+          targetPort: 3000
+commands:
+  - id: install
+    exec:
+      component: runtime
+      commandLine: npm install
+`,
+			target: `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+  displayName: Node.js Runtime
+  version: 2.1.1
+components:
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-18:1-10
+      memoryLimit: 1024Mi
+      endpoints:
+        - name: http-node
+          targetPort: 3000
+commands:
+  - id: install
+    exec:
+      component: runtime
+      commandLine: npm install
+  - id: run
+    exec:
+      component: runtime
+      commandLine: node server.js
+`,
+			want: `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+  displayName: Node.js Runtime
+  version: 2.1.1
+components:
+  - name: runtime
+    container:
+      image: quay.io/me/nodejs:latest
+      memoryLimit: 1024Mi
+      endpoints:
+        - name: http-node
+          targetPort: 3000
+commands:
+  - id: install
+    exec:
+      component: runtime
+      commandLine: npm install
+`,
+			wantConflicts: []Conflict{
+				{Path: "components[runtime].container.image", Reason: "modified locally and in the stack"},
+				{Path: "commands[run]", Reason: "modified in the stack and removed locally"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, conflicts, err := Merge([]byte(baseDevfile), []byte(tt.local), []byte(tt.target))
+			if err != nil {
+				t.Fatalf("Merge() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, string(got)); diff != "" {
+				t.Errorf("Merge() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantConflicts, conflicts); diff != "" {
+				t.Errorf("Merge() conflicts mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
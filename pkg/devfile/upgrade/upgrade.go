@@ -0,0 +1,117 @@
+// Package upgrade upgrades a Devfile created from a stack of a Devfile registry to another version of the stack
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/devfile/api/v2/pkg/devfile"
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/registry"
+	"github.com/redhat-developer/odo/pkg/segment"
+)
+
+// FindStack returns the stack the Devfile has been created from, in the registries of the preferences (or in the registry registryName if not empty).
+// If stackName is empty, the stack is determined from the metadata of the Devfile, by its name or its display name.
+func FindStack(ctx context.Context, registryClient registry.Client, registryName string, stackName string, metadata devfile.DevfileMetadata) (api.DevfileStack, error) {
+	stacks, err := registryClient.ListDevfileStacks(ctx, registryName, "", registry.Filter{}, false)
+	if err != nil {
+		return api.DevfileStack{}, err
+	}
+	if stackName != "" {
+		for _, stack := range stacks.Items {
+			if stack.Name == stackName {
+				return stack, nil
+			}
+		}
+		return api.DevfileStack{}, fmt.Errorf("the stack %q is not found in the registries", stackName)
+	}
+	for _, stack := range stacks.Items {
+		if stack.Name == metadata.Name {
+			return stack, nil
+		}
+	}
+	// odo init replaces the name of the Devfile with the name of the component, the display name of the stack is kept
+	for _, stack := range stacks.Items {
+		if metadata.DisplayName != "" && stack.DisplayName == metadata.DisplayName &&
+			(metadata.Language == "" || strings.EqualFold(stack.Language, metadata.Language)) {
+			return stack, nil
+		}
+	}
+	return api.DevfileStack{}, fmt.Errorf("unable to determine the stack of the Devfile from its metadata, please specify it with --devfile")
+}
+
+// ResolveVersion returns the version of the stack matching the requested version, the latest one if version is empty or "latest"
+func ResolveVersion(stack api.DevfileStack, version string) (string, error) {
+	if len(stack.Versions) == 0 {
+		if version == "" || version == "latest" || version == stack.DefaultVersion {
+			return stack.DefaultVersion, nil
+		}
+		return "", fmt.Errorf("the version %q of the stack %q does not exist", version, stack.Name)
+	}
+	if version != "" && version != "latest" {
+		for _, v := range stack.Versions {
+			if v.Version == version {
+				return version, nil
+			}
+		}
+		return "", fmt.Errorf("the version %q of the stack %q does not exist", version, stack.Name)
+	}
+	var latest *semver.Version
+	var latestVersion string
+	for _, v := range stack.Versions {
+		current, err := semver.Make(v.Version)
+		if err != nil {
+			continue
+		}
+		if latest == nil || current.GT(*latest) {
+			latest = &current
+			latestVersion = v.Version
+		}
+	}
+	if latestVersion == "" {
+		return "", fmt.Errorf("unable to determine the latest version of the stack %q", stack.Name)
+	}
+	return latestVersion, nil
+}
+
+// PullDevfile returns the content of the Devfile of the version of the stack
+func PullDevfile(ctx context.Context, registryClient registry.Client, stack api.DevfileStack, version string) ([]byte, error) {
+	tmpDir, err := ioutil.TempDir("", "odo-devfile-upgrade")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// setting NewIndexSchema ensures that the Devfile library pulls registry based on the stack version
+	options := segment.GetRegistryOptions(ctx)
+	options.NewIndexSchema = true
+	stackWithVersion := stack.Name
+	if version != "" {
+		stackWithVersion = stack.Name + ":" + version
+	}
+	err = registryClient.PullStackFromRegistry(stack.Registry.URL, stackWithVersion, tmpDir, options)
+	if err != nil {
+		return nil, fmt.Errorf("unable to pull the stack %s: %w", stackWithVersion, err)
+	}
+	return os.ReadFile(filepath.Join(tmpDir, location.DevfileFilenamesProvider(tmpDir)))
+}
+
+// Diff returns the unified diff between the current and upgraded content of the Devfile
+func Diff(name string, current, upgraded []byte) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(current)),
+		B:        difflib.SplitLines(string(upgraded)),
+		FromFile: name,
+		ToFile:   name + " (upgraded)",
+		Context:  3,
+	})
+}
@@ -0,0 +1,548 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile"
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	"gopkg.in/yaml.v3"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/devfile/migrate"
+	"github.com/redhat-developer/odo/pkg/devfile/yamlnode"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+var (
+	yamlLineRegexp   = regexp.MustCompile(`line (\d+)`)
+	schemaItemRegexp = regexp.MustCompile(`^(\(root\)|[\w-]+(?:\.[\w-]+)*): (.*)$`)
+	quotedRegexp     = regexp.MustCompile(`"([^"]+)"`)
+)
+
+// libraryMessagesCheckedByOdo are parts of the messages of the errors returned by the devfile library,
+// for problems also detected and located by the odo checks
+var libraryMessagesCheckedByOdo = []string{
+	"does not map to a valid",
+	"same endpoint targetPort",
+	"volume mounts in devfile volume components",
+	"there should be exactly one default command",
+}
+
+// Diagnose parses and validates the Devfile, as odo does before running the commands, and runs semantic checks
+// (references to commands and components, volume mounts, endpoint ports, default commands).
+// It returns all the problems found, located in the Devfile file, sorted by position.
+// An error is returned only if the Devfile cannot be read.
+func Diagnose(fsys filesystem.Filesystem, devfilePath string) ([]api.DevfileDiagnostic, error) {
+	content, err := fsys.ReadFile(devfilePath)
+	if err != nil {
+		return nil, err
+	}
+	return diagnose(devfilePath, content, parser.ParserArgs{Path: devfilePath}), nil
+}
+
+// DiagnoseContent runs the same validations as Diagnose on a content not yet written to the Devfile file,
+// for example a Devfile being edited.
+// The parent of the Devfile, if any, is resolved relatively to the current directory.
+func DiagnoseContent(devfilePath string, content []byte) []api.DevfileDiagnostic {
+	return diagnose(devfilePath, content, parser.ParserArgs{Data: content})
+}
+
+func diagnose(devfilePath string, content []byte, parserArgs parser.ParserArgs) []api.DevfileDiagnostic {
+	d := diagnoser{file: devfilePath}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		line := 1
+		if match := yamlLineRegexp.FindStringSubmatch(err.Error()); match != nil {
+			line, _ = strconv.Atoi(match[1])
+		}
+		d.diagnostics = append(d.diagnostics, api.DevfileDiagnostic{
+			File:     devfilePath,
+			Line:     line,
+			Column:   1,
+			Severity: api.DiagnosticSeverityError,
+			Message:  strings.TrimPrefix(err.Error(), "yaml: "),
+		})
+		return d.diagnostics
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		d.add(nil, "", api.DiagnosticSeverityError, "the devfile is not a YAML mapping")
+		return d.diagnostics
+	}
+	d.root = doc.Content[0]
+	hasParent := yamlnode.MappingValue(d.root, "parent") != nil
+
+	devObj, varWarnings, parseErr := devfile.ParseDevfileAndValidate(parserArgs)
+	names := collectNames(d.root)
+	if parseErr != nil {
+		d.libraryError(parseErr)
+	} else {
+		// the components and commands can be defined in the parent
+		names.addFromData(devObj)
+		d.variableWarnings(varWarnings.Commands, "commands")
+		d.variableWarnings(varWarnings.Components, "components")
+		d.variableWarnings(varWarnings.Projects, "projects")
+		d.variableWarnings(varWarnings.StarterProjects, "starterProjects")
+	}
+	// without the parent, the references to its components and commands cannot be checked
+	checkReferences := !hasParent || parseErr == nil
+
+	if !hasParent {
+		d.checkComponentsPresent()
+	}
+	d.checkSchemaVersionFields()
+	d.checkVolumeMounts(names, checkReferences)
+	d.checkEndpointPorts()
+	d.checkCommands(names, checkReferences)
+	d.checkEvents(names, checkReferences)
+	if !hasParent {
+		d.checkDefaultCommands()
+	}
+
+	sort.SliceStable(d.diagnostics, func(i, j int) bool {
+		if d.diagnostics[i].Line != d.diagnostics[j].Line {
+			return d.diagnostics[i].Line < d.diagnostics[j].Line
+		}
+		return d.diagnostics[i].Column < d.diagnostics[j].Column
+	})
+	return d.diagnostics
+}
+
+// HasErrors returns true if at least one of the diagnostics is an error
+func HasErrors(diagnostics []api.DevfileDiagnostic) bool {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == api.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+type diagnoser struct {
+	file        string
+	root        *yaml.Node
+	diagnostics []api.DevfileDiagnostic
+}
+
+// add adds a diagnostic located at the node, or at the beginning of the file if node is nil
+func (d *diagnoser) add(node *yaml.Node, path string, severity string, message string) {
+	line, column := 1, 1
+	if node != nil {
+		line, column = node.Line, node.Column
+	}
+	d.diagnostics = append(d.diagnostics, api.DevfileDiagnostic{
+		File:     d.file,
+		Line:     line,
+		Column:   column,
+		Severity: severity,
+		Path:     path,
+		Message:  message,
+	})
+}
+
+// libraryError adds the errors returned by the devfile library, located at the field they are related to when it can be determined
+func (d *diagnoser) libraryError(err error) {
+	for _, item := range splitLibraryError(err.Error()) {
+		if isCheckedByOdo(item) {
+			continue
+		}
+		if match := schemaItemRegexp.FindStringSubmatch(item); match != nil {
+			node, path := resolveSchemaPath(d.root, match[1])
+			d.add(node, path, api.DiagnosticSeverityError, match[2])
+			continue
+		}
+		node, path := d.locateMessage(item)
+		d.add(node, path, api.DiagnosticSeverityError, item)
+	}
+}
+
+// splitLibraryError splits the message of an error returned by the devfile library into the messages of the individual errors
+func splitLibraryError(msg string) []string {
+	var items []string
+	switch {
+	case strings.Contains(msg, "errors :\n"):
+		// invalid devfile schema. errors :\n- path: message\n
+		for _, line := range strings.Split(msg[strings.Index(msg, "errors :\n")+len("errors :\n"):], "\n") {
+			if line = strings.TrimSpace(strings.TrimPrefix(line, "- ")); line != "" {
+				items = append(items, line)
+			}
+		}
+	case strings.Contains(msg, "errors occurred:") || strings.Contains(msg, "error occurred:"):
+		// N errors occurred:\n\t* message\n\t* message
+		parts := strings.Split(msg, "\n\t* ")
+		for _, part := range parts[1:] {
+			part = strings.Join(strings.Fields(part), " ")
+			if part != "" {
+				items = append(items, part)
+			}
+		}
+	default:
+		items = append(items, msg)
+	}
+	return items
+}
+
+func isCheckedByOdo(msg string) bool {
+	for _, checked := range libraryMessagesCheckedByOdo {
+		if strings.Contains(msg, checked) {
+			return true
+		}
+	}
+	return false
+}
+
+// locateMessage returns the node identified by a name or id quoted in the message, or ending the message
+func (d *diagnoser) locateMessage(msg string) (*yaml.Node, string) {
+	var candidates []string
+	for _, match := range quotedRegexp.FindAllStringSubmatch(msg, -1) {
+		candidates = append(candidates, match[1])
+	}
+	if i := strings.LastIndex(msg, ": "); i >= 0 {
+		candidates = append(candidates, strings.TrimSpace(msg[i+2:]))
+	}
+	for _, candidate := range candidates {
+		if node, path := findNamed(d.root, "", candidate); node != nil {
+			return node, path
+		}
+	}
+	return nil, ""
+}
+
+func (d *diagnoser) variableWarnings(warnings map[string][]string, section string) {
+	names := make([]string, 0, len(warnings))
+	for name := range warnings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		node, path := findNamed(yamlnode.MappingValue(d.root, section), section, name)
+		d.add(node, path, api.DiagnosticSeverityWarning,
+			fmt.Sprintf("undefined variable(s) %s in %s %q", quoteAll(warnings[name]), section, name))
+	}
+}
+
+func (d *diagnoser) checkComponentsPresent() {
+	components := yamlnode.MappingValue(d.root, "components")
+	if components == nil || len(components.Content) == 0 {
+		d.add(yamlnode.MappingKey(d.root, "components"), "components", api.DiagnosticSeverityError, (&NoComponentsError{}).Error())
+		return
+	}
+	for _, component := range components.Content {
+		if yamlnode.MappingValue(component, "container") != nil {
+			return
+		}
+	}
+	d.add(yamlnode.MappingKey(d.root, "components"), "components", api.DiagnosticSeverityError, (&NoContainerComponentError{}).Error())
+}
+
+// checkSchemaVersionFields warns about the fields not supported by the schema version declared by the Devfile
+func (d *diagnoser) checkSchemaVersionFields() {
+	schemaVersion := yamlnode.ScalarValue(d.root, "schemaVersion")
+	for _, field := range migrate.UnsupportedFields(d.root, schemaVersion) {
+		msg := field.Message(schemaVersion)
+		if field.Since != "" {
+			msg += fmt.Sprintf("; migrate the devfile with odo devfile migrate --schema-version %s", field.Since)
+		}
+		d.add(field.Key, field.Path, api.DiagnosticSeverityWarning, msg)
+	}
+}
+
+func (d *diagnoser) checkVolumeMounts(names devfileNames, checkReferences bool) {
+	if !checkReferences {
+		return
+	}
+	forEachContainer(d.root, func(name string, container *yaml.Node, path string) {
+		for _, mount := range yamlnode.SequenceItems(yamlnode.MappingValue(container, "volumeMounts")) {
+			volume := yamlnode.MappingValue(mount, "name")
+			if volume == nil || names.volumes[volume.Value] {
+				continue
+			}
+			d.add(volume, fmt.Sprintf("%s.volumeMounts[%s]", path, volume.Value), api.DiagnosticSeverityError,
+				fmt.Sprintf("the volume mount %q of the container %q does not reference a volume component", volume.Value, name))
+		}
+	})
+}
+
+func (d *diagnoser) checkEndpointPorts() {
+	type endpoint struct {
+		name      string
+		component string
+	}
+	ports := map[string]endpoint{}
+	forEachContainer(d.root, func(name string, container *yaml.Node, path string) {
+		for _, e := range yamlnode.SequenceItems(yamlnode.MappingValue(container, "endpoints")) {
+			port := yamlnode.MappingValue(e, "targetPort")
+			endpointName := yamlnode.ScalarValue(e, "name")
+			if port == nil {
+				continue
+			}
+			if other, found := ports[port.Value]; found {
+				d.add(port, fmt.Sprintf("%s.endpoints[%s].targetPort", path, endpointName), api.DiagnosticSeverityError,
+					fmt.Sprintf("the port %s of the endpoint %q of the component %q is already used by the endpoint %q of the component %q",
+						port.Value, endpointName, name, other.name, other.component))
+				continue
+			}
+			ports[port.Value] = endpoint{name: endpointName, component: name}
+		}
+	})
+}
+
+func (d *diagnoser) checkCommands(names devfileNames, checkReferences bool) {
+	for _, command := range yamlnode.SequenceItems(yamlnode.MappingValue(d.root, "commands")) {
+		id := yamlnode.ScalarValue(command, "id")
+		path := fmt.Sprintf("commands[%s]", id)
+		exec := yamlnode.MappingValue(command, "exec")
+		apply := yamlnode.MappingValue(command, "apply")
+		composite := yamlnode.MappingValue(command, "composite")
+		if exec == nil && apply == nil && composite == nil {
+			d.add(yamlnode.MappingValue(command, "id"), path, api.DiagnosticSeverityError, (&UnsupportedOdoCommandError{commandId: id}).Error())
+			continue
+		}
+		if !checkReferences {
+			continue
+		}
+		if component := yamlnode.MappingValue(exec, "component"); component != nil && !names.containers[component.Value] {
+			msg := fmt.Sprintf("the command %q references the component %q, which does not exist", id, component.Value)
+			if names.components[component.Value] {
+				msg = fmt.Sprintf("the command %q references the component %q, which is not a container component", id, component.Value)
+			}
+			d.add(component, path+".exec.component", api.DiagnosticSeverityError, msg)
+		}
+		if component := yamlnode.MappingValue(apply, "component"); component != nil && !names.components[component.Value] {
+			d.add(component, path+".apply.component", api.DiagnosticSeverityError,
+				fmt.Sprintf("the command %q references the component %q, which does not exist", id, component.Value))
+		}
+		for _, sub := range yamlnode.SequenceItems(yamlnode.MappingValue(composite, "commands")) {
+			if !names.commands[strings.ToLower(sub.Value)] {
+				d.add(sub, path+".composite.commands", api.DiagnosticSeverityError,
+					fmt.Sprintf("the composite command %q references the command %q, which does not exist", id, sub.Value))
+			}
+		}
+	}
+}
+
+func (d *diagnoser) checkEvents(names devfileNames, checkReferences bool) {
+	events := yamlnode.MappingValue(d.root, "events")
+	if !checkReferences || events == nil || events.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(events.Content); i += 2 {
+		event := events.Content[i].Value
+		for _, command := range yamlnode.SequenceItems(events.Content[i+1]) {
+			if !names.commands[strings.ToLower(command.Value)] {
+				d.add(command, "events."+event, api.DiagnosticSeverityError,
+					fmt.Sprintf("the %s event references the command %q, which does not exist", event, command.Value))
+			}
+		}
+	}
+}
+
+func (d *diagnoser) checkDefaultCommands() {
+	type groupedCommand struct {
+		id        string
+		kind      *yaml.Node
+		isDefault *yaml.Node
+	}
+	groups := map[string][]groupedCommand{}
+	for _, command := range yamlnode.SequenceItems(yamlnode.MappingValue(d.root, "commands")) {
+		for _, typ := range []string{"exec", "apply", "composite"} {
+			group := yamlnode.MappingValue(yamlnode.MappingValue(command, typ), "group")
+			kind := yamlnode.MappingValue(group, "kind")
+			if kind == nil {
+				continue
+			}
+			groups[kind.Value] = append(groups[kind.Value], groupedCommand{
+				id:        yamlnode.ScalarValue(command, "id"),
+				kind:      kind,
+				isDefault: yamlnode.MappingValue(group, "isDefault"),
+			})
+		}
+	}
+
+	kinds := make([]string, 0, len(groups))
+	for kind := range groups {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		commands := groups[kind]
+		var ids []string
+		var defaults []groupedCommand
+		for _, command := range commands {
+			ids = append(ids, command.id)
+			if command.isDefault != nil && command.isDefault.Value == "true" {
+				defaults = append(defaults, command)
+			}
+		}
+		switch {
+		case len(commands) > 1 && len(defaults) == 0:
+			d.add(commands[0].kind, fmt.Sprintf("commands[%s]", commands[0].id), api.DiagnosticSeverityError,
+				fmt.Sprintf("none of the commands %s of kind %q is marked as default with isDefault: true", quoteAll(ids), kind))
+		case len(defaults) > 1:
+			d.add(defaults[1].isDefault, fmt.Sprintf("commands[%s]", defaults[1].id), api.DiagnosticSeverityError,
+				fmt.Sprintf("several commands of kind %q are marked as default: %q and %q", kind, defaults[0].id, defaults[1].id))
+		}
+	}
+
+	if _, found := groups[string(devfilev1.RunCommandGroupKind)]; !found {
+		d.add(yamlnode.MappingKey(d.root, "commands"), "commands", api.DiagnosticSeverityWarning,
+			fmt.Sprintf("no command of kind %q is defined, the component cannot be run with odo dev", devfilev1.RunCommandGroupKind))
+	}
+}
+
+// devfileNames are the names of the components and commands defined in the Devfile
+type devfileNames struct {
+	components map[string]bool
+	containers map[string]bool
+	volumes    map[string]bool
+	commands   map[string]bool
+}
+
+func collectNames(root *yaml.Node) devfileNames {
+	names := devfileNames{
+		components: map[string]bool{},
+		containers: map[string]bool{},
+		volumes:    map[string]bool{},
+		commands:   map[string]bool{},
+	}
+	for _, component := range yamlnode.SequenceItems(yamlnode.MappingValue(root, "components")) {
+		name := yamlnode.ScalarValue(component, "name")
+		names.components[name] = true
+		if yamlnode.MappingValue(component, "container") != nil {
+			names.containers[name] = true
+		}
+		if yamlnode.MappingValue(component, "volume") != nil {
+			names.volumes[name] = true
+		}
+	}
+	for _, command := range yamlnode.SequenceItems(yamlnode.MappingValue(root, "commands")) {
+		names.commands[strings.ToLower(yamlnode.ScalarValue(command, "id"))] = true
+	}
+	return names
+}
+
+// addFromData adds the names of the components and commands of the flattened Devfile
+func (o devfileNames) addFromData(devObj parser.DevfileObj) {
+	components, err := devObj.Data.GetComponents(common.DevfileOptions{})
+	if err == nil {
+		for _, component := range components {
+			o.components[component.Name] = true
+			if component.Container != nil {
+				o.containers[component.Name] = true
+			}
+			if component.Volume != nil {
+				o.volumes[component.Name] = true
+			}
+		}
+	}
+	commands, err := devObj.Data.GetCommands(common.DevfileOptions{})
+	if err == nil {
+		for _, command := range commands {
+			o.commands[strings.ToLower(command.Id)] = true
+		}
+	}
+}
+
+// forEachContainer calls fn for each container component of the Devfile
+func forEachContainer(root *yaml.Node, fn func(name string, container *yaml.Node, path string)) {
+	for _, component := range yamlnode.SequenceItems(yamlnode.MappingValue(root, "components")) {
+		container := yamlnode.MappingValue(component, "container")
+		if container == nil {
+			continue
+		}
+		name := yamlnode.ScalarValue(component, "name")
+		fn(name, container, fmt.Sprintf("components[%s].container", name))
+	}
+}
+
+// resolveSchemaPath returns the node at the path of a schema validation error, for example components.1.container,
+// and the path with the names of the elements of lists, for example components[runtime].container.
+// If the path cannot be resolved completely, the deepest node found is returned.
+func resolveSchemaPath(root *yaml.Node, schemaPath string) (*yaml.Node, string) {
+	if schemaPath == "(root)" {
+		return nil, ""
+	}
+	node, path := root, ""
+	var located *yaml.Node
+	for _, part := range strings.Split(schemaPath, ".") {
+		if index, err := strconv.Atoi(part); err == nil && node.Kind == yaml.SequenceNode {
+			if index >= len(node.Content) {
+				break
+			}
+			node = node.Content[index]
+			located = node
+			name := yamlnode.ScalarValue(node, "name")
+			if name == "" {
+				name = yamlnode.ScalarValue(node, "id")
+			}
+			if name == "" {
+				name = part
+			}
+			path += "[" + name + "]"
+			continue
+		}
+		key := yamlnode.MappingKey(node, part)
+		if key == nil {
+			break
+		}
+		located = key
+		node = yamlnode.MappingValue(node, part)
+		if path != "" {
+			path += "."
+		}
+		path += part
+	}
+	return located, path
+}
+
+// findNamed returns the name or id node having the value, searched in the node and its descendants, with its path
+func findNamed(node *yaml.Node, path string, value string) (*yaml.Node, string) {
+	if node == nil {
+		return nil, ""
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		for _, field := range []string{"name", "id"} {
+			if n := yamlnode.MappingValue(node, field); n != nil && n.Value == value {
+				return n, path
+			}
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			childPath := node.Content[i].Value
+			if path != "" {
+				childPath = path + "." + childPath
+			}
+			if found, foundPath := findNamed(node.Content[i+1], childPath, value); found != nil {
+				return found, foundPath
+			}
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			name := yamlnode.ScalarValue(item, "name")
+			if name == "" {
+				name = yamlnode.ScalarValue(item, "id")
+			}
+			if name == "" {
+				name = strconv.Itoa(i)
+			}
+			if found, foundPath := findNamed(item, fmt.Sprintf("%s[%s]", path, name), value); found != nil {
+				return found, foundPath
+			}
+		}
+	}
+	return nil, ""
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, strconv.Quote(value))
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,196 @@
+package validate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+func TestDiagnose(t *testing.T) {
+	tests := []struct {
+		name    string
+		devfile string
+		want    []api.DevfileDiagnostic
+	}{
+		{
+			name: "valid devfile",
+			devfile: `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+components:
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-16:latest
+      endpoints:
+        - name: http
+          targetPort: 3000
+commands:
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+      group:
+        kind: run
+        isDefault: true
+`,
+		},
+		{
+			name: "YAML syntax error",
+			devfile: `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+ components: []
+`,
+			want: []api.DevfileDiagnostic{
+				{Line: 3, Column: 1, Severity: api.DiagnosticSeverityError},
+			},
+		},
+		{
+			name: "schema error",
+			devfile: `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+components:
+  - name: runtime
+    container:
+      memoryLimit: 1Gi
+commands:
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+      group:
+        kind: run
+`,
+			want: []api.DevfileDiagnostic{
+				{Line: 6, Column: 5, Severity: api.DiagnosticSeverityError, Path: "components[runtime].container", Message: "image is required"},
+			},
+		},
+		{
+			name: "field not supported by the schema version",
+			devfile: `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+components:
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-16:latest
+  - name: k8s
+    kubernetes:
+      deployByDefault: true
+      uri: service.yaml
+commands:
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+      group:
+        kind: run
+`,
+			want: []api.DevfileDiagnostic{
+				{Line: 9, Column: 5, Severity: api.DiagnosticSeverityError, Path: "components[k8s].kubernetes",
+					Message: "Additional property deployByDefault is not allowed"},
+				{Line: 10, Column: 7, Severity: api.DiagnosticSeverityWarning, Path: "components[k8s].kubernetes.deployByDefault",
+					Message: "the field components[k8s].kubernetes.deployByDefault requires the schema version 2.2.0 or later, " +
+						"but the devfile declares the schema version 2.1.0; migrate the devfile with odo devfile migrate --schema-version 2.2.0"},
+			},
+		},
+		{
+			name: "semantic errors",
+			devfile: `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+components:
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-16:latest
+      endpoints:
+        - name: http
+          targetPort: 3000
+      volumeMounts:
+        - name: data
+  - name: debug
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-16:latest
+      endpoints:
+        - name: http-debug
+          targetPort: 3000
+commands:
+  - id: run
+    exec:
+      component: runner
+      commandLine: npm start
+      group:
+        kind: run
+  - id: run-debug
+    exec:
+      component: debug
+      commandLine: npm run debug
+      group:
+        kind: run
+  - id: all
+    composite:
+      commands: [run, unknown]
+events:
+  postStart: [install]
+`,
+			want: []api.DevfileDiagnostic{
+				{Line: 12, Column: 17, Severity: api.DiagnosticSeverityError, Path: "components[runtime].container.volumeMounts[data]",
+					Message: `the volume mount "data" of the container "runtime" does not reference a volume component`},
+				{Line: 18, Column: 23, Severity: api.DiagnosticSeverityError, Path: "components[debug].container.endpoints[http-debug].targetPort",
+					Message: `the port 3000 of the endpoint "http-debug" of the component "debug" is already used by the endpoint "http" of the component "runtime"`},
+				{Line: 22, Column: 18, Severity: api.DiagnosticSeverityError, Path: "commands[run].exec.component",
+					Message: `the command "run" references the component "runner", which does not exist`},
+				{Line: 25, Column: 15, Severity: api.DiagnosticSeverityError, Path: "commands[run]",
+					Message: `none of the commands "run", "run-debug" of kind "run" is marked as default with isDefault: true`},
+				{Line: 34, Column: 23, Severity: api.DiagnosticSeverityError, Path: "commands[all].composite.commands",
+					Message: `the composite command "all" references the command "unknown", which does not exist`},
+				{Line: 36, Column: 15, Severity: api.DiagnosticSeverityError, Path: "events.postStart",
+					Message: `the postStart event references the command "install", which does not exist`},
+			},
+		},
+		{
+			name: "missing run command",
+			devfile: `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+components:
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-16:latest
+`,
+			want: []api.DevfileDiagnostic{
+				{Line: 1, Column: 1, Severity: api.DiagnosticSeverityWarning, Path: "commands",
+					Message: `no command of kind "run" is defined, the component cannot be run with odo dev`},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfilePath := filepath.Join(t.TempDir(), "devfile.yaml")
+			if err := os.WriteFile(devfilePath, []byte(tt.devfile), 0644); err != nil {
+				t.Fatal(err)
+			}
+			got, err := Diagnose(filesystem.DefaultFs{}, devfilePath)
+			if err != nil {
+				t.Fatalf("Diagnose() unexpected error: %v", err)
+			}
+			for i := range tt.want {
+				tt.want[i].File = devfilePath
+			}
+			opts := []cmp.Option{cmpopts.EquateEmpty()}
+			if tt.name == "YAML syntax error" {
+				opts = append(opts, cmpopts.IgnoreFields(api.DevfileDiagnostic{}, "Message"))
+			}
+			if diff := cmp.Diff(tt.want, got, opts...); diff != "" {
+				t.Errorf("Diagnose() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
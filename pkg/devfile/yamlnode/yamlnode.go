@@ -0,0 +1,174 @@
+// Package yamlnode provides helpers to read and edit YAML documents as trees of nodes,
+// preserving the formatting and comments of the documents
+package yamlnode
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ParseDocument parses the YAML document, which must contain a mapping
+func ParseDocument(content []byte) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("the document is not a YAML mapping")
+	}
+	return &doc, nil
+}
+
+// Encode encodes the document node, with an indentation of 2 spaces
+func Encode(doc *yaml.Node) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// FromValue returns the node representing the value, serialized using its JSON tags.
+// The fields are in the order of the struct fields, and the node is in block style.
+func FromValue(value interface{}) (*yaml.Node, error) {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	// JSON is valid YAML, and the order of the fields is preserved
+	var doc yaml.Node
+	if err = yaml.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, fmt.Errorf("unable to serialize the value")
+	}
+	resetStyle(doc.Content[0])
+	return doc.Content[0], nil
+}
+
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+// MappingKey returns the key node of the key in the mapping node, or nil if the key is not found
+func MappingKey(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i]
+		}
+	}
+	return nil
+}
+
+// MappingValue returns the value node of the key in the mapping node, or nil if the key is not found
+func MappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// SetMappingValue sets the value of the key in the mapping node, adding the key at the end of the mapping if not found
+func SetMappingValue(node *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content[i+1] = value
+			return
+		}
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+}
+
+// RemoveMappingKey removes the key and its value from the mapping node, and returns true if the key was found
+func RemoveMappingKey(node *yaml.Node, key string) bool {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return false
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content = append(node.Content[:i], node.Content[i+2:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// ScalarValue returns the value of the scalar field of the mapping node, or an empty string
+func ScalarValue(node *yaml.Node, key string) string {
+	if value := MappingValue(node, key); value != nil && value.Kind == yaml.ScalarNode {
+		return value.Value
+	}
+	return ""
+}
+
+// SequenceItems returns the items of the sequence node, or nil if the node is not a sequence
+func SequenceItems(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+	return node.Content
+}
+
+// ItemKey returns the value of the name field of the mapping node, or of its id field (for commands), or an empty string
+func ItemKey(node *yaml.Node) string {
+	for _, field := range []string{"name", "id"} {
+		if value := ScalarValue(node, field); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// NamedItem returns the item of the sequence node having the name or id, or nil if not found
+func NamedItem(node *yaml.Node, name string) *yaml.Node {
+	for _, item := range SequenceItems(node) {
+		if ItemKey(item) == name {
+			return item
+		}
+	}
+	return nil
+}
+
+// RemoveNamedItem removes the item having the name or id from the sequence node, and returns true if the item was found
+func RemoveNamedItem(node *yaml.Node, name string) bool {
+	for i, item := range SequenceItems(node) {
+		if ItemKey(item) == name {
+			node.Content = append(node.Content[:i], node.Content[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Equal returns true if both nodes have the same value, regardless of their formatting
+func Equal(a, b *yaml.Node) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	var va, vb interface{}
+	if a.Decode(&va) != nil || b.Decode(&vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
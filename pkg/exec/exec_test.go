@@ -8,6 +8,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/redhat-developer/odo/pkg/platform"
 )
 
 const (
@@ -23,7 +25,7 @@ func (o fakePlatform) ExecCMDInContainer(containerName, podName string, cmd []st
 	return o.execCMDInContainer(containerName, podName, cmd, stdout, stderr, stdin, tty)
 }
 
-func (o fakePlatform) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
+func (o fakePlatform) GetPodLogs(podName, containerName string, options platform.LogOptions) (io.ReadCloser, error) {
 	panic("not implemented yet")
 }
 
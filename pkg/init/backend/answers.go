@@ -0,0 +1,319 @@
+package backend
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/xeipuuv/gojsonschema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+// AnswersSchema is the JSON schema of the answers file
+//
+//go:embed answers.schema.json
+var AnswersSchema []byte
+
+// Answers are the answers to the questions asked in interactive mode, in the format of the answers file
+type Answers struct {
+	Name            string `json:"name"`
+	Devfile         string `json:"devfile,omitempty"`
+	DevfileRegistry string `json:"devfileRegistry,omitempty"`
+	DevfileVersion  string `json:"devfileVersion,omitempty"`
+	DevfilePath     string `json:"devfilePath,omitempty"`
+	Starter         string `json:"starter,omitempty"`
+	StarterGit      string `json:"starterGit,omitempty"`
+	StarterRevision string `json:"starterRevision,omitempty"`
+	StarterSubdir   string `json:"starterSubdir,omitempty"`
+	// StarterNoOverwrite downloads the starter project in a non empty directory, without overwriting its files
+	StarterNoOverwrite bool `json:"starterNoOverwrite,omitempty"`
+	// Containers are the changes to the configuration of the containers, by name of container
+	Containers map[string]ContainerAnswers `json:"containers,omitempty"`
+}
+
+// ContainerAnswers are the changes to the configuration of a container
+type ContainerAnswers struct {
+	AddPorts    []int             `json:"addPorts,omitempty"`
+	RemovePorts []int             `json:"removePorts,omitempty"`
+	Env         map[string]string `json:"env,omitempty"`
+	RemoveEnv   []string          `json:"removeEnv,omitempty"`
+}
+
+// ParseAnswers parses answers in YAML or JSON format, and validates them against the schema
+func ParseAnswers(content []byte) (*Answers, error) {
+	jsonContent, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the answers: %w", err)
+	}
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(AnswersSchema), gojsonschema.NewBytesLoader(jsonContent))
+	if err != nil {
+		return nil, fmt.Errorf("unable to validate the answers: %w", err)
+	}
+	if !result.Valid() {
+		var errs []string
+		for _, desc := range result.Errors() {
+			errs = append(errs, desc.String())
+		}
+		return nil, fmt.Errorf("invalid answers:\n  - %s", strings.Join(errs, "\n  - "))
+	}
+	var answers Answers
+	if err = json.Unmarshal(jsonContent, &answers); err != nil {
+		return nil, err
+	}
+	return &answers, nil
+}
+
+// NewAnswers returns the answers given in interactive mode, the changes to the configuration of the containers
+// being the differences between the original devfile and the personalized one
+func NewAnswers(devfileLocation *api.DetectionResult, starter *v1alpha2.StarterProject, name string, original parser.DevfileObj, personalized parser.DevfileObj) (*Answers, error) {
+	answers := &Answers{
+		Name:            name,
+		Devfile:         devfileLocation.Devfile,
+		DevfileRegistry: devfileLocation.DevfileRegistry,
+		DevfileVersion:  devfileLocation.DevfileVersion,
+		DevfilePath:     devfileLocation.DevfilePath,
+	}
+	if starter != nil {
+		answers.Starter = starter.Name
+	}
+
+	originalConfig, err := getPortsAndEnvVar(original)
+	if err != nil {
+		return nil, err
+	}
+	personalizedConfig, err := getPortsAndEnvVar(personalized)
+	if err != nil {
+		return nil, err
+	}
+	for container, after := range personalizedConfig {
+		before := originalConfig[container]
+		var changes ContainerAnswers
+		if changes.AddPorts, err = portsDifference(after.Ports, before.Ports); err != nil {
+			return nil, err
+		}
+		if changes.RemovePorts, err = portsDifference(before.Ports, after.Ports); err != nil {
+			return nil, err
+		}
+		for envName, value := range after.Envs {
+			if beforeValue, ok := before.Envs[envName]; !ok || beforeValue != value {
+				if changes.Env == nil {
+					changes.Env = map[string]string{}
+				}
+				changes.Env[envName] = value
+			}
+		}
+		for envName := range before.Envs {
+			if _, ok := after.Envs[envName]; !ok {
+				changes.RemoveEnv = append(changes.RemoveEnv, envName)
+			}
+		}
+		sort.Strings(changes.RemoveEnv)
+		if len(changes.AddPorts) == 0 && len(changes.RemovePorts) == 0 && len(changes.Env) == 0 && len(changes.RemoveEnv) == 0 {
+			continue
+		}
+		if answers.Containers == nil {
+			answers.Containers = map[string]ContainerAnswers{}
+		}
+		answers.Containers[container] = changes
+	}
+	return answers, nil
+}
+
+// portsDifference returns the ports of a which are not in b, sorted
+func portsDifference(a []string, b []string) ([]int, error) {
+	inB := map[string]bool{}
+	for _, port := range b {
+		inB[port] = true
+	}
+	var result []int
+	for _, port := range a {
+		if inB[port] {
+			continue
+		}
+		p, err := strconv.Atoi(port)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+	sort.Ints(result)
+	return result, nil
+}
+
+// Flags returns the answers selecting the devfile, the starter project and the name, as the flags of the Flags backend
+func (o *Answers) Flags() map[string]string {
+	flags := map[string]string{}
+	for flag, value := range map[string]string{
+		FLAG_NAME:             o.Name,
+		FLAG_DEVFILE:          o.Devfile,
+		FLAG_DEVFILE_REGISTRY: o.DevfileRegistry,
+		FLAG_DEVFILE_VERSION:  o.DevfileVersion,
+		FLAG_DEVFILE_PATH:     o.DevfilePath,
+		FLAG_STARTER:          o.Starter,
+		FLAG_STARTER_GIT:      o.StarterGit,
+		FLAG_STARTER_REVISION: o.StarterRevision,
+		FLAG_STARTER_SUBDIR:   o.StarterSubdir,
+	} {
+		if value != "" {
+			flags[flag] = value
+		}
+	}
+	if o.StarterNoOverwrite {
+		flags[FLAG_STARTER_NO_OVERWRITE] = "true"
+	}
+	return flags
+}
+
+// AnswersBackend is a backend that will extract all needed information from an answers file,
+// whose path is passed in the `answers` flag, or from the standard input if the path is "-"
+type AnswersBackend struct {
+	flagsBackend *FlagsBackend
+	stdin        io.Reader
+	// answers are loaded when the flags are validated
+	answers *Answers
+}
+
+var _ InitBackend = (*AnswersBackend)(nil)
+
+func NewAnswersBackend(preferenceClient preference.Client, stdin io.Reader) *AnswersBackend {
+	return &AnswersBackend{
+		flagsBackend: NewFlagsBackend(preferenceClient),
+		stdin:        stdin,
+	}
+}
+
+func (o *AnswersBackend) Validate(flags map[string]string, fs filesystem.Filesystem, dir string) error {
+	for flag := range flags {
+		if flag != FLAG_ANSWERS {
+			return fmt.Errorf("--%s parameter cannot be used with --answers, the answers file must contain all the answers", flag)
+		}
+	}
+
+	var (
+		content []byte
+		err     error
+	)
+	if path := flags[FLAG_ANSWERS]; path == "-" {
+		content, err = io.ReadAll(o.stdin)
+	} else {
+		content, err = fs.ReadFile(path)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to read the answers: %w", err)
+	}
+	o.answers, err = ParseAnswers(content)
+	if err != nil {
+		return err
+	}
+	return o.flagsBackend.Validate(o.answers.Flags(), fs, dir)
+}
+
+// Flags returns the answers loaded, as the flags of the Flags backend
+func (o *AnswersBackend) Flags() map[string]string {
+	if o.answers == nil {
+		return map[string]string{}
+	}
+	return o.answers.Flags()
+}
+
+func (o *AnswersBackend) getAnswers() (*Answers, error) {
+	if o.answers == nil {
+		return nil, errors.New("the answers have not been loaded")
+	}
+	return o.answers, nil
+}
+
+func (o *AnswersBackend) SelectDevfile(ctx context.Context, _ map[string]string, fs filesystem.Filesystem, dir string) (*api.DetectionResult, error) {
+	answers, err := o.getAnswers()
+	if err != nil {
+		return nil, err
+	}
+	return o.flagsBackend.SelectDevfile(ctx, answers.Flags(), fs, dir)
+}
+
+func (o *AnswersBackend) SelectStarterProject(devfile parser.DevfileObj, _ map[string]string) (*v1alpha2.StarterProject, error) {
+	answers, err := o.getAnswers()
+	if err != nil {
+		return nil, err
+	}
+	return o.flagsBackend.SelectStarterProject(devfile, answers.Flags())
+}
+
+func (o *AnswersBackend) PersonalizeName(devfile parser.DevfileObj, _ map[string]string) (string, error) {
+	answers, err := o.getAnswers()
+	if err != nil {
+		return "", err
+	}
+	return o.flagsBackend.PersonalizeName(devfile, answers.Flags())
+}
+
+// PersonalizeDevfileConfig applies the changes of the answers to the ports and environment variables of the containers
+func (o *AnswersBackend) PersonalizeDevfileConfig(devfileobj parser.DevfileObj) (parser.DevfileObj, error) {
+	answers, err := o.getAnswers()
+	if err != nil {
+		return parser.DevfileObj{}, err
+	}
+	config, err := getPortsAndEnvVar(devfileobj)
+	if err != nil {
+		return parser.DevfileObj{}, err
+	}
+
+	containers := make([]string, 0, len(answers.Containers))
+	for container := range answers.Containers {
+		containers = append(containers, container)
+	}
+	sort.Strings(containers)
+	for _, container := range containers {
+		if _, ok := config[container]; !ok {
+			return parser.DevfileObj{}, fmt.Errorf("container %q not found in the devfile", container)
+		}
+		changes := answers.Containers[container]
+
+		err = devfileobj.Data.RemovePorts(map[string][]string{container: portsToStrings(changes.RemovePorts)})
+		if err != nil {
+			return parser.DevfileObj{}, fmt.Errorf("container %q: %w", container, err)
+		}
+		err = devfileobj.Data.SetPorts(map[string][]string{container: portsToStrings(changes.AddPorts)})
+		if err != nil {
+			return parser.DevfileObj{}, fmt.Errorf("container %q: %w", container, err)
+		}
+		err = devfileobj.Data.RemoveEnvVars(map[string][]string{container: changes.RemoveEnv})
+		if err != nil {
+			return parser.DevfileObj{}, fmt.Errorf("container %q: %w", container, err)
+		}
+		envVars := make([]v1alpha2.EnvVar, 0, len(changes.Env))
+		for name, value := range changes.Env {
+			envVars = append(envVars, v1alpha2.EnvVar{Name: name, Value: value})
+		}
+		err = devfileobj.Data.AddEnvVars(map[string][]v1alpha2.EnvVar{container: envVars})
+		if err != nil {
+			return parser.DevfileObj{}, fmt.Errorf("container %q: %w", container, err)
+		}
+	}
+	return devfileobj, nil
+}
+
+// HandleApplicationPorts does not change the ports, the ports to expose being part of the answers
+func (o *AnswersBackend) HandleApplicationPorts(devfileobj parser.DevfileObj, _ []int, _ map[string]string) (parser.DevfileObj, error) {
+	return devfileobj, nil
+}
+
+func portsToStrings(ports []int) []string {
+	result := make([]string, 0, len(ports))
+	for _, port := range ports {
+		result = append(result, strconv.Itoa(port))
+	}
+	return result
+}
@@ -0,0 +1,293 @@
+package backend
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	dffilesystem "github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/init/asker"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+func TestParseAnswers(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    *Answers
+		wantErr string
+	}{
+		{
+			name: "answers in YAML format",
+			content: `name: my-app
+devfile: nodejs
+devfileRegistry: DefaultDevfileRegistry
+devfileVersion: 2.1.1
+starter: nodejs-starter
+containers:
+  runtime:
+    addPorts: [8080]
+    removePorts: [3000]
+    env:
+      DEBUG: "true"
+    removeEnv: [NODE_ENV]
+`,
+			want: &Answers{
+				Name:            "my-app",
+				Devfile:         "nodejs",
+				DevfileRegistry: "DefaultDevfileRegistry",
+				DevfileVersion:  "2.1.1",
+				Starter:         "nodejs-starter",
+				Containers: map[string]ContainerAnswers{
+					"runtime": {
+						AddPorts:    []int{8080},
+						RemovePorts: []int{3000},
+						Env:         map[string]string{"DEBUG": "true"},
+						RemoveEnv:   []string{"NODE_ENV"},
+					},
+				},
+			},
+		},
+		{
+			name:    "answers in JSON format",
+			content: `{"name": "my-app", "devfilePath": "https://devfiles.example.com/nodejs/devfile.yaml"}`,
+			want: &Answers{
+				Name:        "my-app",
+				DevfilePath: "https://devfiles.example.com/nodejs/devfile.yaml",
+			},
+		},
+		{
+			name:    "missing name",
+			content: `devfile: nodejs`,
+			wantErr: "name is required",
+		},
+		{
+			name:    "invalid name",
+			content: "name: My_App\ndevfile: nodejs",
+			wantErr: "name: Does not match pattern",
+		},
+		{
+			name:    "missing devfile",
+			content: `name: my-app`,
+			wantErr: "Must validate one and only one schema",
+		},
+		{
+			name:    "devfile and devfilePath",
+			content: "name: my-app\ndevfile: nodejs\ndevfilePath: ./devfile.yaml",
+			wantErr: "Must validate one and only one schema",
+		},
+		{
+			name:    "unknown field",
+			content: "name: my-app\ndevfile: nodejs\nversion: 2.1.1",
+			wantErr: "Additional property version is not allowed",
+		},
+		{
+			name:    "invalid port",
+			content: "name: my-app\ndevfile: nodejs\ncontainers:\n  runtime:\n    addPorts: [70000]",
+			wantErr: "Must be less than or equal to 65535",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAnswers([]byte(tt.content))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseAnswers() error = %v, want an error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAnswers() unexpected error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ParseAnswers() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnswersBackend_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   map[string]string
+		stdin   string
+		files   map[string]string
+		want    *Answers
+		wantErr bool
+	}{
+		{
+			name:  "answers read from a file",
+			flags: map[string]string{FLAG_ANSWERS: "/answers.yaml"},
+			files: map[string]string{"/answers.yaml": "name: my-app\ndevfilePath: /devfiles/devfile.yaml"},
+			want:  &Answers{Name: "my-app", DevfilePath: "/devfiles/devfile.yaml"},
+		},
+		{
+			name:  "answers read from the standard input",
+			flags: map[string]string{FLAG_ANSWERS: "-"},
+			stdin: `{"name": "my-app", "devfilePath": "/devfiles/devfile.yaml"}`,
+			want:  &Answers{Name: "my-app", DevfilePath: "/devfiles/devfile.yaml"},
+		},
+		{
+			name:    "other flags passed",
+			flags:   map[string]string{FLAG_ANSWERS: "/answers.yaml", FLAG_NAME: "my-app"},
+			files:   map[string]string{"/answers.yaml": "name: my-app\ndevfilePath: /devfiles/devfile.yaml"},
+			wantErr: true,
+		},
+		{
+			name:    "missing file",
+			flags:   map[string]string{FLAG_ANSWERS: "/answers.yaml"},
+			wantErr: true,
+		},
+		{
+			name:    "starter in a non empty directory",
+			flags:   map[string]string{FLAG_ANSWERS: "/answers.yaml"},
+			files:   map[string]string{"/answers.yaml": "name: my-app\ndevfilePath: /devfiles/devfile.yaml\nstarter: a-starter"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			for path, content := range tt.files {
+				_ = fs.WriteFile(path, []byte(content), 0644)
+			}
+			o := NewAnswersBackend(nil, strings.NewReader(tt.stdin))
+			err := o.Validate(tt.flags, fs, "/")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AnswersBackend.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, o.answers); diff != "" {
+				t.Errorf("AnswersBackend.Validate() answers mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnswersBackend_PersonalizeDevfileConfig(t *testing.T) {
+	container := "runtime"
+	tests := []struct {
+		name       string
+		containers map[string]ContainerAnswers
+		want       asker.DevfileConfiguration
+		wantErr    bool
+	}{
+		{
+			name: "ports and environment variables changed",
+			containers: map[string]ContainerAnswers{
+				container: {
+					AddPorts:    []int{9000},
+					RemovePorts: []int{7000},
+					Env:         map[string]string{"env1": "new", "env3": "val3"},
+					RemoveEnv:   []string{"env2"},
+				},
+			},
+			want: asker.DevfileConfiguration{
+				container: {
+					Ports: []string{"8000", "9000"},
+					Envs:  map[string]string{"env1": "new", "env3": "val3"},
+				},
+			},
+		},
+		{
+			name:       "unknown container",
+			containers: map[string]ContainerAnswers{"other": {AddPorts: []int{9000}}},
+			wantErr:    true,
+		},
+		{
+			name:       "unknown port to remove",
+			containers: map[string]ContainerAnswers{container: {RemovePorts: []int{9000}}},
+			wantErr:    true,
+		},
+		{
+			name:       "unknown environment variable to remove",
+			containers: map[string]ContainerAnswers{container: {RemoveEnv: []string{"env3"}}},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileObj := getDevfileObj(dffilesystem.NewFakeFs(), container, []string{"7000", "8000"},
+				[]v1alpha2.EnvVar{{Name: "env1", Value: "val1"}, {Name: "env2", Value: "val2"}})
+			o := &AnswersBackend{answers: &Answers{Containers: tt.containers}}
+			got, err := o.PersonalizeDevfileConfig(devfileObj)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AnswersBackend.PersonalizeDevfileConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			config, err := getPortsAndEnvVar(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for name, want := range tt.want {
+				if diff := cmp.Diff(want.Ports, config[name].Ports); diff != "" {
+					t.Errorf("ports mismatch (-want +got):\n%s", diff)
+				}
+				if diff := cmp.Diff(want.Envs, config[name].Envs); diff != "" {
+					t.Errorf("environment variables mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestNewAnswers(t *testing.T) {
+	container := "runtime"
+	fs := dffilesystem.NewFakeFs()
+	original := getDevfileObj(fs, container, []string{"7000", "8000"},
+		[]v1alpha2.EnvVar{{Name: "env1", Value: "val1"}, {Name: "env2", Value: "val2"}})
+	personalized := getDevfileObj(fs, container, []string{"8000", "9000"},
+		[]v1alpha2.EnvVar{{Name: "env1", Value: "new"}, {Name: "env3", Value: "val3"}})
+
+	got, err := NewAnswers(
+		&api.DetectionResult{Devfile: "nodejs", DevfileRegistry: "DefaultDevfileRegistry", DevfileVersion: "2.1.1"},
+		&v1alpha2.StarterProject{Name: "nodejs-starter"},
+		"my-app", original, personalized)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Answers{
+		Name:            "my-app",
+		Devfile:         "nodejs",
+		DevfileRegistry: "DefaultDevfileRegistry",
+		DevfileVersion:  "2.1.1",
+		Starter:         "nodejs-starter",
+		Containers: map[string]ContainerAnswers{
+			container: {
+				AddPorts:    []int{9000},
+				RemovePorts: []int{7000},
+				Env:         map[string]string{"env1": "new", "env3": "val3"},
+				RemoveEnv:   []string{"env2"},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewAnswers() mismatch (-want +got):\n%s", diff)
+	}
+
+	// the answers are applied to the original devfile to get the personalized one
+	o := &AnswersBackend{answers: got}
+	replayed, err := o.PersonalizeDevfileConfig(getDevfileObj(fs, container, []string{"7000", "8000"},
+		[]v1alpha2.EnvVar{{Name: "env1", Value: "val1"}, {Name: "env2", Value: "val2"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayedConfig, err := getPortsAndEnvVar(replayed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	personalizedConfig, err := getPortsAndEnvVar(personalized)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(personalizedConfig, replayedConfig); diff != "" {
+		t.Errorf("replayed configuration mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
 
 	"github.com/redhat-developer/odo/pkg/registry"
 
@@ -25,6 +28,12 @@ const (
 	FLAG_STARTER          = "starter"
 	FLAG_DEVFILE_PATH     = "devfile-path"
 	FLAG_DEVFILE_VERSION  = "devfile-version"
+	FLAG_ANSWERS          = "answers"
+
+	FLAG_STARTER_GIT          = "starter-git"
+	FLAG_STARTER_REVISION     = "starter-revision"
+	FLAG_STARTER_SUBDIR       = "starter-subdir"
+	FLAG_STARTER_NO_OVERWRITE = "starter-no-overwrite"
 )
 
 // FlagsBackend is a backend that will extract all needed information from flags passed to the command
@@ -76,12 +85,32 @@ func (o *FlagsBackend) Validate(flags map[string]string, fs filesystem.Filesyste
 		return err
 	}
 
+	if flags[FLAG_STARTER] != "" && flags[FLAG_STARTER_GIT] != "" {
+		return errors.New("only one of --starter or --starter-git parameter should be specified")
+	}
+	if flags[FLAG_STARTER_GIT] == "" && (flags[FLAG_STARTER_REVISION] != "" || flags[FLAG_STARTER_SUBDIR] != "") {
+		return errors.New("--starter-revision and --starter-subdir parameters can be used only with --starter-git")
+	}
+	if flags[FLAG_STARTER_SUBDIR] != "" && (filepath.IsAbs(flags[FLAG_STARTER_SUBDIR]) || strings.HasPrefix(filepath.Clean(flags[FLAG_STARTER_SUBDIR]), "..")) {
+		return errors.New("--starter-subdir parameter must be a path relative to the root of the git repository")
+	}
+	hasStarter := flags[FLAG_STARTER] != "" || flags[FLAG_STARTER_GIT] != ""
+	noOverwrite := flags[FLAG_STARTER_NO_OVERWRITE] == "true"
+	if !hasStarter && noOverwrite {
+		return errors.New("--starter-no-overwrite parameter can be used only with --starter or --starter-git")
+	}
+
 	empty, err := location.DirIsEmpty(fs, dir)
 	if err != nil {
 		return err
 	}
-	if !empty && flags[FLAG_STARTER] != "" {
-		return errors.New("--starter parameter cannot be used when the directory is not empty")
+	if !empty && hasStarter && !noOverwrite {
+		starterFlag := FLAG_STARTER
+		if flags[FLAG_STARTER_GIT] != "" {
+			starterFlag = FLAG_STARTER_GIT
+		}
+		return fmt.Errorf("--%s parameter cannot be used when the directory is not empty; "+
+			"use --%s to download the starter project without overwriting the files of the directory", starterFlag, FLAG_STARTER_NO_OVERWRITE)
 	}
 
 	return nil
@@ -97,6 +126,9 @@ func (o *FlagsBackend) SelectDevfile(ctx context.Context, flags map[string]strin
 }
 
 func (o *FlagsBackend) SelectStarterProject(devfile parser.DevfileObj, flags map[string]string) (*v1alpha2.StarterProject, error) {
+	if flags[FLAG_STARTER_GIT] != "" {
+		return GitStarterProject(flags[FLAG_STARTER_GIT], flags[FLAG_STARTER_REVISION], flags[FLAG_STARTER_SUBDIR]), nil
+	}
 	starter := flags[FLAG_STARTER]
 	if starter == "" {
 		return nil, nil
@@ -114,6 +146,28 @@ func (o *FlagsBackend) SelectStarterProject(devfile parser.DevfileObj, flags map
 	return nil, fmt.Errorf("starter project %q not found in devfile", starter)
 }
 
+// GitStarterProject returns a starter project downloaded from a git repository, at the revision if not empty,
+// and keeping only the sub-directory of the repository if not empty.
+// The starter project is named after the repository
+func GitStarterProject(url string, revision string, subDir string) *v1alpha2.StarterProject {
+	name := path.Base(strings.TrimSuffix(strings.TrimSuffix(url, "/"), ".git"))
+	starter := &v1alpha2.StarterProject{
+		Name:   name,
+		SubDir: filepath.ToSlash(subDir),
+		ProjectSource: v1alpha2.ProjectSource{
+			Git: &v1alpha2.GitProjectSource{
+				GitLikeProjectSource: v1alpha2.GitLikeProjectSource{
+					Remotes: map[string]string{"origin": url},
+				},
+			},
+		},
+	}
+	if revision != "" {
+		starter.Git.CheckoutFrom = &v1alpha2.CheckoutFrom{Revision: revision}
+	}
+	return starter
+}
+
 func (o *FlagsBackend) PersonalizeName(_ parser.DevfileObj, flags map[string]string) (string, error) {
 	if validK8sNameErr := dfutil.ValidateK8sResourceName("name", flags[FLAG_NAME]); validK8sNameErr != nil {
 		return "", validK8sNameErr
@@ -267,6 +267,133 @@ func TestFlagsBackend_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "starter flag with a non empty directory without overwriting",
+			args: args{
+				flags: map[string]string{
+					"name":                 "aname",
+					"devfile":              "adevfile",
+					"starter":              "astarter",
+					"starter-no-overwrite": "true",
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					_ = fs.WriteFile("/tmp/main.go", []byte("package main"), 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: false,
+		},
+		{
+			name: "starter-no-overwrite flag without starter",
+			args: args{
+				flags: map[string]string{
+					"name":                 "aname",
+					"devfile":              "adevfile",
+					"starter-no-overwrite": "true",
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: true,
+		},
+		{
+			name: "starter-git flag with an empty directory",
+			args: args{
+				flags: map[string]string{
+					"name":             "aname",
+					"devfile":          "adevfile",
+					"starter-git":      "https://github.com/example/templates.git",
+					"starter-revision": "v1.0.0",
+					"starter-subdir":   "nodejs",
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: false,
+		},
+		{
+			name: "starter-git flag with a non empty directory",
+			args: args{
+				flags: map[string]string{
+					"name":        "aname",
+					"devfile":     "adevfile",
+					"starter-git": "https://github.com/example/templates.git",
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					_ = fs.WriteFile("/tmp/main.go", []byte("package main"), 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: true,
+		},
+		{
+			name: "starter and starter-git flags",
+			args: args{
+				flags: map[string]string{
+					"name":        "aname",
+					"devfile":     "adevfile",
+					"starter":     "astarter",
+					"starter-git": "https://github.com/example/templates.git",
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: true,
+		},
+		{
+			name: "starter-revision flag without starter-git",
+			args: args{
+				flags: map[string]string{
+					"name":             "aname",
+					"devfile":          "adevfile",
+					"starter":          "astarter",
+					"starter-revision": "v1.0.0",
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: true,
+		},
+		{
+			name: "starter-subdir flag outside of the repository",
+			args: args{
+				flags: map[string]string{
+					"name":           "aname",
+					"devfile":        "adevfile",
+					"starter-git":    "https://github.com/example/templates.git",
+					"starter-subdir": "../other",
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: true,
+		},
 		// TODO: Add test cases.
 	}
 	for _, tt := range tests {
@@ -369,6 +496,33 @@ func TestFlagsBackend_SelectStarterProject(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "starter-git flag defined",
+			args: args{
+				devfile: func() parser.DevfileObj {
+					return parser.DevfileObj{}
+				},
+				flags: map[string]string{
+					"devfile":          "adevfile",
+					"starter-git":      "https://github.com/example/templates.git",
+					"starter-revision": "v1.0.0",
+					"starter-subdir":   "nodejs/express",
+				},
+			},
+			want: &v1alpha2.StarterProject{
+				Name:   "templates",
+				SubDir: "nodejs/express",
+				ProjectSource: v1alpha2.ProjectSource{
+					Git: &v1alpha2.GitProjectSource{
+						GitLikeProjectSource: v1alpha2.GitLikeProjectSource{
+							Remotes:      map[string]string{"origin": "https://github.com/example/templates.git"},
+							CheckoutFrom: &v1alpha2.CheckoutFrom{Revision: "v1.0.0"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		// TODO: Add test cases.
 	}
 	for _, tt := range tests {
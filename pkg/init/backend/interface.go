@@ -1,6 +1,7 @@
 // Package backend provides different backends to initiate projects.
 // - `Flags` backend gets needed information from command line flags.
 // - `Interactive` backend interacts with the user to get needed information.
+// - `Answers` backend gets needed information from an answers file, in the format of the answers given interactively.
 package backend
 
 import (
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/registry"
 	"github.com/redhat-developer/odo/pkg/segment"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/util"
 )
 
 type InitClient struct {
@@ -31,6 +33,7 @@ type InitClient struct {
 	flagsBackend       *backend.FlagsBackend
 	interactiveBackend *backend.InteractiveBackend
 	alizerBackend      *backend.AlizerBackend
+	answersBackend     *backend.AnswersBackend
 
 	// Clients
 	fsys             filesystem.Filesystem
@@ -47,6 +50,7 @@ func NewInitClient(fsys filesystem.Filesystem, preferenceClient preference.Clien
 		flagsBackend:       backend.NewFlagsBackend(preferenceClient),
 		interactiveBackend: backend.NewInteractiveBackend(askerClient, registryClient, alizerClient),
 		alizerBackend:      backend.NewAlizerBackend(askerClient, alizerClient),
+		answersBackend:     backend.NewAnswersBackend(preferenceClient, os.Stdin),
 		fsys:               fsys,
 		preferenceClient:   preferenceClient,
 		registryClient:     registryClient,
@@ -58,18 +62,27 @@ func NewInitClient(fsys filesystem.Filesystem, preferenceClient preference.Clien
 func (o *InitClient) GetFlags(flags map[string]string) map[string]string {
 	initFlags := map[string]string{}
 	for flag, value := range flags {
-		if flag == backend.FLAG_NAME || flag == backend.FLAG_DEVFILE || flag == backend.FLAG_DEVFILE_REGISTRY || flag == backend.FLAG_STARTER || flag == backend.FLAG_DEVFILE_PATH || flag == backend.FLAG_DEVFILE_VERSION {
+		switch flag {
+		case backend.FLAG_NAME, backend.FLAG_DEVFILE, backend.FLAG_DEVFILE_REGISTRY, backend.FLAG_STARTER, backend.FLAG_DEVFILE_PATH, backend.FLAG_DEVFILE_VERSION,
+			backend.FLAG_ANSWERS, backend.FLAG_STARTER_GIT, backend.FLAG_STARTER_REVISION, backend.FLAG_STARTER_SUBDIR, backend.FLAG_STARTER_NO_OVERWRITE:
 			initFlags[flag] = value
 		}
 	}
 	return initFlags
 }
 
+// usesAnswers returns true if the answers are read from an answers file
+func usesAnswers(flags map[string]string) bool {
+	return flags[backend.FLAG_ANSWERS] != ""
+}
+
 // Validate calls Validate method of the adequate backend
 func (o *InitClient) Validate(flags map[string]string, fs filesystem.Filesystem, dir string) error {
 	var backend backend.InitBackend
 	if len(flags) == 0 {
 		backend = o.interactiveBackend
+	} else if usesAnswers(flags) {
+		backend = o.answersBackend
 	} else {
 		backend = o.flagsBackend
 	}
@@ -88,6 +101,8 @@ func (o *InitClient) SelectDevfile(ctx context.Context, flags map[string]string,
 		backend = o.interactiveBackend
 	} else if len(flags) == 0 {
 		backend = o.alizerBackend
+	} else if usesAnswers(flags) {
+		backend = o.answersBackend
 	} else {
 		backend = o.flagsBackend
 	}
@@ -217,15 +232,25 @@ func (o *InitClient) SelectStarterProject(devfile parser.DevfileObj, flags map[s
 		backend = o.interactiveBackend
 	} else if len(flags) == 0 {
 		backend = o.alizerBackend
+	} else if usesAnswers(flags) {
+		backend = o.answersBackend
 	} else {
 		backend = o.flagsBackend
 	}
 	return backend.SelectStarterProject(devfile, flags)
 }
 
-func (o *InitClient) DownloadStarterProject(starter *v1alpha2.StarterProject, dest string) error {
+func (o *InitClient) DownloadStarterProject(starter *v1alpha2.StarterProject, dest string, flags map[string]string) error {
+	if usesAnswers(flags) {
+		flags = o.answersBackend.Flags()
+	}
 	downloadSpinner := log.Spinnerf("Downloading starter project %q", starter.Name)
-	err := o.registryClient.DownloadStarterProject(starter, "", dest, false)
+	var err error
+	if flags[backend.FLAG_STARTER_NO_OVERWRITE] == "true" {
+		err = o.downloadStarterProjectWithoutOverwrite(starter, dest)
+	} else {
+		err = o.registryClient.DownloadStarterProject(starter, "", dest, false)
+	}
 	if err != nil {
 		downloadSpinner.End(false)
 		return err
@@ -234,12 +259,64 @@ func (o *InitClient) DownloadStarterProject(starter *v1alpha2.StarterProject, de
 	return nil
 }
 
+// downloadStarterProjectWithoutOverwrite downloads the starter project in a temporary directory,
+// then copies its files into dest, only if none of the files exists in dest, except the devfile
+func (o *InitClient) downloadStarterProjectWithoutOverwrite(starter *v1alpha2.StarterProject, dest string) error {
+	tmpDir, err := o.fsys.TempDir("", "odo-starter")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = o.fsys.RemoveAll(tmpDir)
+	}()
+
+	err = o.registryClient.DownloadStarterProject(starter, "", tmpDir, false)
+	if err != nil {
+		return err
+	}
+
+	var conflicts []string
+	err = o.fsys.Walk(tmpDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(tmpDir, path)
+		if err != nil || rel == "." {
+			return err
+		}
+		// the starter project can come bundled with its own devfile, replacing the one downloaded
+		if rel == "devfile.yaml" || rel == ".devfile.yaml" {
+			return nil
+		}
+		existing, err := o.fsys.Stat(filepath.Join(dest, rel))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() || !existing.IsDir() {
+			conflicts = append(conflicts, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("the starter project would overwrite these files of the directory: %s", strings.Join(conflicts, ", "))
+	}
+	return util.CopyDirWithFS(tmpDir, dest, o.fsys)
+}
+
 // PersonalizeName calls PersonalizeName methods of the adequate backend
 func (o *InitClient) PersonalizeName(devfile parser.DevfileObj, flags map[string]string) (string, error) {
 	var backend backend.InitBackend
 
 	if len(flags) == 0 {
 		backend = o.interactiveBackend
+	} else if usesAnswers(flags) {
+		backend = o.answersBackend
 	} else {
 		backend = o.flagsBackend
 	}
@@ -257,6 +334,8 @@ func (o *InitClient) HandleApplicationPorts(devfileobj parser.DevfileObj, ports
 	if len(flags) == 0 && !onlyDevfile {
 		// Other files present in the directory; hence alizer is run
 		backend = o.interactiveBackend
+	} else if usesAnswers(flags) {
+		backend = o.answersBackend
 	} else {
 		backend = o.flagsBackend
 	}
@@ -269,6 +348,8 @@ func (o *InitClient) PersonalizeDevfileConfig(devfileobj parser.DevfileObj, flag
 	// Interactive mode since no flags are provided
 	if len(flags) == 0 {
 		backend = o.interactiveBackend
+	} else if usesAnswers(flags) {
+		backend = o.answersBackend
 	} else {
 		backend = o.flagsBackend
 	}
@@ -303,6 +384,42 @@ func (o *InitClient) SelectAndPersonalizeDevfile(ctx context.Context, flags map[
 	return devfileObj, devfilePath, devfileLocation, nil
 }
 
+func (o *InitClient) InitComponent(ctx context.Context, component api.DetectionResult, rootDir string) (parser.DevfileObj, string, error) {
+	dir := filepath.Join(rootDir, filepath.FromSlash(component.Path))
+	containsDevfile, err := location.DirectoryContainsDevfile(o.fsys, dir)
+	if err != nil {
+		return parser.DevfileObj{}, "", err
+	}
+	if containsDevfile {
+		return parser.DevfileObj{}, "", fmt.Errorf("a devfile already exists in the directory %q", component.Path)
+	}
+
+	devfilePath, err := o.DownloadDevfile(ctx, &component, dir)
+	if err != nil {
+		return parser.DevfileObj{}, "", fmt.Errorf("unable to download devfile: %w", err)
+	}
+
+	devfileObj, _, err := devfile.ParseDevfileAndValidate(parser.ParserArgs{Path: devfilePath, FlattenedDevfile: pointer.BoolPtr(false)})
+	if err != nil {
+		_ = o.fsys.Remove(devfilePath)
+		return parser.DevfileObj{}, "", fmt.Errorf("unable to parse devfile: %w", err)
+	}
+
+	// The ports detected are used as with the Alizer backend
+	devfileObj, err = o.interactiveBackend.HandleApplicationPorts(devfileObj, component.ApplicationPorts, nil)
+	if err != nil {
+		_ = o.fsys.Remove(devfilePath)
+		return parser.DevfileObj{}, "", fmt.Errorf("unable to set application ports in devfile: %w", err)
+	}
+
+	// WARNING: SetMetadataName writes the Devfile to disk
+	if err = devfileObj.SetMetadataName(component.Name); err != nil {
+		_ = o.fsys.Remove(devfilePath)
+		return parser.DevfileObj{}, "", err
+	}
+	return devfileObj, devfilePath, nil
+}
+
 func (o *InitClient) InitDevfile(ctx context.Context, flags map[string]string, contextDir string,
 	preInitHandlerFunc func(interactiveMode bool), newDevfileHandlerFunc func(newDevfileObj parser.DevfileObj) error) error {
 
@@ -3,6 +3,7 @@ package init
 import (
 	"context"
 	"errors"
+	"path/filepath"
 	"testing"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -11,6 +12,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/config"
 	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/init/backend"
 	"github.com/redhat-developer/odo/pkg/preference"
 	"github.com/redhat-developer/odo/pkg/registry"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
@@ -315,17 +317,37 @@ func TestInitClient_downloadDirect(t *testing.T) {
 }
 
 func TestInitClient_downloadStarterProject(t *testing.T) {
+	// starterFiles makes the registry client write the files of the starter project in the destination directory
+	starterFiles := func(fsys filesystem.Filesystem, files map[string]string) func(ctrl *gomock.Controller) registry.Client {
+		return func(ctrl *gomock.Controller) registry.Client {
+			client := registry.NewMockClient(ctrl)
+			client.EXPECT().DownloadStarterProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *v1alpha2.StarterProject, _ string, contextDir string, _ bool) error {
+					for name, content := range files {
+						if err := fsys.WriteFile(filepath.Join(contextDir, name), []byte(content), 0644); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+			return client
+		}
+	}
+
 	type fields struct {
 		registryClient func(ctrl *gomock.Controller) registry.Client
 	}
 	type args struct {
 		project v1alpha2.StarterProject
+		flags   map[string]string
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr bool
+		name      string
+		fsys      filesystem.Filesystem
+		fields    fields
+		args      args
+		wantErr   bool
+		wantFiles map[string]string
 	}{
 		{
 			name: "starter project defined",
@@ -343,16 +365,74 @@ func TestInitClient_downloadStarterProject(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "starter project without overwriting files",
+			fsys: func() filesystem.Filesystem {
+				fsys := filesystem.NewFakeFs()
+				_ = fsys.WriteFile("dest/README.md", []byte("readme"), 0644)
+				_ = fsys.WriteFile("dest/devfile.yaml", []byte("devfile"), 0644)
+				return fsys
+			}(),
+			args: args{
+				project: v1alpha2.StarterProject{Name: "project1"},
+				flags:   map[string]string{backend.FLAG_STARTER: "project1", backend.FLAG_STARTER_NO_OVERWRITE: "true"},
+			},
+			wantFiles: map[string]string{
+				"dest/README.md":    "readme",
+				"dest/main.go":      "main",
+				"dest/devfile.yaml": "starter devfile",
+			},
+		},
+		{
+			name: "starter project overwriting files",
+			fsys: func() filesystem.Filesystem {
+				fsys := filesystem.NewFakeFs()
+				_ = fsys.WriteFile("dest/README.md", []byte("readme"), 0644)
+				return fsys
+			}(),
+			args: args{
+				project: v1alpha2.StarterProject{Name: "project1"},
+				flags:   map[string]string{backend.FLAG_STARTER: "project1", backend.FLAG_STARTER_NO_OVERWRITE: "true"},
+			},
+			wantErr: true,
+			wantFiles: map[string]string{
+				"dest/README.md": "readme",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
+			registryClient := tt.fields.registryClient
+			if registryClient == nil {
+				starter := map[string]string{"main.go": "main", "devfile.yaml": "starter devfile"}
+				if tt.wantErr {
+					starter["README.md"] = "starter readme"
+				}
+				registryClient = starterFiles(tt.fsys, starter)
+			}
 			o := &InitClient{
-				registryClient: tt.fields.registryClient(ctrl),
+				fsys:           tt.fsys,
+				registryClient: registryClient(ctrl),
 			}
-			if err := o.DownloadStarterProject(&tt.args.project, "dest"); (err != nil) != tt.wantErr {
+			if err := o.DownloadStarterProject(&tt.args.project, "dest", tt.args.flags); (err != nil) != tt.wantErr {
 				t.Errorf("InitClient.downloadStarterProject() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			for name, want := range tt.wantFiles {
+				got, err := tt.fsys.ReadFile(name)
+				if err != nil {
+					t.Errorf("unable to read %s: %v", name, err)
+					continue
+				}
+				if string(got) != want {
+					t.Errorf("content of %s = %q, want %q", name, string(got), want)
+				}
+			}
+			if tt.wantErr && tt.fsys != nil {
+				if _, err := tt.fsys.Stat("dest/main.go"); err == nil {
+					t.Errorf("no file of the starter project should be copied when a file would be overwritten")
+				}
+			}
 		})
 	}
 }
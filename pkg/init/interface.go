@@ -4,7 +4,8 @@
 // Several backends are available to complete the operations, the backend
 // being chosen depending on the flags content:
 // - if no flags are passed, the `interactive` backend will be used
-// - if some flags are passed, the `flags` backend will be used.
+// - if the `answers` flag is passed, the `answers` backend will be used
+// - if some other flags are passed, the `flags` backend will be used.
 package init
 
 import (
@@ -47,9 +48,10 @@ type Client interface {
 	// depending on the flags. If not starter project is selected, a nil starter is returned
 	SelectStarterProject(devfile parser.DevfileObj, flags map[string]string, fs filesystem.Filesystem, dir string) (*v1alpha2.StarterProject, error)
 
-	// DownloadStarterProject downloads the starter project referenced in devfile and stores it in dest directory
-	// WARNING: This will first remove all the content of dest.
-	DownloadStarterProject(project *v1alpha2.StarterProject, dest string) error
+	// DownloadStarterProject downloads the starter project referenced in devfile and stores it in dest directory.
+	// Depending on the flags, the starter project is not downloaded if any of its files, except the devfile, exists in dest.
+	// Else, dest is expected to be empty or to contain only the devfile.
+	DownloadStarterProject(project *v1alpha2.StarterProject, dest string, flags map[string]string) error
 
 	// PersonalizeName returns the customized Devfile Metadata Name.
 	// Depending on the flags, it may return a name set interactively or not.
@@ -64,4 +66,9 @@ type Client interface {
 
 	// HandleApplicationPorts updates the ports in the Devfile accordingly.
 	HandleApplicationPorts(devfileobj parser.DevfileObj, ports []int, flags map[string]string, fs filesystem.Filesystem, dir string) (parser.DevfileObj, error)
+
+	// InitComponent creates the Devfile of a component detected in a sub-directory of rootDir,
+	// using the devfile, name and ports detected for the component.
+	// Returns the devfile object and its path
+	InitComponent(ctx context.Context, component api.DetectionResult, rootDir string) (parser.DevfileObj, string, error)
 }
@@ -54,17 +54,17 @@ func (mr *MockClientMockRecorder) DownloadDevfile(ctx, devfileLocation, destDir
 }
 
 // DownloadStarterProject mocks base method.
-func (m *MockClient) DownloadStarterProject(project *v1alpha2.StarterProject, dest string) error {
+func (m *MockClient) DownloadStarterProject(project *v1alpha2.StarterProject, dest string, flags map[string]string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DownloadStarterProject", project, dest)
+	ret := m.ctrl.Call(m, "DownloadStarterProject", project, dest, flags)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DownloadStarterProject indicates an expected call of DownloadStarterProject.
-func (mr *MockClientMockRecorder) DownloadStarterProject(project, dest interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DownloadStarterProject(project, dest, flags interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadStarterProject", reflect.TypeOf((*MockClient)(nil).DownloadStarterProject), project, dest)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadStarterProject", reflect.TypeOf((*MockClient)(nil).DownloadStarterProject), project, dest, flags)
 }
 
 // GetFlags mocks base method.
@@ -96,6 +96,22 @@ func (mr *MockClientMockRecorder) HandleApplicationPorts(devfileobj, ports, flag
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleApplicationPorts", reflect.TypeOf((*MockClient)(nil).HandleApplicationPorts), devfileobj, ports, flags, fs, dir)
 }
 
+// InitComponent mocks base method.
+func (m *MockClient) InitComponent(ctx context.Context, component api.DetectionResult, rootDir string) (parser.DevfileObj, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitComponent", ctx, component, rootDir)
+	ret0, _ := ret[0].(parser.DevfileObj)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InitComponent indicates an expected call of InitComponent.
+func (mr *MockClientMockRecorder) InitComponent(ctx, component, rootDir interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitComponent", reflect.TypeOf((*MockClient)(nil).InitComponent), ctx, component, rootDir)
+}
+
 // InitDevfile mocks base method.
 func (m *MockClient) InitDevfile(ctx context.Context, flags map[string]string, contextDir string, preInitHandlerFunc func(bool), newDevfileHandlerFunc func(parser.DevfileObj) error) error {
 	m.ctrl.T.Helper()
@@ -8,6 +8,7 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -24,10 +25,21 @@ func (c *Client) GetAllResourcesFromSelector(selector string, ns string) ([]unst
 	if err != nil {
 		return nil, err
 	}
-	return getAllResources(c.DynamicClient, apis.list, ns, selector)
+	return getAllResources(c.DynamicClient, apis.list, ns, selector, false)
 }
 
-func getAllResources(client dynamic.Interface, apis []apiResource, ns string, selector string) ([]unstructured.Unstructured, error) {
+// GetAllResourcesFromSelectorInAllNamespaces returns all resources of any kind (including CRs) matching the given label selector
+// in all the namespaces. Unlike GetAllResourcesFromSelector, a Forbidden error is returned if the user is not allowed
+// to list the resources of one of the kinds in all the namespaces, as the result would be partial
+func (c *Client) GetAllResourcesFromSelectorInAllNamespaces(selector string) ([]unstructured.Unstructured, error) {
+	apis, err := findAPIs(c.cachedDiscoveryClient)
+	if err != nil {
+		return nil, err
+	}
+	return getAllResources(c.DynamicClient, apis.list, metav1.NamespaceAll, selector, true)
+}
+
+func getAllResources(client dynamic.Interface, apis []apiResource, ns string, selector string, failOnForbidden bool) ([]unstructured.Unstructured, error) {
 	var out []unstructured.Unstructured
 	outChan := make(chan []unstructured.Unstructured)
 
@@ -48,7 +60,7 @@ func getAllResources(client dynamic.Interface, apis []apiResource, ns string, se
 		api := api // shadowing because go vet complains "loop variable api captured by func literal"
 		group.Go(func() error {
 			klog.V(5).Infof("[query api] start: %s", api.GroupVersionResource())
-			v, err := queryAPI(client, api, ns, selector)
+			v, err := queryAPI(client, api, ns, selector, failOnForbidden)
 			if err != nil {
 				klog.V(5).Infof("[query api] error querying: %s, error=%v", api.GroupVersionResource(), err)
 				return err
@@ -76,7 +88,9 @@ func getAllResources(client dynamic.Interface, apis []apiResource, ns string, se
 	return out, <-errChan
 }
 
-func queryAPI(client dynamic.Interface, api apiResource, ns string, selector string) ([]unstructured.Unstructured, error) {
+// queryAPI returns the resources of the api matching the selector. The errors are ignored,
+// except the Forbidden errors if failOnForbidden is true
+func queryAPI(client dynamic.Interface, api apiResource, ns string, selector string, failOnForbidden bool) ([]unstructured.Unstructured, error) {
 	var out []unstructured.Unstructured
 
 	var next string
@@ -91,6 +105,9 @@ func queryAPI(client dynamic.Interface, api apiResource, ns string, selector str
 		})
 		if err != nil {
 			klog.V(5).Infof("listing resources failed (%s): %v", api.GroupVersionResource(), err)
+			if failOnForbidden && kerrors.IsForbidden(err) {
+				return nil, fmt.Errorf("unable to list %s: %w", api.GroupVersionResource(), err)
+			}
 			return nil, nil
 		}
 		out = append(out, resp.Items...)
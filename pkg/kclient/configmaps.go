@@ -0,0 +1,18 @@
+package kclient
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetConfigMap returns the ConfigMap with the given name in the given namespace
+func (c *Client) GetConfigMap(name, namespace string) (*corev1.ConfigMap, error) {
+	configMap, err := c.KubeClient.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the ConfigMap %s: %w", name, err)
+	}
+	return configMap, nil
+}
@@ -194,8 +194,13 @@ func (c *Client) IsDeploymentExtensionsV1Beta1() (bool, error) {
 // DeploymentWatcher returns a watcher on Deployments into the current namespace
 // with the given label selector
 func (c *Client) DeploymentWatcher(ctx context.Context, selector string) (watch.Interface, error) {
-	ns := c.GetCurrentNamespace()
-	return c.GetClient().AppsV1().Deployments(ns).
+	return c.DeploymentWatcherInNamespace(ctx, c.GetCurrentNamespace(), selector)
+}
+
+// DeploymentWatcherInNamespace returns a watcher on Deployments into the given namespace,
+// or into all namespaces if namespace is empty, with the given label selector
+func (c *Client) DeploymentWatcherInNamespace(ctx context.Context, namespace string, selector string) (watch.Interface, error) {
+	return c.GetClient().AppsV1().Deployments(namespace).
 		Watch(ctx, metav1.ListOptions{
 			LabelSelector: selector,
 		})
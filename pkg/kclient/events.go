@@ -3,6 +3,7 @@ package kclient
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/watch"
@@ -34,3 +35,15 @@ func (c *Client) PodWarningEventWatcher(ctx context.Context) (result watch.Inter
 	}
 	return result, false, nil
 }
+
+// ListWarningEvents returns the events of type Warning in the current namespace
+func (c *Client) ListWarningEvents() ([]corev1.Event, error) {
+	list, err := c.GetClient().CoreV1().Events(c.GetCurrentNamespace()).
+		List(context.TODO(), metav1.ListOptions{
+			FieldSelector: "type=Warning",
+		})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
@@ -26,6 +26,7 @@ import (
 	specApi "github.com/redhat-developer/service-binding-operator/apis/spec/v1alpha3"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/platform"
 )
 
 type ClientInterface interface {
@@ -34,6 +35,9 @@ type ClientInterface interface {
 
 	// GetAllResourcesFromSelector returns all resources of any kind (including CRs) matching the given label selector
 	GetAllResourcesFromSelector(selector string, ns string) ([]unstructured.Unstructured, error)
+	// GetAllResourcesFromSelectorInAllNamespaces returns all resources of any kind (including CRs) matching the given label selector
+	// in all the namespaces, or a Forbidden error if the user is not allowed to list them
+	GetAllResourcesFromSelectorInAllNamespaces(selector string) ([]unstructured.Unstructured, error)
 
 	// binding.go
 	IsServiceBindingSupported() (bool, error)
@@ -47,6 +51,9 @@ type ClientInterface interface {
 	APIServiceBindingFromSpec(spec specApi.ServiceBinding) api.ServiceBinding
 	GetWorkloadKinds() ([]string, []schema.GroupVersionKind, error)
 
+	// configmaps.go
+	GetConfigMap(name, namespace string) (*corev1.ConfigMap, error)
+
 	// deployment.go
 	GetDeploymentByName(name string) (*appsv1.Deployment, error)
 	GetOneDeployment(componentName, appName string, isPartOfComponent bool) (*appsv1.Deployment, error)
@@ -58,6 +65,7 @@ type ClientInterface interface {
 	GetDeploymentAPIVersion() (schema.GroupVersionKind, error)
 	IsDeploymentExtensionsV1Beta1() (bool, error)
 	DeploymentWatcher(ctx context.Context, selector string) (watch.Interface, error)
+	DeploymentWatcherInNamespace(ctx context.Context, namespace string, selector string) (watch.Interface, error)
 
 	// dynamic.go
 	PatchDynamicResource(exampleCustomResource unstructured.Unstructured) (bool, error)
@@ -68,6 +76,7 @@ type ClientInterface interface {
 
 	// events.go
 	PodWarningEventWatcher(ctx context.Context) (result watch.Interface, isForbidden bool, err error)
+	ListWarningEvents() ([]corev1.Event, error)
 
 	// kclient.go
 	GetClient() kubernetes.Interface
@@ -80,6 +89,10 @@ type ClientInterface interface {
 	IsSSASupported() bool
 	Refresh() (newConfig bool, err error)
 
+	// metrics.go
+	IsPodMetricsSupported() (bool, error)
+	GetPodMetrics(podName string) (map[string]corev1.ResourceList, error)
+
 	// namespace.go
 	GetCurrentNamespace() string
 	SetNamespace(ns string)
@@ -113,9 +126,11 @@ type ClientInterface interface {
 	ExecCMDInContainer(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error
 	GetPodUsingComponentName(componentName string) (*corev1.Pod, error)
 	GetRunningPodFromSelector(selector string) (*corev1.Pod, error)
-	GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error)
+	GetPodLogs(podName, containerName string, options platform.LogOptions) (io.ReadCloser, error)
 	GetAllPodsInNamespaceMatchingSelector(selector string, ns string) (*corev1.PodList, error)
 	GetPodsMatchingSelector(selector string) (*corev1.PodList, error)
+	// GetPodsOwnedByResources returns the pods being one of the resources or owned by one of the resources, with the resource matched
+	GetPodsOwnedByResources(resources []unstructured.Unstructured) ([]OwnedPod, error)
 	PodWatcher(ctx context.Context, selector string) (watch.Interface, error)
 	IsPodNameMatchingSelector(ctx context.Context, podname string, selector string) (bool, error)
 
@@ -124,6 +139,9 @@ type ClientInterface interface {
 	// ["<localhost-port>":"<remote-pod-port>"] format. errOut is used by the client-go library to output any errors
 	// encountered while the port-forwarding is running
 	SetupPortForwarding(pod *corev1.Pod, portPairs []string, out io.Writer, errOut io.Writer, stopChan chan struct{}) error
+	// SetupPortForwardingOnAddresses creates port-forwarding for the pod as SetupPortForwarding does,
+	// listening on the local addresses provided instead of localhost
+	SetupPortForwardingOnAddresses(pod *corev1.Pod, addresses []string, portPairs []string, out io.Writer, errOut io.Writer, stopChan chan struct{}) error
 
 	// projects.go
 	CreateNewProject(projectName string, wait bool) error
@@ -150,6 +168,9 @@ type ClientInterface interface {
 	DeleteService(serviceName string) error
 	GetOneService(componentName, appName string, isPartOfComponent bool) (*corev1.Service, error)
 	GetOneServiceFromSelector(selector string) (*corev1.Service, error)
+	GetService(name string) (*corev1.Service, error)
+	// ServiceWatcher returns a watcher on the changes of the Services matching the selector, from their current state
+	ServiceWatcher(ctx context.Context, selector string) (watch.Interface, error)
 
 	// user.go
 	RunLogout(stdout io.Writer) error
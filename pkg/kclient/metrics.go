@@ -0,0 +1,68 @@
+package kclient
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PodMetricsGVR is the resource exposing the resources usage of the pods, served by the metrics server
+var PodMetricsGVR = schema.GroupVersionResource{
+	Group:    "metrics.k8s.io",
+	Version:  "v1beta1",
+	Resource: "pods",
+}
+
+// IsPodMetricsSupported checks if the metrics API serving the resources usage of the pods is available in the cluster
+func (c *Client) IsPodMetricsSupported() (bool, error) {
+	return c.IsResourceSupported(PodMetricsGVR.Group, PodMetricsGVR.Version, PodMetricsGVR.Resource)
+}
+
+// GetPodMetrics returns the current resources usage of the containers of the pod podName in the current namespace,
+// indexed by container name
+func (c *Client) GetPodMetrics(podName string) (map[string]corev1.ResourceList, error) {
+	u, err := c.GetDynamicClient().Resource(PodMetricsGVR).Namespace(c.GetCurrentNamespace()).
+		Get(context.TODO(), podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return getContainersUsage(u)
+}
+
+// getContainersUsage extracts the usage of the containers from an unstructured PodMetrics resource
+func getContainersUsage(u *unstructured.Unstructured) (map[string]corev1.ResourceList, error) {
+	containers, _, err := unstructured.NestedSlice(u.Object, "containers")
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]corev1.ResourceList, len(containers))
+	for _, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, err := unstructured.NestedString(container, "name")
+		if err != nil {
+			return nil, err
+		}
+		usage, _, err := unstructured.NestedStringMap(container, "usage")
+		if err != nil {
+			return nil, err
+		}
+		resources := corev1.ResourceList{}
+		for k, v := range usage {
+			q, err := resource.ParseQuantity(v)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse %s usage of container %q: %w", k, name, err)
+			}
+			resources[corev1.ResourceName(k)] = q
+		}
+		result[name] = resources
+	}
+	return result, nil
+}
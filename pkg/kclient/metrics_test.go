@@ -0,0 +1,84 @@
+package kclient
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_getContainersUsage(t *testing.T) {
+	tests := []struct {
+		name    string
+		object  map[string]interface{}
+		want    map[string]corev1.ResourceList
+		wantErr bool
+	}{
+		{
+			name:   "no containers",
+			object: map[string]interface{}{},
+			want:   map[string]corev1.ResourceList{},
+		},
+		{
+			name: "usage of containers",
+			object: map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name": "runtime",
+						"usage": map[string]interface{}{
+							"cpu":    "12345678n",
+							"memory": "65536Ki",
+						},
+					},
+					map[string]interface{}{
+						"name": "sidecar",
+						"usage": map[string]interface{}{
+							"cpu":    "0",
+							"memory": "1Mi",
+						},
+					},
+				},
+			},
+			want: map[string]corev1.ResourceList{
+				"runtime": {
+					corev1.ResourceCPU:    resource.MustParse("12345678n"),
+					corev1.ResourceMemory: resource.MustParse("65536Ki"),
+				},
+				"sidecar": {
+					corev1.ResourceCPU:    resource.MustParse("0"),
+					corev1.ResourceMemory: resource.MustParse("1Mi"),
+				},
+			},
+		},
+		{
+			name: "invalid quantity",
+			object: map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name": "runtime",
+						"usage": map[string]interface{}{
+							"cpu": "a lot",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getContainersUsage(&unstructured.Unstructured{Object: tt.object})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getContainersUsage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.Comparer(func(a, b resource.Quantity) bool {
+				return a.Cmp(b) == 0
+			})); diff != "" {
+				t.Errorf("getContainersUsage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	v1 "github.com/openshift/api/project/v1"
 	v1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	api "github.com/redhat-developer/odo/pkg/api"
+	platform "github.com/redhat-developer/odo/pkg/platform"
 	v1alpha10 "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
 	v1alpha3 "github.com/redhat-developer/service-binding-operator/apis/spec/v1alpha3"
 	v10 "k8s.io/api/apps/v1"
@@ -315,6 +316,21 @@ func (mr *MockClientInterfaceMockRecorder) DeploymentWatcher(ctx, selector inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeploymentWatcher", reflect.TypeOf((*MockClientInterface)(nil).DeploymentWatcher), ctx, selector)
 }
 
+// DeploymentWatcherInNamespace mocks base method.
+func (m *MockClientInterface) DeploymentWatcherInNamespace(ctx context.Context, namespace, selector string) (watch.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeploymentWatcherInNamespace", ctx, namespace, selector)
+	ret0, _ := ret[0].(watch.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeploymentWatcherInNamespace indicates an expected call of DeploymentWatcherInNamespace.
+func (mr *MockClientInterfaceMockRecorder) DeploymentWatcherInNamespace(ctx, namespace, selector interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeploymentWatcherInNamespace", reflect.TypeOf((*MockClientInterface)(nil).DeploymentWatcherInNamespace), ctx, namespace, selector)
+}
+
 // ExecCMDInContainer mocks base method.
 func (m *MockClientInterface) ExecCMDInContainer(containerName, podName string, cmd []string, stdout, stderr io.Writer, stdin io.Reader, tty bool) error {
 	m.ctrl.T.Helper()
@@ -373,6 +389,21 @@ func (mr *MockClientInterfaceMockRecorder) GetAllResourcesFromSelector(selector,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllResourcesFromSelector", reflect.TypeOf((*MockClientInterface)(nil).GetAllResourcesFromSelector), selector, ns)
 }
 
+// GetAllResourcesFromSelectorInAllNamespaces mocks base method.
+func (m *MockClientInterface) GetAllResourcesFromSelectorInAllNamespaces(selector string) ([]unstructured.Unstructured, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllResourcesFromSelectorInAllNamespaces", selector)
+	ret0, _ := ret[0].([]unstructured.Unstructured)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllResourcesFromSelectorInAllNamespaces indicates an expected call of GetAllResourcesFromSelectorInAllNamespaces.
+func (mr *MockClientInterfaceMockRecorder) GetAllResourcesFromSelectorInAllNamespaces(selector interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllResourcesFromSelectorInAllNamespaces", reflect.TypeOf((*MockClientInterface)(nil).GetAllResourcesFromSelectorInAllNamespaces), selector)
+}
+
 // GetBindableKindStatusRestMapping mocks base method.
 func (m *MockClientInterface) GetBindableKindStatusRestMapping(bindableKindStatuses []v1alpha10.BindableKindsStatus) ([]*meta.RESTMapping, error) {
 	m.ctrl.T.Helper()
@@ -475,6 +506,21 @@ func (mr *MockClientInterfaceMockRecorder) GetConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockClientInterface)(nil).GetConfig))
 }
 
+// GetConfigMap mocks base method.
+func (m *MockClientInterface) GetConfigMap(name, namespace string) (*v11.ConfigMap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigMap", name, namespace)
+	ret0, _ := ret[0].(*v11.ConfigMap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfigMap indicates an expected call of GetConfigMap.
+func (mr *MockClientInterfaceMockRecorder) GetConfigMap(name, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigMap", reflect.TypeOf((*MockClientInterface)(nil).GetConfigMap), name, namespace)
+}
+
 // GetCurrentNamespace mocks base method.
 func (m *MockClientInterface) GetCurrentNamespace() string {
 	m.ctrl.T.Helper()
@@ -757,18 +803,33 @@ func (mr *MockClientInterfaceMockRecorder) GetPVCFromName(pvcName interface{}) *
 }
 
 // GetPodLogs mocks base method.
-func (m *MockClientInterface) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
+func (m *MockClientInterface) GetPodLogs(podName, containerName string, options platform.LogOptions) (io.ReadCloser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPodLogs", podName, containerName, followLog)
+	ret := m.ctrl.Call(m, "GetPodLogs", podName, containerName, options)
 	ret0, _ := ret[0].(io.ReadCloser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPodLogs indicates an expected call of GetPodLogs.
-func (mr *MockClientInterfaceMockRecorder) GetPodLogs(podName, containerName, followLog interface{}) *gomock.Call {
+func (mr *MockClientInterfaceMockRecorder) GetPodLogs(podName, containerName, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodLogs", reflect.TypeOf((*MockClientInterface)(nil).GetPodLogs), podName, containerName, followLog)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodLogs", reflect.TypeOf((*MockClientInterface)(nil).GetPodLogs), podName, containerName, options)
+}
+
+// GetPodMetrics mocks base method.
+func (m *MockClientInterface) GetPodMetrics(podName string) (map[string]v11.ResourceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPodMetrics", podName)
+	ret0, _ := ret[0].(map[string]v11.ResourceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPodMetrics indicates an expected call of GetPodMetrics.
+func (mr *MockClientInterfaceMockRecorder) GetPodMetrics(podName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodMetrics", reflect.TypeOf((*MockClientInterface)(nil).GetPodMetrics), podName)
 }
 
 // GetPodUsingComponentName mocks base method.
@@ -801,6 +862,21 @@ func (mr *MockClientInterfaceMockRecorder) GetPodsMatchingSelector(selector inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodsMatchingSelector", reflect.TypeOf((*MockClientInterface)(nil).GetPodsMatchingSelector), selector)
 }
 
+// GetPodsOwnedByResources mocks base method.
+func (m *MockClientInterface) GetPodsOwnedByResources(resources []unstructured.Unstructured) ([]OwnedPod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPodsOwnedByResources", resources)
+	ret0, _ := ret[0].([]OwnedPod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPodsOwnedByResources indicates an expected call of GetPodsOwnedByResources.
+func (mr *MockClientInterfaceMockRecorder) GetPodsOwnedByResources(resources interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodsOwnedByResources", reflect.TypeOf((*MockClientInterface)(nil).GetPodsOwnedByResources), resources)
+}
+
 // GetProject mocks base method.
 func (m *MockClientInterface) GetProject(projectName string) (*v1.Project, error) {
 	m.ctrl.T.Helper()
@@ -906,6 +982,21 @@ func (mr *MockClientInterfaceMockRecorder) GetServerVersion(timeout interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerVersion", reflect.TypeOf((*MockClientInterface)(nil).GetServerVersion), timeout)
 }
 
+// GetService mocks base method.
+func (m *MockClientInterface) GetService(name string) (*v11.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetService", name)
+	ret0, _ := ret[0].(*v11.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetService indicates an expected call of GetService.
+func (mr *MockClientInterfaceMockRecorder) GetService(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetService", reflect.TypeOf((*MockClientInterface)(nil).GetService), name)
+}
+
 // GetSpecServiceBinding mocks base method.
 func (m *MockClientInterface) GetSpecServiceBinding(name string) (v1alpha3.ServiceBinding, error) {
 	m.ctrl.T.Helper()
@@ -967,6 +1058,21 @@ func (mr *MockClientInterfaceMockRecorder) IsDeploymentExtensionsV1Beta1() *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDeploymentExtensionsV1Beta1", reflect.TypeOf((*MockClientInterface)(nil).IsDeploymentExtensionsV1Beta1))
 }
 
+// IsPodMetricsSupported mocks base method.
+func (m *MockClientInterface) IsPodMetricsSupported() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPodMetricsSupported")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPodMetricsSupported indicates an expected call of IsPodMetricsSupported.
+func (mr *MockClientInterfaceMockRecorder) IsPodMetricsSupported() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPodMetricsSupported", reflect.TypeOf((*MockClientInterface)(nil).IsPodMetricsSupported))
+}
+
 // IsPodNameMatchingSelector mocks base method.
 func (m *MockClientInterface) IsPodNameMatchingSelector(ctx context.Context, podname, selector string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -1177,6 +1283,21 @@ func (mr *MockClientInterfaceMockRecorder) ListServices(selector interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockClientInterface)(nil).ListServices), selector)
 }
 
+// ListWarningEvents mocks base method.
+func (m *MockClientInterface) ListWarningEvents() ([]v11.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWarningEvents")
+	ret0, _ := ret[0].([]v11.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWarningEvents indicates an expected call of ListWarningEvents.
+func (mr *MockClientInterfaceMockRecorder) ListWarningEvents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWarningEvents", reflect.TypeOf((*MockClientInterface)(nil).ListWarningEvents))
+}
+
 // NewServiceBindingServiceObject mocks base method.
 func (m *MockClientInterface) NewServiceBindingServiceObject(serviceNs string, unstructuredService unstructured.Unstructured, bindingName string) (v1alpha10.Service, error) {
 	m.ctrl.T.Helper()
@@ -1267,6 +1388,21 @@ func (mr *MockClientInterfaceMockRecorder) RunLogout(stdout interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunLogout", reflect.TypeOf((*MockClientInterface)(nil).RunLogout), stdout)
 }
 
+// ServiceWatcher mocks base method.
+func (m *MockClientInterface) ServiceWatcher(ctx context.Context, selector string) (watch.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceWatcher", ctx, selector)
+	ret0, _ := ret[0].(watch.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceWatcher indicates an expected call of ServiceWatcher.
+func (mr *MockClientInterfaceMockRecorder) ServiceWatcher(ctx, selector interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceWatcher", reflect.TypeOf((*MockClientInterface)(nil).ServiceWatcher), ctx, selector)
+}
+
 // SetCurrentNamespace mocks base method.
 func (m *MockClientInterface) SetCurrentNamespace(namespace string) error {
 	m.ctrl.T.Helper()
@@ -1319,6 +1455,20 @@ func (mr *MockClientInterfaceMockRecorder) SetupPortForwarding(pod, portPairs, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupPortForwarding", reflect.TypeOf((*MockClientInterface)(nil).SetupPortForwarding), pod, portPairs, out, errOut, stopChan)
 }
 
+// SetupPortForwardingOnAddresses mocks base method.
+func (m *MockClientInterface) SetupPortForwardingOnAddresses(pod *v11.Pod, addresses, portPairs []string, out, errOut io.Writer, stopChan chan struct{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetupPortForwardingOnAddresses", pod, addresses, portPairs, out, errOut, stopChan)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetupPortForwardingOnAddresses indicates an expected call of SetupPortForwardingOnAddresses.
+func (mr *MockClientInterfaceMockRecorder) SetupPortForwardingOnAddresses(pod, addresses, portPairs, out, errOut, stopChan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupPortForwardingOnAddresses", reflect.TypeOf((*MockClientInterface)(nil).SetupPortForwardingOnAddresses), pod, addresses, portPairs, out, errOut, stopChan)
+}
+
 // TryWithBlockOwnerDeletion mocks base method.
 func (m *MockClientInterface) TryWithBlockOwnerDeletion(ownerReference v13.OwnerReference, exec func(v13.OwnerReference) error) error {
 	m.ctrl.T.Helper()
@@ -124,8 +124,8 @@ loopDefinitions:
 	return nil, nil
 }
 
-// toOpenAPISpec transforms Spec descriptors from a CRD description to an OpenAPI schema
-func toOpenAPISpec(repr *olm.CRDDescription) *spec.Schema {
+// ToOpenAPISpec transforms Spec descriptors from a CRD description to an OpenAPI schema
+func ToOpenAPISpec(repr *olm.CRDDescription) *spec.Schema {
 	if len(repr.SpecDescriptors) == 0 {
 		return nil
 	}
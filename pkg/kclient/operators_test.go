@@ -204,9 +204,9 @@ func TestToOpenAPISpec(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := toOpenAPISpec(&tt.repr)
+			result := ToOpenAPISpec(&tt.repr)
 			if diff := cmp.Diff(tt.want, *result, cmp.AllowUnexported(jsonreference.Ref{}, jsonpointer.Pointer{})); diff != "" {
-				t.Errorf("toOpenAPISpec mismatch (-want +got):\n%s", diff)
+				t.Errorf("ToOpenAPISpec mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math"
 
 	// api resource types
 
@@ -11,9 +12,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/remotecommand"
+
+	"github.com/redhat-developer/odo/pkg/platform"
 )
 
 // ExecCMDInContainer execute command in the container of a pod, pass an empty string for containerName to execute in the first container of the pod
@@ -95,19 +99,20 @@ func (c *Client) GetRunningPodFromSelector(selector string) (*corev1.Pod, error)
 	return &pods.Items[0], nil
 }
 
-// GetPodLogs prints the log from pod to stdout
-func (c *Client) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
-
-	// Set standard log options
-	podLogOptions := corev1.PodLogOptions{Follow: false, Container: containerName}
-
-	// If the log is being followed, set it to follow / don't wait
-	if followLog {
-		podLogOptions = corev1.PodLogOptions{
-			Follow:    true,
-			Previous:  false,
-			Container: containerName,
-		}
+// GetPodLogs returns the logs of the container containerName of the pod podName, filtered with the options
+func (c *Client) GetPodLogs(podName, containerName string, options platform.LogOptions) (io.ReadCloser, error) {
+	podLogOptions := corev1.PodLogOptions{
+		Container:  containerName,
+		Follow:     options.Follow,
+		Previous:   options.Previous,
+		Timestamps: options.Timestamps,
+		TailLines:  options.TailLines,
+		LimitBytes: options.LimitBytes,
+	}
+	if options.Since > 0 {
+		// the API only accepts a number of seconds, the duration is rounded up to not miss any line
+		sinceSeconds := int64(math.Ceil(options.Since.Seconds()))
+		podLogOptions.SinceSeconds = &sinceSeconds
 	}
 
 	// RESTClient call to kubernetes
@@ -152,30 +157,82 @@ func (c *Client) GetAllPodsInNamespaceMatchingSelector(selector string, ns strin
 	return &list, err
 }
 
+// OwnedPod is a pod, along with the resource it is or it is owned by
+type OwnedPod struct {
+	Pod   corev1.Pod
+	Owner unstructured.Unstructured
+}
+
+// GetPodsOwnedByResources returns the pods of the current namespace being one of the resources or owned, directly or not,
+// by one of the resources, along with the resource matched for each pod
+func (c *Client) GetPodsOwnedByResources(resources []unstructured.Unstructured) ([]OwnedPod, error) {
+	podList, err := c.KubeClient.CoreV1().Pods(c.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []OwnedPod
+	for _, pod := range podList.Items {
+		if resource := findResourceWithUID(pod.GetUID(), resources); resource != nil {
+			result = append(result, OwnedPod{Pod: pod, Owner: *resource})
+			continue
+		}
+		for _, owner := range pod.GetOwnerReferences() {
+			resource, err := findOwnerInResources(c, owner, resources)
+			if err != nil {
+				return nil, err
+			}
+			if resource != nil {
+				result = append(result, OwnedPod{Pod: pod, Owner: *resource})
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
 // matchOwnerReferenceWithResources recursively checks if the owner reference passed to it matches any of the resources
 // This is useful when trying to find if a pod is owned by any of the ReplicaSet or Deployment in the cluster.
 func matchOwnerReferenceWithResources(c ClientInterface, owner metav1.OwnerReference, resources []unstructured.Unstructured) (bool, error) {
+	resource, err := findOwnerInResources(c, owner, resources)
+	return resource != nil, err
+}
+
+// findOwnerInResources recursively searches the resource matching the owner reference, or one of the owners of the resource it references,
+// in the resources. It returns nil if not found
+func findOwnerInResources(c ClientInterface, owner metav1.OwnerReference, resources []unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	// first, check if ownerReference belongs to any of the resources
-	for _, resource := range resources {
-		if resource.GetUID() != "" && owner.UID != "" && resource.GetUID() == owner.UID {
-			return true, nil
-		}
+	if resource := findResourceWithUID(owner.UID, resources); resource != nil {
+		return resource, nil
 	}
 	// second, get the resource indicated by ownerReference and check its ownerReferences field
 	restMapping, err := c.GetRestMappingFromGVK(schema.FromAPIVersionAndKind(owner.APIVersion, owner.Kind))
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	resource, err := c.GetDynamicResource(restMapping.Resource, owner.Name)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	ownerReferences := resource.GetOwnerReferences()
 	// recursively check if ownerReference matches any of the resources' UID
 	for _, ownerReference := range ownerReferences {
-		return matchOwnerReferenceWithResources(c, ownerReference, resources)
+		return findOwnerInResources(c, ownerReference, resources)
 	}
-	return false, nil
+	return nil, nil
+}
+
+// findResourceWithUID returns the resource with the given UID, or nil if not found
+func findResourceWithUID(uid types.UID, resources []unstructured.Unstructured) *unstructured.Unstructured {
+	if uid == "" {
+		return nil
+	}
+	for i := range resources {
+		if resources[i].GetUID() == uid {
+			return &resources[i]
+		}
+	}
+	return nil
 }
 
 func (c *Client) GetPodsMatchingSelector(selector string) (*corev1.PodList, error) {
@@ -156,6 +156,34 @@ func TestGetPodUsingComponentName(t *testing.T) {
 	}
 }
 
+func TestGetPodsOwnedByResources(t *testing.T) {
+	devPod := fakePod("dev")
+	deployment := fakeDeployment("deploy")
+	labeledPod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: devPod.GetName(), UID: devPod.GetUID()}}
+	ownedPod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:            "pod-deploy",
+		OwnerReferences: []metav1.OwnerReference{generateOwnerReference(deployment)},
+	}}
+	otherPod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-other", UID: "pod-other"}}
+
+	fkclient, fkclientset := FakeNew()
+	fkclientset.Kubernetes.PrependReactor("list", "pods", func(action ktesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &corev1.PodList{Items: []corev1.Pod{labeledPod, ownedPod, otherPod}}, nil
+	})
+
+	got, err := fkclient.GetPodsOwnedByResources([]unstructured.Unstructured{devPod, deployment})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []OwnedPod{
+		{Pod: labeledPod, Owner: devPod},
+		{Pod: ownedPod, Owner: deployment},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Client.GetPodsOwnedByResources() mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func generateOwnerReference(object unstructured.Unstructured) metav1.OwnerReference {
 	return metav1.OwnerReference{
 		APIVersion: object.GetAPIVersion(),
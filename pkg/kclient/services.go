@@ -6,6 +6,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
 )
@@ -74,3 +75,28 @@ func (c *Client) GetOneServiceFromSelector(selector string) (*corev1.Service, er
 
 	return &services[0], nil
 }
+
+// GetService returns the service with the given name in the current namespace
+func (c *Client) GetService(name string) (*corev1.Service, error) {
+	service, err := c.KubeClient.CoreV1().Services(c.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the Service %s: %w", name, err)
+	}
+	return service, nil
+}
+
+// ServiceWatcher returns a watcher on the changes of the Services matching the selector.
+// The watch starts from the current state of the Services, so no ADDED event is sent for the existing Services
+func (c *Client) ServiceWatcher(ctx context.Context, selector string) (watch.Interface, error) {
+	list, err := c.KubeClient.CoreV1().Services(c.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.KubeClient.CoreV1().Services(c.Namespace).
+		Watch(ctx, metav1.ListOptions{
+			LabelSelector:   selector,
+			ResourceVersion: list.GetResourceVersion(),
+		})
+}
@@ -28,6 +28,12 @@ const (
 	// odoProjectTypeAnnotation indicates the project type of the component
 	odoProjectTypeAnnotation = "odo.dev/project-type"
 
+	// odoDeployRevisionOfLabel identifies the component of which a resource stores a deploy revision
+	odoDeployRevisionOfLabel = "odo.dev/deploy-revision-of"
+
+	// odoDeployRevisionLabel indicates the number of the deploy revision stored by a resource
+	odoDeployRevisionLabel = "odo.dev/deploy-revision"
+
 	appLabel = "app"
 
 	componentLabel = "component"
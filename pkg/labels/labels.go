@@ -2,12 +2,15 @@ package labels
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"unicode"
 
 	dfutil "github.com/devfile/library/pkg/util"
 	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/klog"
 
@@ -215,6 +218,56 @@ func GetSelector(componentName string, applicationName string, mode string, isPa
 	return labels.String()
 }
 
+// GetSelectorForComponents returns a selector string used for selection of the resources of any of the given components, in any mode
+func GetSelectorForComponents(componentNames []string, applicationName string) string {
+	selector := k8slabels.SelectorFromSet(getApplicationLabels(applicationName, false))
+	requirement, err := k8slabels.NewRequirement(kubernetesInstanceLabel, selection.In, componentNames)
+	if err != nil {
+		klog.V(4).Infof("unable to select the components %v: %v", componentNames, err)
+		return selector.String()
+	}
+	return selector.Add(*requirement).String()
+}
+
+// GetSelectorForAllComponents returns a selector string used for selection of the resources of any component,
+// or only of the components managed by odo if managedByOdo is true
+func GetSelectorForAllComponents(managedByOdo bool) string {
+	if managedByOdo {
+		return k8slabels.Set{kubernetesManagedByLabel: odoManager}.String()
+	}
+	requirement, err := k8slabels.NewRequirement(kubernetesInstanceLabel, selection.Exists, nil)
+	if err != nil {
+		klog.V(4).Infof("unable to select the components: %v", err)
+		return ""
+	}
+	return k8slabels.NewSelector().Add(*requirement).String()
+}
+
+// GetDeployRevisionLabels returns the labels of the resource storing the deploy revision of the given component.
+// The component name is not set in the instance label, so the resource is not considered as part of the component
+func GetDeployRevisionLabels(componentName string, applicationName string, revision int) map[string]string {
+	labels := getApplicationLabels(applicationName, true)
+	labels[odoDeployRevisionOfLabel] = componentName
+	labels[odoDeployRevisionLabel] = strconv.Itoa(revision)
+	return labels
+}
+
+// GetDeployRevisionSelector returns a selector string used for selection of the resources storing the deploy revisions of the given component
+func GetDeployRevisionSelector(componentName string, applicationName string) string {
+	labels := getApplicationLabels(applicationName, false)
+	labels[odoDeployRevisionOfLabel] = componentName
+	return labels.String()
+}
+
+// GetDeployRevision returns the number of the deploy revision stored by the resource with the given labels
+func GetDeployRevision(labels map[string]string) (int, error) {
+	value, ok := labels[odoDeployRevisionLabel]
+	if !ok {
+		return 0, fmt.Errorf("label %q not found", odoDeployRevisionLabel)
+	}
+	return strconv.Atoi(value)
+}
+
 // IsCoreComponent determines if a resource is core component (created in Dev mode and includes deployment, svc, pv, pvc, etc.)
 // by checking for 'component' label key.
 func IsCoreComponent(labels map[string]string) bool {
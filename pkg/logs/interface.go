@@ -1,6 +1,10 @@
 package logs
 
-import "context"
+import (
+	"context"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+)
 
 type Client interface {
 	// GetLogsForMode gets logs of the containers for the specified mode (Dev, Deploy or both) of the provided
@@ -9,12 +13,15 @@ type Client interface {
 	// have been fetched.
 	// The accepted values for mode are ComponentDevMode, ComponentDeployMode and ComponentAnyMode
 	// found in the pkg/labels package.
-	// Setting follow boolean to true helps follow/tail the logs of the pods.
+	// Only the logs of the containers named containerName are returned, if not empty.
+	// The logs are filtered with the options; setting options.Follow to true helps follow/tail the logs of the pods.
+	// With options.Previous, only the containers which have been restarted are considered.
 	GetLogsForMode(
 		ctx context.Context,
 		mode string,
 		componentName string,
 		namespace string,
-		follow bool,
+		containerName string,
+		options platform.LogOptions,
 	) (Events, error)
 }
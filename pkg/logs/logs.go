@@ -18,7 +18,22 @@ type LogsClient struct {
 
 type ContainerLogs struct {
 	Name string
-	Logs io.ReadCloser
+	// PodName is the name of the pod of the container
+	PodName string
+	// Mode is the mode of the pod, Dev or Deploy
+	Mode string
+	// Status is the status of the container when its logs are requested, nil if not available on the platform
+	Status *corev1.ContainerStatus
+	Logs   io.ReadCloser
+}
+
+// ContainerRestart indicates that a container has been restarted while its logs are followed
+type ContainerRestart struct {
+	Name    string
+	PodName string
+	Mode    string
+	// Status is the status of the container after its restart
+	Status corev1.ContainerStatus
 }
 
 type Events struct {
@@ -28,6 +43,9 @@ type Events struct {
 	Err chan error
 	// channel to indicate that logs for all pods have been grabbed; not to be populated if --follow is used
 	Done chan struct{}
+	// channel to put the restarts of the containers on, when the logs are followed; the logs of the new instance
+	// of a restarted container are read from the same ContainerLogs
+	Restarts chan ContainerRestart
 }
 
 var _ Client = (*LogsClient)(nil)
@@ -45,15 +63,17 @@ func (o *LogsClient) GetLogsForMode(
 	mode string,
 	componentName string,
 	namespace string,
-	follow bool,
+	containerName string,
+	options platform.LogOptions,
 ) (Events, error) {
 	events := Events{
-		Logs: make(chan ContainerLogs),
-		Err:  make(chan error),
-		Done: make(chan struct{}),
+		Logs:     make(chan ContainerLogs),
+		Err:      make(chan error),
+		Done:     make(chan struct{}),
+		Restarts: make(chan ContainerRestart),
 	}
 
-	go o.getLogsForMode(ctx, events, mode, componentName, namespace, follow)
+	go o.getLogsForMode(ctx, events, mode, componentName, namespace, containerName, options)
 	return events, nil
 }
 
@@ -63,24 +83,48 @@ func (o *LogsClient) getLogsForMode(
 	mode string,
 	componentName string,
 	namespace string,
-	follow bool,
+	containerName string,
+	options platform.LogOptions,
 ) {
 	var selector string
 	podChan := make(chan corev1.Pod) // grab the logs of the pod put on this channel
 	errChan := make(chan error)
 	doneChan := make(chan struct{}) // because populating doneChan directly would cause odo logs to exit prematurely.
 
+	appname := odocontext.GetApplication(ctx)
+
 	go func() {
 		// this go routine gets the logs of the pods put on the podChan
 		for {
 			select {
 			case pod := <-podChan:
 				for _, container := range pod.Spec.Containers {
-					containerLogs, err := o.platformClient.GetPodLogs(pod.Name, container.Name, follow)
+					if containerName != "" && container.Name != containerName {
+						continue
+					}
+					if options.Previous && getRestartCount(pod, container.Name) == 0 {
+						// no previous instance of the container to get the logs of
+						continue
+					}
+					containerLogs, err := o.platformClient.GetPodLogs(pod.Name, container.Name, options)
 					if err != nil {
 						events.Err <- fmt.Errorf("failed to get logs for container %s; error: %v", container.Name, err)
+						continue
+					}
+					podMode := odolabels.GetMode(pod.GetLabels())
+					status := getContainerStatus(pod, container.Name)
+					if options.Follow && !options.Previous && status != nil {
+						// the logs of the new instances of the container are followed when it restarts
+						containerLogs = newRestartingReader(ctx, o.platformClient, containerLogs, events.Restarts,
+							odolabels.GetSelector(componentName, appname, podMode, false), pod.Name, container.Name, podMode, status.RestartCount, options)
+					}
+					events.Logs <- ContainerLogs{
+						Name:    container.Name,
+						PodName: pod.Name,
+						Mode:    podMode,
+						Status:  status,
+						Logs:    containerLogs,
 					}
-					events.Logs <- ContainerLogs{container.Name, containerLogs}
 				}
 			case err := <-errChan:
 				events.Err <- err
@@ -90,8 +134,6 @@ func (o *LogsClient) getLogsForMode(
 		}
 	}()
 
-	appname := odocontext.GetApplication(ctx)
-
 	if mode == odolabels.ComponentDevMode || mode == odolabels.ComponentAnyMode {
 		selector = odolabels.GetSelector(componentName, appname, odolabels.ComponentDevMode, false)
 		err := o.getPodsForSelector(selector, namespace, podChan)
@@ -148,3 +190,21 @@ func (o *LogsClient) getPodsForSelector(
 
 	return nil
 }
+
+// getRestartCount returns the number of times the container containerName of the pod has been restarted
+func getRestartCount(pod corev1.Pod, containerName string) int32 {
+	if status := getContainerStatus(pod, containerName); status != nil {
+		return status.RestartCount
+	}
+	return 0
+}
+
+// getContainerStatus returns the status of the container containerName of the pod, or nil if not found
+func getContainerStatus(pod corev1.Pod, containerName string) *corev1.ContainerStatus {
+	for i := range pod.Status.ContainerStatuses {
+		if pod.Status.ContainerStatuses[i].Name == containerName {
+			return &pod.Status.ContainerStatuses[i]
+		}
+	}
+	return nil
+}
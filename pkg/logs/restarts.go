@@ -0,0 +1,166 @@
+package logs
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+// restartPollInterval is the interval at which the pod is checked for a restart of the container,
+// once the logs of the current instance of the container have been read
+var restartPollInterval = 2 * time.Second
+
+// restartingReader reads the followed logs of a container, then the logs of its new instances when it is restarted,
+// until the pod is deleted. Each restart is put on the restarts channel.
+type restartingReader struct {
+	ctx            context.Context
+	platformClient platform.Client
+	restarts       chan<- ContainerRestart
+	selector       string
+	podName        string
+	containerName  string
+	mode           string
+	restartCount   int32
+	options        platform.LogOptions
+
+	mu      sync.Mutex
+	current io.ReadCloser
+	closed  chan struct{}
+}
+
+func newRestartingReader(
+	ctx context.Context,
+	platformClient platform.Client,
+	logs io.ReadCloser,
+	restarts chan<- ContainerRestart,
+	selector string,
+	podName string,
+	containerName string,
+	mode string,
+	restartCount int32,
+	options platform.LogOptions,
+) *restartingReader {
+	return &restartingReader{
+		ctx:            ctx,
+		platformClient: platformClient,
+		restarts:       restarts,
+		selector:       selector,
+		podName:        podName,
+		containerName:  containerName,
+		mode:           mode,
+		restartCount:   restartCount,
+		options:        options,
+		current:        logs,
+		closed:         make(chan struct{}),
+	}
+}
+
+func (o *restartingReader) Read(p []byte) (int, error) {
+	for {
+		o.mu.Lock()
+		current := o.current
+		o.mu.Unlock()
+
+		n, err := current.Read(p)
+		if err != io.EOF || n > 0 {
+			return n, err
+		}
+
+		restart, found := o.waitRestart()
+		if !found {
+			return 0, io.EOF
+		}
+		// all the logs of the new instance are read; when the new instance is already waiting to be restarted,
+		// its logs are the ones of the previous instance
+		options := o.options
+		options.Since = 0
+		options.TailLines = nil
+		options.Previous = restart.Status.State.Waiting != nil
+		options.Follow = !options.Previous
+		logs, err := o.platformClient.GetPodLogs(o.podName, o.containerName, options)
+		if err != nil {
+			return 0, err
+		}
+
+		o.mu.Lock()
+		select {
+		case <-o.closed:
+			o.mu.Unlock()
+			_ = logs.Close()
+			return 0, io.EOF
+		default:
+		}
+		_ = o.current.Close()
+		o.current = logs
+		o.restartCount = restart.Status.RestartCount
+		o.mu.Unlock()
+
+		select {
+		case o.restarts <- restart:
+		case <-o.closed:
+			return 0, io.EOF
+		case <-o.ctx.Done():
+			return 0, io.EOF
+		}
+	}
+}
+
+func (o *restartingReader) Close() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	select {
+	case <-o.closed:
+		return nil
+	default:
+	}
+	close(o.closed)
+	return o.current.Close()
+}
+
+// waitRestart waits for the container to be restarted, and returns false if the pod is deleted before
+func (o *restartingReader) waitRestart() (ContainerRestart, bool) {
+	ticker := time.NewTicker(restartPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-o.ctx.Done():
+			return ContainerRestart{}, false
+		case <-o.closed:
+			return ContainerRestart{}, false
+		case <-ticker.C:
+		}
+		pods, err := o.platformClient.GetPodsMatchingSelector(o.selector)
+		if err != nil {
+			klog.V(4).Infof("unable to get the pod %s: %v", o.podName, err)
+			continue
+		}
+		var restart *ContainerRestart
+		for _, pod := range pods.Items {
+			if pod.GetName() != o.podName || pod.GetDeletionTimestamp() != nil {
+				continue
+			}
+			status := getContainerStatus(pod, o.containerName)
+			if status == nil {
+				return ContainerRestart{}, false
+			}
+			restart = &ContainerRestart{
+				Name:    o.containerName,
+				PodName: o.podName,
+				Mode:    o.mode,
+				Status:  *status,
+			}
+		}
+		if restart == nil {
+			// the pod has been deleted
+			return ContainerRestart{}, false
+		}
+		if restart.Status.RestartCount > o.restartCount {
+			return *restart, true
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package logs
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+type fakePlatform struct {
+	// pods are returned by the successive calls to GetPodsMatchingSelector, the last one being repeated
+	pods []corev1.PodList
+	// logs are returned by the successive calls to GetPodLogs
+	logs    []string
+	options []platform.LogOptions
+}
+
+func (o *fakePlatform) ExecCMDInContainer(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+	panic("not implemented yet")
+}
+
+func (o *fakePlatform) GetPodLogs(podName, containerName string, options platform.LogOptions) (io.ReadCloser, error) {
+	o.options = append(o.options, options)
+	logs := o.logs[0]
+	o.logs = o.logs[1:]
+	return io.NopCloser(strings.NewReader(logs)), nil
+}
+
+func (o *fakePlatform) GetPodsMatchingSelector(selector string) (*corev1.PodList, error) {
+	pods := o.pods[0]
+	if len(o.pods) > 1 {
+		o.pods = o.pods[1:]
+	}
+	return &pods, nil
+}
+
+func (o *fakePlatform) GetAllResourcesFromSelector(selector string, ns string) ([]unstructured.Unstructured, error) {
+	panic("not implemented yet")
+}
+
+func (o *fakePlatform) GetAllPodsInNamespaceMatchingSelector(selector string, ns string) (*corev1.PodList, error) {
+	panic("not implemented yet")
+}
+
+func (o *fakePlatform) GetRunningPodFromSelector(selector string) (*corev1.Pod, error) {
+	panic("not implemented yet")
+}
+
+func getPodList(restartCount int32, state corev1.ContainerState) corev1.PodList {
+	return corev1.PodList{Items: []corev1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pod"},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+			Name:         "runtime",
+			RestartCount: restartCount,
+			State:        state,
+			LastTerminationState: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1},
+			},
+		}}},
+	}}}
+}
+
+func TestRestartingReader(t *testing.T) {
+	restartPollInterval = time.Millisecond
+
+	running := corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	waiting := corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}
+	tail := int64(10)
+	client := &fakePlatform{
+		pods: []corev1.PodList{
+			getPodList(0, running),
+			// the container is restarted and running
+			getPodList(1, running),
+			// the container is restarted, and is already waiting to be restarted again
+			getPodList(2, waiting),
+			// the pod is deleted
+			{},
+		},
+		logs: []string{"second\n", "third\n"},
+	}
+	restarts := make(chan ContainerRestart, 2)
+	options := platform.LogOptions{Follow: true, Since: time.Hour, TailLines: &tail, Timestamps: true}
+
+	reader := newRestartingReader(context.Background(), client, io.NopCloser(strings.NewReader("first\n")), restarts,
+		"selector", "my-pod", "runtime", "Dev", 0, options)
+	got, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("first\nsecond\nthird\n", string(got)); diff != "" {
+		t.Errorf("logs mismatch (-want +got):\n%s", diff)
+	}
+
+	wantOptions := []platform.LogOptions{
+		{Follow: true, Timestamps: true},
+		{Previous: true, Timestamps: true},
+	}
+	if diff := cmp.Diff(wantOptions, client.options); diff != "" {
+		t.Errorf("options mismatch (-want +got):\n%s", diff)
+	}
+
+	close(restarts)
+	var restartCounts []int32
+	for restart := range restarts {
+		if restart.PodName != "my-pod" || restart.Name != "runtime" || restart.Mode != "Dev" {
+			t.Errorf("unexpected restart %+v", restart)
+		}
+		restartCounts = append(restartCounts, restart.Status.RestartCount)
+	}
+	if diff := cmp.Diff([]int32{1, 2}, restartCounts); diff != "" {
+		t.Errorf("restarts mismatch (-want +got):\n%s", diff)
+	}
+}
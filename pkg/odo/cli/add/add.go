@@ -4,6 +4,11 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/redhat-developer/odo/pkg/odo/cli/add/binding"
+	"github.com/redhat-developer/odo/pkg/odo/cli/add/command"
+	"github.com/redhat-developer/odo/pkg/odo/cli/add/container"
+	"github.com/redhat-developer/odo/pkg/odo/cli/add/endpoint"
+	"github.com/redhat-developer/odo/pkg/odo/cli/add/env"
+	"github.com/redhat-developer/odo/pkg/odo/cli/add/volume"
 	"github.com/redhat-developer/odo/pkg/odo/util"
 )
 
@@ -19,6 +24,11 @@ func NewCmdAdd(name, fullName string) *cobra.Command {
 
 	bindingCmd := binding.NewCmdBinding(binding.BindingRecommendedCommandName, util.GetFullName(fullName, binding.BindingRecommendedCommandName))
 	createCmd.AddCommand(bindingCmd)
+	createCmd.AddCommand(command.NewCmdCommand(command.RecommendedCommandName, util.GetFullName(fullName, command.RecommendedCommandName)))
+	createCmd.AddCommand(container.NewCmdContainer(container.RecommendedCommandName, util.GetFullName(fullName, container.RecommendedCommandName)))
+	createCmd.AddCommand(endpoint.NewCmdEndpoint(endpoint.RecommendedCommandName, util.GetFullName(fullName, endpoint.RecommendedCommandName)))
+	createCmd.AddCommand(env.NewCmdEnv(env.RecommendedCommandName, util.GetFullName(fullName, env.RecommendedCommandName)))
+	createCmd.AddCommand(volume.NewCmdVolume(volume.RecommendedCommandName, util.GetFullName(fullName, volume.RecommendedCommandName)))
 	util.SetCommandGroup(createCmd, util.ManagementGroup)
 	createCmd.SetUsageTemplate(util.CmdUsageTemplate)
 
@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 
+	"github.com/redhat-developer/odo/pkg/binding"
 	"github.com/redhat-developer/odo/pkg/binding/asker"
 	"github.com/redhat-developer/odo/pkg/binding/backend"
 	"github.com/redhat-developer/odo/pkg/log"
@@ -38,6 +39,18 @@ var addBindingExample = ktemplates.Examples(`
 
 %[1]s --service myservice.Redis --name myRedisService
 
+# Add binding between the Secret 'db-credentials' and the component present in the working directory,
+# projected into the component by odo, without the Service Binding Operator
+%[1]s --native --service db-credentials/Secret --name db --type postgresql
+
+# Add binding between the Service 'db' along with the Secret 'db-credentials' and the component present in the working directory,
+# projected into the component by odo, without the Service Binding Operator
+%[1]s --native --service db/Service --secret db-credentials --name db --bind-as-files=false
+
+# Add binding between the component 'api' and the component present in the working directory,
+# injecting the host, port and URL of the component 'api' as the environment variables API_HOST, API_PORT and API_URL
+%[1]s --component api
+
 # Add binding between service named 'myservice' of kind 'Redis' and the deployment app (without Devfile)
 %[1]s --service myservice/Redis --name myRedisService --workload app/Deployment.apps
 %[1]s --service myservice/Redis --name myRedisService --workload app.Deployment.apps
@@ -76,6 +89,19 @@ func (o *AddBindingOptions) Validate(ctx context.Context) (err error) {
 func (o *AddBindingOptions) Run(ctx context.Context) error {
 	devfileObj := odocontext.GetDevfileObj(ctx)
 	withDevfile := devfileObj != nil
+
+	if o.flags[backend.FLAG_COMPONENT] != "" {
+		return o.runComponent(ctx)
+	}
+
+	native, err := binding.IsNativeBindingRequested(o.flags)
+	if err != nil {
+		return err
+	}
+	if native {
+		return o.runNative(ctx)
+	}
+
 	ns, err := o.clientset.BindingClient.SelectNamespace(o.flags)
 	if err != nil {
 		return err
@@ -185,6 +211,44 @@ func (o *AddBindingOptions) Run(ctx context.Context) error {
 	return nil
 }
 
+// runNative adds to the devfile a binding projected into the component by odo itself
+func (o *AddBindingOptions) runNative(ctx context.Context) error {
+	devfileobj, nativeBinding, err := o.clientset.BindingClient.AddNativeBindingToDevfile(
+		odocontext.GetComponentName(ctx), o.flags, *odocontext.GetDevfileObj(ctx), odocontext.GetWorkingDirectory(ctx))
+	if err != nil {
+		return err
+	}
+	err = devfileobj.WriteYamlDevfile()
+	if err != nil {
+		return err
+	}
+	log.Success("Successfully added the native binding to the devfile.")
+	log.Infof("The binding will be projected into the component by `odo dev`, in the directory $%s/%s of the containers.",
+		binding.ServiceBindingRootEnv, nativeBinding.Directory)
+	return nil
+}
+
+// runComponent adds to the devfile a binding to another component, projected into the component by odo itself
+func (o *AddBindingOptions) runComponent(ctx context.Context) error {
+	devfileobj, nativeBinding, err := o.clientset.BindingClient.AddComponentBindingToDevfile(
+		odocontext.GetComponentName(ctx), o.flags, *odocontext.GetDevfileObj(ctx))
+	if err != nil {
+		return err
+	}
+	err = devfileobj.WriteYamlDevfile()
+	if err != nil {
+		return err
+	}
+	var envNames []string
+	for _, env := range nativeBinding.Env {
+		envNames = append(envNames, env.Name)
+	}
+	log.Successf("Successfully added the binding to the component %q to the devfile.", nativeBinding.Component)
+	log.Infof("The environment variables %s will be injected into the component by `odo dev`, "+
+		"from the Service of the component %q running in Dev mode or deployed.", strings.Join(envNames, ", "), nativeBinding.Component)
+	return nil
+}
+
 // NewCmdBinding implements the component odo sub-command
 func NewCmdBinding(name, fullName string) *cobra.Command {
 	o := NewAddBindingOptions()
@@ -208,6 +272,14 @@ func NewCmdBinding(name, fullName string) *cobra.Command {
 		"Naming strategy to use for binding names. "+
 			"It can be set to pre-defined strategies: 'none', 'lowercase', or 'uppercase'. "+
 			"Otherwise, it is treated as a custom Go template, and it is handled accordingly.")
+	bindingCmd.Flags().Bool(backend.FLAG_NATIVE, false,
+		"Project the binding into the component with odo, without the Service Binding Operator. "+
+			"The service must be a Secret, a ConfigMap or a Service, in the format <name>[/<kind>]")
+	bindingCmd.Flags().String(backend.FLAG_SECRET, "", "Secret to bind along with the Service, only with --native")
+	bindingCmd.Flags().String(backend.FLAG_TYPE, "", "Type of the binding, overriding the type entry of the service, only with --native")
+	bindingCmd.Flags().String(backend.FLAG_PROVIDER, "", "Provider of the binding, overriding the provider entry of the service, only with --native")
+	bindingCmd.Flags().String(backend.FLAG_COMPONENT, "",
+		"Name of the component to bind, injecting its host, port and URL into the component, without the Service Binding Operator")
 	clientset.Add(bindingCmd, clientset.BINDING, clientset.FILESYSTEM)
 
 	return bindingCmd
@@ -0,0 +1,164 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/edit"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "command"
+
+var addCommandExample = ktemplates.Examples(`
+  # Add an exec command running in the only container component of the devfile
+  %[1]s lint --command-line "npm run lint"
+
+  # Add an exec command as the default command of the test group
+  %[1]s test --container runtime --command-line "npm test" --working-dir '${PROJECT_SOURCE}' --group test --default
+
+  # Add a composite command running other commands in parallel
+  %[1]s build-all --composite build-front,build-back --parallel --group build
+`)
+
+// AddCommandOptions encapsulates the options for the odo add command command
+type AddCommandOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	containerFlag   string
+	commandLineFlag string
+	workingDirFlag  string
+	compositeFlag   []string
+	parallelFlag    bool
+	groupFlag       string
+	defaultFlag     bool
+
+	// Variables
+	id        string
+	container string
+}
+
+var _ genericclioptions.Runnable = (*AddCommandOptions)(nil)
+
+// NewAddCommandOptions creates a new AddCommandOptions instance
+func NewAddCommandOptions() *AddCommandOptions {
+	return &AddCommandOptions{}
+}
+
+func (o *AddCommandOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes AddCommandOptions after they've been created
+func (o *AddCommandOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	devfileObj := odocontext.GetDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	o.id = args[0]
+	if len(o.compositeFlag) > 0 {
+		return nil
+	}
+	o.container, err = edit.ContainerName(*devfileObj, o.containerFlag)
+	return err
+}
+
+// Validate validates the AddCommandOptions based on completed values
+func (o *AddCommandOptions) Validate(ctx context.Context) (err error) {
+	if len(o.compositeFlag) > 0 {
+		if o.commandLineFlag != "" || o.containerFlag != "" || o.workingDirFlag != "" {
+			return errors.New("--composite cannot be used with --command-line, --container or --working-dir")
+		}
+	} else {
+		if o.commandLineFlag == "" {
+			return errors.New("the command line must be specified with --command-line, or the commands of a composite command with --composite")
+		}
+		if o.parallelFlag {
+			return errors.New("--parallel can be used only with --composite")
+		}
+	}
+	if o.defaultFlag && o.groupFlag == "" {
+		return errors.New("--default can be used only with --group")
+	}
+	return edit.ValidateGroupKind(o.groupFlag)
+}
+
+// Run contains the logic for the odo add command command
+func (o *AddCommandOptions) Run(ctx context.Context) (err error) {
+	var group *devfilev1.CommandGroup
+	if o.groupFlag != "" {
+		group = &devfilev1.CommandGroup{Kind: devfilev1.CommandGroupKind(o.groupFlag)}
+		if o.defaultFlag {
+			group.IsDefault = &o.defaultFlag
+		}
+	}
+
+	command := devfilev1.Command{Id: o.id}
+	if len(o.compositeFlag) > 0 {
+		command.Composite = &devfilev1.CompositeCommand{
+			LabeledCommand: devfilev1.LabeledCommand{BaseCommand: devfilev1.BaseCommand{Group: group}},
+			Commands:       o.compositeFlag,
+		}
+		if o.parallelFlag {
+			command.Composite.Parallel = &o.parallelFlag
+		}
+	} else {
+		command.Exec = &devfilev1.ExecCommand{
+			LabeledCommand: devfilev1.LabeledCommand{BaseCommand: devfilev1.BaseCommand{Group: group}},
+			CommandLine:    o.commandLineFlag,
+			Component:      o.container,
+			WorkingDir:     o.workingDirFlag,
+		}
+	}
+
+	err = edit.EditFile(o.clientset.FS, odocontext.GetDevfilePath(ctx), func(d *edit.Devfile) error {
+		return d.AddCommand(command)
+	})
+	if err != nil {
+		return err
+	}
+	log.Successf("The command %q has been added to the devfile", o.id)
+	return nil
+}
+
+// NewCmdCommand implements the odo add command command
+func NewCmdCommand(name, fullName string) *cobra.Command {
+	o := NewAddCommandOptions()
+	commandCmd := &cobra.Command{
+		Use:   name + " ID",
+		Short: "Add an exec or composite command to the devfile",
+		Long: `Add an exec command, running a command line in a container component, or a composite command, running other commands, to the devfile.
+The devfile is modified only if the result is valid.
+
+The command can be added to a group (build, run, test, debug or deploy). If it is the default command of the group,
+the other commands of the group are not default anymore.`,
+		Example: fmt.Sprintf(addCommandExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	commandCmd.Flags().StringVar(&o.containerFlag, "container", "", "Container component running the exec command; can be omitted if the devfile defines a single container component")
+	commandCmd.Flags().StringVar(&o.commandLineFlag, "command-line", "", "Command line of the exec command")
+	commandCmd.Flags().StringVar(&o.workingDirFlag, "working-dir", "", "Working directory of the exec command")
+	commandCmd.Flags().StringSliceVar(&o.compositeFlag, "composite", nil, "Commands run by the composite command")
+	commandCmd.Flags().BoolVar(&o.parallelFlag, "parallel", false, "If true, the commands of the composite command are run in parallel")
+	commandCmd.Flags().StringVar(&o.groupFlag, "group", "", "Group of the command: build, run, test, debug or deploy")
+	commandCmd.Flags().BoolVar(&o.defaultFlag, "default", false, "If true, the command is the default command of its group")
+	clientset.Add(commandCmd, clientset.FILESYSTEM)
+	commandCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return commandCmd
+}
@@ -0,0 +1,128 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/edit"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "container"
+
+var addContainerExample = ktemplates.Examples(`
+  # Add a container component running a database
+  %[1]s db --image postgres:14 --memory-limit 512Mi
+
+  # Add a container component running a command, without mounting the sources
+  %[1]s tools --image registry.access.redhat.com/ubi8/ubi-minimal --command tail --args -f,/dev/null --mount-sources=false
+`)
+
+// AddContainerOptions encapsulates the options for the odo add container command
+type AddContainerOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	imageFlag        string
+	memoryLimitFlag  string
+	cpuLimitFlag     string
+	commandFlag      []string
+	argsFlag         []string
+	mountSourcesFlag bool
+
+	// Variables
+	name              string
+	mountSourcesIsSet bool
+}
+
+var _ genericclioptions.Runnable = (*AddContainerOptions)(nil)
+
+// NewAddContainerOptions creates a new AddContainerOptions instance
+func NewAddContainerOptions() *AddContainerOptions {
+	return &AddContainerOptions{}
+}
+
+func (o *AddContainerOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes AddContainerOptions after they've been created
+func (o *AddContainerOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	if odocontext.GetDevfileObj(ctx) == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	o.name = args[0]
+	o.mountSourcesIsSet = cmdline.IsFlagSet("mount-sources")
+	return nil
+}
+
+// Validate validates the AddContainerOptions based on completed values
+func (o *AddContainerOptions) Validate(ctx context.Context) (err error) {
+	if o.imageFlag == "" {
+		return errors.New("the image of the container must be specified with --image")
+	}
+	return nil
+}
+
+// Run contains the logic for the odo add container command
+func (o *AddContainerOptions) Run(ctx context.Context) (err error) {
+	container := devfilev1.ContainerComponent{
+		Container: devfilev1.Container{
+			Image:       o.imageFlag,
+			MemoryLimit: o.memoryLimitFlag,
+			CpuLimit:    o.cpuLimitFlag,
+			Command:     o.commandFlag,
+			Args:        o.argsFlag,
+		},
+	}
+	if o.mountSourcesIsSet {
+		container.MountSources = &o.mountSourcesFlag
+	}
+	err = edit.EditFile(o.clientset.FS, odocontext.GetDevfilePath(ctx), func(d *edit.Devfile) error {
+		return d.AddComponent(devfilev1.Component{
+			Name:           o.name,
+			ComponentUnion: devfilev1.ComponentUnion{Container: &container},
+		})
+	})
+	if err != nil {
+		return err
+	}
+	log.Successf("The container component %q has been added to the devfile", o.name)
+	return nil
+}
+
+// NewCmdContainer implements the odo add container command
+func NewCmdContainer(name, fullName string) *cobra.Command {
+	o := NewAddContainerOptions()
+	containerCmd := &cobra.Command{
+		Use:     name + " NAME --image IMAGE",
+		Short:   "Add a container component to the devfile",
+		Long:    "Add a container component to the devfile. The devfile is modified only if the result is valid.",
+		Example: fmt.Sprintf(addContainerExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	containerCmd.Flags().StringVar(&o.imageFlag, "image", "", "Image of the container")
+	containerCmd.Flags().StringVar(&o.memoryLimitFlag, "memory-limit", "", "Memory limit of the container, for example 512Mi")
+	containerCmd.Flags().StringVar(&o.cpuLimitFlag, "cpu-limit", "", "CPU limit of the container, for example 500m")
+	containerCmd.Flags().StringSliceVar(&o.commandFlag, "command", nil, "Command run when the container starts, overriding the entrypoint of the image")
+	containerCmd.Flags().StringSliceVar(&o.argsFlag, "args", nil, "Arguments of the command run when the container starts")
+	containerCmd.Flags().BoolVar(&o.mountSourcesFlag, "mount-sources", true, "If false, the sources of the component are not mounted in the container")
+	clientset.Add(containerCmd, clientset.FILESYSTEM)
+	containerCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return containerCmd
+}
@@ -0,0 +1,152 @@
+package endpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/edit"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "endpoint"
+
+var (
+	exposures = map[devfilev1.EndpointExposure]bool{
+		devfilev1.PublicEndpointExposure:   true,
+		devfilev1.InternalEndpointExposure: true,
+		devfilev1.NoneEndpointExposure:     true,
+	}
+	protocols = map[devfilev1.EndpointProtocol]bool{
+		devfilev1.HTTPEndpointProtocol:  true,
+		devfilev1.HTTPSEndpointProtocol: true,
+		devfilev1.WSEndpointProtocol:    true,
+		devfilev1.WSSEndpointProtocol:   true,
+		devfilev1.TCPEndpointProtocol:   true,
+		devfilev1.UDPEndpointProtocol:   true,
+	}
+)
+
+var addEndpointExample = ktemplates.Examples(`
+  # Add an endpoint to the only container component of the devfile
+  %[1]s http-admin --port 9000
+
+  # Add an internal TCP endpoint to a container component
+  %[1]s pg --container db --port 5432 --exposure internal --protocol tcp
+
+  # Add a public HTTPS endpoint with a path
+  %[1]s api --container runtime --port 8443 --protocol https --path /api
+`)
+
+// AddEndpointOptions encapsulates the options for the odo add endpoint command
+type AddEndpointOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	containerFlag string
+	portFlag      int
+	exposureFlag  string
+	protocolFlag  string
+	pathFlag      string
+	secureFlag    bool
+
+	// Variables
+	name      string
+	container string
+}
+
+var _ genericclioptions.Runnable = (*AddEndpointOptions)(nil)
+
+// NewAddEndpointOptions creates a new AddEndpointOptions instance
+func NewAddEndpointOptions() *AddEndpointOptions {
+	return &AddEndpointOptions{}
+}
+
+func (o *AddEndpointOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes AddEndpointOptions after they've been created
+func (o *AddEndpointOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	devfileObj := odocontext.GetDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	o.name = args[0]
+	o.container, err = edit.ContainerName(*devfileObj, o.containerFlag)
+	return err
+}
+
+// Validate validates the AddEndpointOptions based on completed values
+func (o *AddEndpointOptions) Validate(ctx context.Context) (err error) {
+	if o.portFlag <= 0 || o.portFlag > 65535 {
+		return errors.New("the port of the endpoint must be specified with --port, between 1 and 65535")
+	}
+	if o.exposureFlag != "" && !exposures[devfilev1.EndpointExposure(o.exposureFlag)] {
+		return fmt.Errorf("invalid exposure %q, must be one of public, internal or none", o.exposureFlag)
+	}
+	if o.protocolFlag != "" && !protocols[devfilev1.EndpointProtocol(o.protocolFlag)] {
+		return fmt.Errorf("invalid protocol %q, must be one of http, https, ws, wss, tcp or udp", o.protocolFlag)
+	}
+	return nil
+}
+
+// Run contains the logic for the odo add endpoint command
+func (o *AddEndpointOptions) Run(ctx context.Context) (err error) {
+	endpoint := devfilev1.Endpoint{
+		Name:       o.name,
+		TargetPort: o.portFlag,
+		Exposure:   devfilev1.EndpointExposure(o.exposureFlag),
+		Protocol:   devfilev1.EndpointProtocol(o.protocolFlag),
+		Path:       o.pathFlag,
+	}
+	if o.secureFlag {
+		endpoint.Secure = &o.secureFlag
+	}
+	err = edit.EditFile(o.clientset.FS, odocontext.GetDevfilePath(ctx), func(d *edit.Devfile) error {
+		return d.AddEndpoint(o.container, endpoint)
+	})
+	if err != nil {
+		return err
+	}
+	log.Successf("The endpoint %q (port %d) has been added to the container component %q", o.name, o.portFlag, o.container)
+	return nil
+}
+
+// NewCmdEndpoint implements the odo add endpoint command
+func NewCmdEndpoint(name, fullName string) *cobra.Command {
+	o := NewAddEndpointOptions()
+	endpointCmd := &cobra.Command{
+		Use:   name + " NAME --port PORT",
+		Short: "Add an endpoint to a container component of the devfile",
+		Long: `Add an endpoint to a container component of the devfile. The devfile is modified only if the result is valid.
+
+The exposure of the endpoint is one of public (default), internal or none,
+and its protocol one of http (default), https, ws, wss, tcp or udp.`,
+		Example: fmt.Sprintf(addEndpointExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	endpointCmd.Flags().StringVar(&o.containerFlag, "container", "", "Container component of the endpoint; can be omitted if the devfile defines a single container component")
+	endpointCmd.Flags().IntVar(&o.portFlag, "port", 0, "Port of the endpoint in the container")
+	endpointCmd.Flags().StringVar(&o.exposureFlag, "exposure", "", "Exposure of the endpoint: public, internal or none")
+	endpointCmd.Flags().StringVar(&o.protocolFlag, "protocol", "", "Protocol of the endpoint: http, https, ws, wss, tcp or udp")
+	endpointCmd.Flags().StringVar(&o.pathFlag, "path", "", "Path of the endpoint")
+	endpointCmd.Flags().BoolVar(&o.secureFlag, "secure", false, "If true, the endpoint is accessible only with an authentication")
+	clientset.Add(endpointCmd, clientset.FILESYSTEM)
+	endpointCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return endpointCmd
+}
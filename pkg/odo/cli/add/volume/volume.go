@@ -0,0 +1,138 @@
+package volume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/edit"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "volume"
+
+var addVolumeExample = ktemplates.Examples(`
+  # Add a volume of 1Gi, and mount it in the only container component of the devfile
+  %[1]s data --size 1Gi --mount-path /data
+
+  # Add an ephemeral volume, and mount it in a container component
+  %[1]s cache --ephemeral --container runtime --mount-path /cache
+
+  # Add a volume without mounting it
+  %[1]s data --size 1Gi
+`)
+
+// AddVolumeOptions encapsulates the options for the odo add volume command
+type AddVolumeOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	sizeFlag      string
+	ephemeralFlag bool
+	containerFlag string
+	mountPathFlag string
+
+	// Variables
+	name      string
+	container string
+}
+
+var _ genericclioptions.Runnable = (*AddVolumeOptions)(nil)
+
+// NewAddVolumeOptions creates a new AddVolumeOptions instance
+func NewAddVolumeOptions() *AddVolumeOptions {
+	return &AddVolumeOptions{}
+}
+
+func (o *AddVolumeOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes AddVolumeOptions after they've been created
+func (o *AddVolumeOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	devfileObj := odocontext.GetDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	o.name = args[0]
+	if o.mountPathFlag == "" {
+		return nil
+	}
+	o.container, err = edit.ContainerName(*devfileObj, o.containerFlag)
+	return err
+}
+
+// Validate validates the AddVolumeOptions based on completed values
+func (o *AddVolumeOptions) Validate(ctx context.Context) (err error) {
+	if o.containerFlag != "" && o.mountPathFlag == "" {
+		return errors.New("the path where the volume is mounted in the container must be specified with --mount-path")
+	}
+	return nil
+}
+
+// Run contains the logic for the odo add volume command
+func (o *AddVolumeOptions) Run(ctx context.Context) (err error) {
+	volume := devfilev1.Component{
+		Name: o.name,
+		ComponentUnion: devfilev1.ComponentUnion{
+			Volume: &devfilev1.VolumeComponent{
+				Volume: devfilev1.Volume{Size: o.sizeFlag},
+			},
+		},
+	}
+	if o.ephemeralFlag {
+		volume.Volume.Ephemeral = &o.ephemeralFlag
+	}
+	err = edit.EditFile(o.clientset.FS, odocontext.GetDevfilePath(ctx), func(d *edit.Devfile) error {
+		if err := d.AddComponent(volume); err != nil {
+			return err
+		}
+		if o.container == "" {
+			return nil
+		}
+		return d.AddVolumeMount(o.container, devfilev1.VolumeMount{Name: o.name, Path: o.mountPathFlag})
+	})
+	if err != nil {
+		return err
+	}
+	if o.container == "" {
+		log.Successf("The volume %q has been added to the devfile", o.name)
+		return nil
+	}
+	log.Successf("The volume %q has been added to the devfile, and mounted on %s in the container component %q", o.name, o.mountPathFlag, o.container)
+	return nil
+}
+
+// NewCmdVolume implements the odo add volume command
+func NewCmdVolume(name, fullName string) *cobra.Command {
+	o := NewAddVolumeOptions()
+	volumeCmd := &cobra.Command{
+		Use:   name + " NAME",
+		Short: "Add a volume to the devfile",
+		Long: `Add a volume component to the devfile, and optionally mount it in a container component.
+The devfile is modified only if the result is valid.`,
+		Example: fmt.Sprintf(addVolumeExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	volumeCmd.Flags().StringVar(&o.sizeFlag, "size", "", "Size of the volume, for example 1Gi")
+	volumeCmd.Flags().BoolVar(&o.ephemeralFlag, "ephemeral", false, "If true, the content of the volume is not persisted")
+	volumeCmd.Flags().StringVar(&o.containerFlag, "container", "", "Container component in which the volume is mounted; can be omitted if the devfile defines a single container component")
+	volumeCmd.Flags().StringVar(&o.mountPathFlag, "mount-path", "", "Path where the volume is mounted in the container")
+	clientset.Add(volumeCmd, clientset.FILESYSTEM)
+	volumeCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return volumeCmd
+}
@@ -3,9 +3,18 @@ package alizer
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/templates"
 
 	"github.com/redhat-developer/odo/pkg/alizer"
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
@@ -13,14 +22,23 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	"github.com/redhat-developer/odo/pkg/odo/util"
 	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
-
-	"github.com/spf13/cobra"
 )
 
 const RecommendedCommandName = "analyze"
 
+var alizerExample = templates.Examples(`
+  # Detect the devfile to use for the current directory
+  %[1]s -o json
+
+  # Detect all the components of a monorepo, with their paths, ports and suggested devfiles
+  %[1]s --all-components
+`)
+
 type AlizerOptions struct {
 	clientset *clientset.Clientset
+
+	// Flags
+	allComponentsFlag bool
 }
 
 var _ genericclioptions.Runnable = (*AlizerOptions)(nil)
@@ -44,12 +62,34 @@ func (o *AlizerOptions) Validate(ctx context.Context) error {
 }
 
 func (o *AlizerOptions) Run(ctx context.Context) (err error) {
-	return errors.New("this command can be run with json output only, please use the flag: -o json")
+	if !o.allComponentsFlag {
+		return errors.New("this command can be run with json output only, please use the flag: -o json")
+	}
+	components, err := o.clientset.AlizerClient.DetectComponents(ctx, odocontext.GetWorkingDirectory(ctx))
+	if err != nil {
+		return err
+	}
+	if len(components) == 0 {
+		log.Error("No components detected in the current directory")
+		return nil
+	}
+	PrintComponents(components)
+	return nil
 }
 
 // RunForJsonOutput contains the logic for the odo command
 func (o *AlizerOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
 	workingDir := odocontext.GetWorkingDirectory(ctx)
+	if o.allComponentsFlag {
+		components, err := o.clientset.AlizerClient.DetectComponents(ctx, workingDir)
+		if err != nil {
+			return nil, err
+		}
+		if components == nil {
+			components = []api.DetectionResult{}
+		}
+		return components, nil
+	}
 	df, defaultVersion, reg, err := o.clientset.AlizerClient.DetectFramework(ctx, workingDir)
 	if err != nil {
 		return nil, err
@@ -62,18 +102,39 @@ func (o *AlizerOptions) RunForJsonOutput(ctx context.Context) (out interface{},
 	return []api.DetectionResult{*result}, nil
 }
 
+// PrintComponents displays the components detected, with their paths, ports and suggested devfiles
+func PrintComponents(components []api.DetectionResult) {
+	t := ui.NewTable()
+	t.AppendHeader(table.Row{"NAME", "PATH", "DEVFILE", "REGISTRY", "PORTS"})
+	for _, component := range components {
+		ports := make([]string, 0, len(component.ApplicationPorts))
+		for _, port := range component.ApplicationPorts {
+			ports = append(ports, strconv.Itoa(port))
+		}
+		devfile := component.Devfile
+		if component.DevfileVersion != "" {
+			devfile += ":" + component.DevfileVersion
+		}
+		t.AppendRow(table.Row{component.Name, component.Path, devfile, component.DevfileRegistry, strings.Join(ports, ", ")})
+	}
+	t.Render()
+}
+
 func NewCmdAlizer(name, fullName string) *cobra.Command {
 	o := NewAlizerOptions()
 	alizerCmd := &cobra.Command{
 		Use:         name,
 		Short:       "Detect devfile to use based on files present in current directory",
 		Long:        "Detect devfile to use based on files present in current directory",
+		Example:     fmt.Sprintf(alizerExample, fullName),
 		Args:        cobra.MaximumNArgs(0),
 		Annotations: map[string]string{},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return genericclioptions.GenericRun(o, cmd, args)
 		},
 	}
+	alizerCmd.Flags().BoolVar(&o.allComponentsFlag, "all-components", false,
+		"If true, detect all the components in the current directory and its sub-directories, with the devfile to use for each one")
 	clientset.Add(alizerCmd, clientset.ALIZER, clientset.FILESYSTEM)
 	util.SetCommandGroup(alizerCmd, util.UtilityGroup)
 	commonflags.UseOutputFlag(alizerCmd)
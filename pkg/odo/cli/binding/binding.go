@@ -0,0 +1,31 @@
+package binding
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/redhat-developer/odo/pkg/odo/cli/binding/check"
+	"github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended binding command name
+const RecommendedCommandName = "binding"
+
+// NewCmdBinding implements the binding odo command
+func NewCmdBinding(name, fullName string) *cobra.Command {
+	checkCmd := check.NewCmdCheck(check.RecommendedCommandName, util.GetFullName(fullName, check.RecommendedCommandName))
+	bindingCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Manage the bindings of the component",
+		Long:    "Manage the bindings of the component",
+		Example: fmt.Sprintf("%s\n", checkCmd.Example),
+	}
+
+	bindingCmd.AddCommand(checkCmd)
+
+	util.SetCommandGroup(bindingCmd, util.ManagementGroup)
+	bindingCmd.SetUsageTemplate(util.CmdUsageTemplate)
+
+	return bindingCmd
+}
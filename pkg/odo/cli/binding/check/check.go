@@ -0,0 +1,256 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/binding"
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "check"
+
+var checkExample = ktemplates.Examples(`
+  # Check the connectivity of the bindings of the component, from the container running the application
+  %[1]s
+
+  # Check the connectivity of the binding 'my-db', from the container 'runtime' of the component
+  %[1]s --name my-db --container runtime
+
+  # Check the connectivity of the bindings and get the results in JSON format, for example in a CI smoke test
+  %[1]s -o json
+`)
+
+// CheckOptions encapsulates the options for the odo binding check command
+type CheckOptions struct {
+	// Flags
+	nameFlag      string
+	containerFlag string
+
+	// Clients
+	clientset *clientset.Clientset
+}
+
+var (
+	_ genericclioptions.Runnable      = (*CheckOptions)(nil)
+	_ genericclioptions.JsonOutputter = (*CheckOptions)(nil)
+)
+
+// NewCheckOptions creates a new CheckOptions instance
+func NewCheckOptions() *CheckOptions {
+	return &CheckOptions{}
+}
+
+func (o *CheckOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes CheckOptions after they've been created
+func (o *CheckOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	if odocontext.GetDevfileObj(ctx) == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	return nil
+}
+
+// Validate validates the CheckOptions based on completed values
+func (o *CheckOptions) Validate(ctx context.Context) (err error) {
+	if o.clientset.KubernetesClient == nil {
+		return errors.New("unable to access the cluster, the bindings are checked from the running component")
+	}
+	return nil
+}
+
+// Run contains the logic for the odo binding check command
+func (o *CheckOptions) Run(ctx context.Context) (err error) {
+	checks, err := o.check(ctx)
+	if err != nil {
+		return err
+	}
+	if len(checks.Bindings) == 0 {
+		log.Info("No ServiceBinding used by the current component")
+		return nil
+	}
+
+	log.Infof("Checking the bindings from the container %q of the component", checks.Container)
+	for _, check := range checks.Bindings {
+		description := describeCheck(check)
+		if !binding.IsBindingCheckHealthy(check) {
+			log.Errorf("%s", description)
+			continue
+		}
+		if check.Reachability == api.BindingCheckSkipped && check.Auth == api.BindingCheckSkipped {
+			log.Warningf("%s", description)
+			continue
+		}
+		log.Successf("%s", description)
+	}
+	return getChecksError(checks)
+}
+
+// RunForJsonOutput contains the logic for the JSON Output
+func (o *CheckOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	checks, err := o.check(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = getChecksError(checks)
+	if err != nil {
+		// the result is written before returning the error, so that the command exits with a non-zero status
+		machineoutput.OutputSuccess(checks)
+		return nil, err
+	}
+	return checks, nil
+}
+
+// getChecksError returns an error if a probe of the checks failed
+func getChecksError(checks api.BindingChecks) error {
+	var nbFailed int
+	for _, check := range checks.Bindings {
+		if !binding.IsBindingCheckHealthy(check) {
+			nbFailed++
+		}
+	}
+	if nbFailed > 0 {
+		return fmt.Errorf("%d binding(s) out of %d failed the check", nbFailed, len(checks.Bindings))
+	}
+	return nil
+}
+
+// check runs the probes of the bindings of the component, from its running container
+func (o *CheckOptions) check(ctx context.Context) (api.BindingChecks, error) {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+		devfileObj    = odocontext.GetDevfileObj(ctx)
+		workingDir    = odocontext.GetWorkingDirectory(ctx)
+	)
+
+	bindings, err := o.clientset.BindingClient.GetBindingsFromDevfile(*devfileObj, workingDir)
+	if err != nil {
+		return api.BindingChecks{}, err
+	}
+	if o.nameFlag != "" {
+		var selected []api.ServiceBinding
+		var names []string
+		for _, b := range bindings {
+			if b.Name == o.nameFlag {
+				selected = append(selected, b)
+			}
+			names = append(names, b.Name)
+		}
+		if len(selected) == 0 {
+			return api.BindingChecks{}, fmt.Errorf("binding %q not found in the devfile, the bindings of the component are: %s", o.nameFlag, strings.Join(names, ", "))
+		}
+		bindings = selected
+	}
+
+	result := api.BindingChecks{
+		Healthy:  true,
+		Bindings: make([]api.BindingCheck, 0, len(bindings)),
+	}
+	if len(bindings) == 0 {
+		return result, nil
+	}
+
+	pod, containerName, err := component.GetDevContainer(o.clientset.KubernetesClient, *devfileObj, componentName, appName, o.containerFlag)
+	if err != nil {
+		return api.BindingChecks{}, err
+	}
+	result.Container = containerName
+
+	for _, b := range bindings {
+		// the values are read in clear text, to authenticate with the credentials of the binding
+		err = binding.GetBindingValues(o.clientset.KubernetesClient, pod.Name, containerName, b.Status, true)
+		if err != nil {
+			return api.BindingChecks{}, err
+		}
+		check, err := binding.CheckBinding(o.clientset.ExecClient, pod.Name, containerName, b)
+		if err != nil {
+			return api.BindingChecks{}, err
+		}
+		result.Healthy = result.Healthy && binding.IsBindingCheckHealthy(check)
+		result.Bindings = append(result.Bindings, check)
+	}
+	return result, nil
+}
+
+// describeCheck returns the human readable summary of the check of a binding
+func describeCheck(check api.BindingCheck) string {
+	var details []string
+	if check.Type != "" {
+		details = append(details, check.Type)
+	}
+	if check.Host != "" {
+		details = append(details, check.Host+":"+strconv.Itoa(check.Port))
+	}
+	description := check.Name
+	if len(details) > 0 {
+		description += " (" + strings.Join(details, ", ") + ")"
+	}
+
+	var results []string
+	switch check.Reachability {
+	case api.BindingCheckOK:
+		results = append(results, "reachable")
+	case api.BindingCheckFailed:
+		results = append(results, "unreachable")
+	default:
+		results = append(results, "reachability not checked")
+	}
+	switch check.Auth {
+	case api.BindingCheckOK:
+		results = append(results, "authenticated")
+	case api.BindingCheckFailed:
+		results = append(results, "authentication failed")
+	default:
+		results = append(results, "authentication not checked")
+	}
+	description += ": " + strings.Join(results, ", ")
+	if check.Message != "" {
+		description += " (" + check.Message + ")"
+	}
+	return description
+}
+
+// NewCmdCheck implements the odo binding check command
+func NewCmdCheck(name, fullName string) *cobra.Command {
+	o := NewCheckOptions()
+	checkCmd := &cobra.Command{
+		Use:   name,
+		Short: "Check the connectivity of the bindings",
+		Long: `Check the connectivity of the bindings of the component, from the container of the running component.
+
+For each binding, a TCP connection is opened to the host and port of the binding, then, depending on the type of the binding,
+a handshake authenticated with the credentials of the binding is made with the client found in the container:
+psql for the type postgresql, mysql for mysql, redis-cli for redis and curl for http and https.`,
+		Example: fmt.Sprintf(checkExample, fullName),
+		Args:    genericclioptions.NoArgsAndSilenceJSON,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	checkCmd.Flags().StringVar(&o.nameFlag, "name", "", "Name of the binding to check. By default, all the bindings of the component are checked")
+	checkCmd.Flags().StringVar(&o.containerFlag, "container", "",
+		"Container of the component from which the bindings are checked. By default, the container running the application")
+	clientset.Add(checkCmd, clientset.KUBERNETES, clientset.BINDING, clientset.EXEC, clientset.FILESYSTEM)
+	commonflags.UseOutputFlag(checkCmd)
+	checkCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return checkCmd
+}
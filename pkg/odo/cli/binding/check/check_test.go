@@ -0,0 +1,55 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+func Test_getChecksError(t *testing.T) {
+	tests := []struct {
+		name    string
+		checks  api.BindingChecks
+		wantErr string
+	}{
+		{
+			name:   "no binding",
+			checks: api.BindingChecks{Healthy: true},
+		},
+		{
+			name: "all probes succeeded or skipped",
+			checks: api.BindingChecks{
+				Healthy: true,
+				Bindings: []api.BindingCheck{
+					{Name: "db", Reachability: api.BindingCheckOK, Auth: api.BindingCheckOK},
+					{Name: "cache", Reachability: api.BindingCheckOK, Auth: api.BindingCheckSkipped},
+				},
+			},
+		},
+		{
+			name: "a probe failed",
+			checks: api.BindingChecks{
+				Healthy: false,
+				Bindings: []api.BindingCheck{
+					{Name: "db", Reachability: api.BindingCheckOK, Auth: api.BindingCheckFailed},
+					{Name: "cache", Reachability: api.BindingCheckOK, Auth: api.BindingCheckOK},
+				},
+			},
+			wantErr: "1 binding(s) out of 2 failed the check",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := getChecksError(tt.checks)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("getChecksError() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("getChecksError() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -25,7 +25,17 @@ type BuildImagesOptions struct {
 	clientset *clientset.Clientset
 
 	// Flags
-	pushFlag bool
+	pushFlag       bool
+	loadFlag       bool
+	forceBuildFlag bool
+	platformFlag   string
+	buildArgFlag   []string
+	targetFlag     string
+	secretFlag     []string
+
+	// Variables
+	platforms []string
+	load      bool
 }
 
 var _ genericclioptions.Runnable = (*BuildImagesOptions)(nil)
@@ -36,6 +46,18 @@ var buildImagesExample = templates.Examples(`
 
   # Build images and push them to their registries
   %[1]s --push
+
+  # Build images, even if their build inputs did not change since their last build
+  %[1]s --force-build
+
+  # Build images and load them into the local kind, minikube or CRC cluster
+  %[1]s --load
+
+  # Build multi-architecture images and push the manifest lists to their registries
+  %[1]s --push --platform linux/amd64,linux/arm64
+
+  # Build images with a build argument and a target stage overriding the devfile, and a secret available to RUN --mount=type=secret,id=npmrc
+  %[1]s --build-arg VERSION=1.2 --target production --secret id=npmrc,src=$HOME/.npmrc
 `)
 
 // NewBuildImagesOptions creates a new BuildImagesOptions instance
@@ -49,6 +71,22 @@ func (o *BuildImagesOptions) SetClientset(clientset *clientset.Clientset) {
 
 // Complete completes LoginOptions after they've been created
 func (o *BuildImagesOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	o.platforms, err = image.ParsePlatforms(o.platformFlag)
+	if err != nil {
+		return err
+	}
+	err = image.ValidateBuildArgs(o.buildArgFlag)
+	if err != nil {
+		return err
+	}
+	err = image.ValidateSecrets(o.secretFlag)
+	if err != nil {
+		return err
+	}
+	o.load = o.loadFlag
+	if !cmdline.IsFlagSet("load") {
+		o.load = o.clientset.PreferenceClient.GetImageLoad()
+	}
 	return nil
 }
 
@@ -63,6 +101,14 @@ func (o *BuildImagesOptions) Validate(ctx context.Context) (err error) {
 
 // Run contains the logic for the odo command
 func (o *BuildImagesOptions) Run(ctx context.Context) (err error) {
+	ctx = image.WithBuildOptions(ctx, image.BuildOptions{
+		Force:     o.forceBuildFlag,
+		Platforms: o.platforms,
+		Load:      o.load,
+		BuildArgs: o.buildArgFlag,
+		Target:    o.targetFlag,
+		Secrets:   o.secretFlag,
+	})
 	return image.BuildPushImages(ctx, o.clientset.FS, o.pushFlag)
 }
 
@@ -83,7 +129,17 @@ func NewCmdBuildImages(name, fullName string) *cobra.Command {
 	util.SetCommandGroup(buildImagesCmd, util.MainGroup)
 	buildImagesCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 	buildImagesCmd.Flags().BoolVar(&o.pushFlag, "push", false, "If true, build and push the images")
-	clientset.Add(buildImagesCmd, clientset.FILESYSTEM)
+	buildImagesCmd.Flags().BoolVar(&o.loadFlag, "load", false,
+		"If true, load the images into the local cluster (kind, minikube or CRC) of the current context. Defaults to the value of the ImageLoad preference")
+	buildImagesCmd.Flags().StringVar(&o.platformFlag, "platform", "",
+		"Comma-separated list of platforms (os/arch[/variant]) to build the images for, producing manifest lists. Overrides the "+image.PlatformsAttribute+" attribute of the image components")
+	buildImagesCmd.Flags().BoolVar(&o.forceBuildFlag, "force-build", false, "Build the images even if their build inputs did not change since their last build")
+	buildImagesCmd.Flags().StringArrayVar(&o.buildArgFlag, "build-arg", nil,
+		"Build argument (KEY=VALUE) passed to the image builds, overriding the value defined in the devfile. Can be repeated")
+	buildImagesCmd.Flags().StringVar(&o.targetFlag, "target", "", "Stage of the Dockerfiles to build, overriding the target defined in the devfile")
+	buildImagesCmd.Flags().StringArrayVar(&o.secretFlag, "secret", nil,
+		"Secret (id=ID,src=PATH) exposed to the image builds with RUN --mount=type=secret, without being stored in the images. Can be repeated")
+	clientset.Add(buildImagesCmd, clientset.FILESYSTEM, clientset.PREFERENCE)
 
 	return buildImagesCmd
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/cli/add"
 	"github.com/redhat-developer/odo/pkg/odo/cli/alizer"
+	"github.com/redhat-developer/odo/pkg/odo/cli/binding"
 	"github.com/redhat-developer/odo/pkg/odo/cli/build_images"
 	"github.com/redhat-developer/odo/pkg/odo/cli/completion"
 	"github.com/redhat-developer/odo/pkg/odo/cli/create"
@@ -22,6 +23,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/cli/deploy"
 	"github.com/redhat-developer/odo/pkg/odo/cli/describe"
 	"github.com/redhat-developer/odo/pkg/odo/cli/dev"
+	"github.com/redhat-developer/odo/pkg/odo/cli/devfile"
 	_init "github.com/redhat-developer/odo/pkg/odo/cli/init"
 	"github.com/redhat-developer/odo/pkg/odo/cli/list"
 	"github.com/redhat-developer/odo/pkg/odo/cli/login"
@@ -190,6 +192,8 @@ func odoRootCmd(ctx context.Context, name, fullName string) *cobra.Command {
 		dev.NewCmdDev(dev.RecommendedCommandName, util.GetFullName(fullName, dev.RecommendedCommandName)),
 		alizer.NewCmdAlizer(alizer.RecommendedCommandName, util.GetFullName(fullName, alizer.RecommendedCommandName)),
 		describe.NewCmdDescribe(describe.RecommendedCommandName, util.GetFullName(fullName, describe.RecommendedCommandName)),
+		devfile.NewCmdDevfile(devfile.RecommendedCommandName, util.GetFullName(fullName, devfile.RecommendedCommandName)),
+		binding.NewCmdBinding(binding.RecommendedCommandName, util.GetFullName(fullName, binding.RecommendedCommandName)),
 		registry.NewCmdRegistry(registry.RecommendedCommandName, util.GetFullName(fullName, registry.RecommendedCommandName)),
 		create.NewCmdCreate(create.RecommendedCommandName, util.GetFullName(fullName, create.RecommendedCommandName)),
 		set.NewCmdSet(set.RecommendedCommandName, util.GetFullName(fullName, set.RecommendedCommandName)),
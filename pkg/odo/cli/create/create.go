@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/redhat-developer/odo/pkg/odo/cli/create/namespace"
+	"github.com/redhat-developer/odo/pkg/odo/cli/create/service"
 	"github.com/redhat-developer/odo/pkg/odo/util"
 	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
 )
@@ -17,16 +18,18 @@ const RecommendedCommandName = "create"
 func NewCmdCreate(name, fullName string) *cobra.Command {
 
 	namespaceCreateCmd := namespace.NewCmdNamespaceCreate(namespace.RecommendedCommandName, odoutil.GetFullName(fullName, namespace.RecommendedCommandName))
+	serviceCreateCmd := service.NewCmdServiceCreate(service.RecommendedCommandName, odoutil.GetFullName(fullName, service.RecommendedCommandName))
 	createCmd := &cobra.Command{
 		Use:   name + " [options]",
 		Short: "Perform create operation",
 		Long:  "Perform create operation",
-		Example: fmt.Sprintf("%s\n",
+		Example: fmt.Sprintf("%s\n\n%s\n",
 			namespaceCreateCmd.Example,
+			serviceCreateCmd.Example,
 		),
 	}
 
-	createCmd.AddCommand(namespaceCreateCmd)
+	createCmd.AddCommand(namespaceCreateCmd, serviceCreateCmd)
 
 	// Add a defined annotation in order to appear in the help menu
 	util.SetCommandGroup(createCmd, util.ManagementGroup)
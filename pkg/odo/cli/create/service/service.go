@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	dfutil "github.com/devfile/library/pkg/util"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/edit"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/service"
+	"github.com/redhat-developer/odo/pkg/service/asker"
+)
+
+// RecommendedCommandName is the recommended service command name
+const RecommendedCommandName = "service"
+
+var (
+	createExample = ktemplates.Examples(`
+	# Create a service instance in the interactive mode, guided by the schema of the service
+	%[1]s
+
+	# Create an instance named 'my-db' of the service kind 'Cluster' provided by an Operator,
+	# and add it to the Devfile of the working directory
+	%[1]s my-db --kind Cluster.postgresql.k8s.enterprisedb.io --param instances=1 --param storage.size=1Gi
+
+	# Create an instance named 'my-redis' of the service kind 'Redis' directly on the cluster
+	%[1]s my-redis --kind Redis --param kubernetesConfig.image=quay.io/opstree/redis:v6.2.5 --apply
+	`)
+
+	createLongDesc = ktemplates.LongDesc(`Create an instance of a service provided by an Operator installed in the namespace.
+
+	The instance is added to the Devfile as a Kubernetes component, and created on the cluster by "odo dev".
+	With --apply, or when the working directory does not contain a Devfile, the instance is directly created on the cluster.
+
+	Without --kind, the service kind, the name and the parameters of the instance are asked interactively,
+	based on the schema of the service.
+	`)
+
+	createShortDesc = `Create an Operator-backed service instance`
+)
+
+// ServiceCreateOptions encapsulates the options for the odo create service command
+type ServiceCreateOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+	asker     asker.Asker
+
+	// Parameters
+	name string
+
+	// Flags
+	kindFlag  string
+	paramFlag []string
+	applyFlag bool
+
+	// Variables
+	params map[string]string
+}
+
+var _ genericclioptions.Runnable = (*ServiceCreateOptions)(nil)
+
+// NewServiceCreateOptions creates a ServiceCreateOptions instance
+func NewServiceCreateOptions() *ServiceCreateOptions {
+	return &ServiceCreateOptions{
+		asker: asker.NewSurveyAsker(),
+	}
+}
+
+func (o *ServiceCreateOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes ServiceCreateOptions after they've been created
+func (o *ServiceCreateOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	if len(args) > 0 {
+		o.name = args[0]
+	}
+	o.params, err = service.ParseParams(o.paramFlag)
+	return err
+}
+
+// Validate validates the parameters of the ServiceCreateOptions
+func (o *ServiceCreateOptions) Validate(ctx context.Context) error {
+	if o.kindFlag == "" {
+		if len(o.paramFlag) > 0 {
+			return errors.New("--param can only be used with --kind")
+		}
+	} else if o.name == "" {
+		return errors.New("the name of the service instance must be specified when using --kind")
+	}
+	if o.name != "" {
+		return dfutil.ValidateK8sResourceName("service name", o.name)
+	}
+	return nil
+}
+
+// Run runs the service create command
+func (o *ServiceCreateOptions) Run(ctx context.Context) error {
+	crds, err := service.ListOperatorCRDs(o.clientset.KubernetesClient)
+	if err != nil {
+		return err
+	}
+	if len(crds) == 0 {
+		return fmt.Errorf("no Operator providing services is installed in the namespace %q", o.clientset.KubernetesClient.GetCurrentNamespace())
+	}
+
+	interactive := o.kindFlag == ""
+
+	var crd service.OperatorCRD
+	if interactive {
+		options := make([]string, 0, len(crds))
+		for _, c := range crds {
+			options = append(options, c.String())
+		}
+		var index int
+		index, err = o.asker.SelectServiceKind(options)
+		if err != nil {
+			return err
+		}
+		crd = crds[index]
+	} else {
+		crd, err = service.FindOperatorCRD(crds, o.kindFlag)
+		if err != nil {
+			return err
+		}
+	}
+
+	crdSchema := service.GetCRDSchema(o.clientset.KubernetesClient, crd)
+
+	if interactive {
+		if o.name == "" {
+			o.name, err = o.asker.AskServiceName(strings.ToLower(crd.CRD.Kind))
+			if err != nil {
+				return err
+			}
+		}
+		if crdSchema == nil {
+			log.Warningf("No schema is available for the service kind %s, the instance is created without parameters", crd.KindGroup())
+		}
+		o.params, err = service.AskParams(o.asker, crdSchema)
+		if err != nil {
+			return err
+		}
+	}
+
+	instance, err := service.BuildServiceFromParams(o.name, crd, crdSchema, o.params)
+	if err != nil {
+		return err
+	}
+
+	apply := o.applyFlag || odocontext.GetDevfileObj(ctx) == nil
+	if !apply && interactive {
+		var option asker.CreationOption
+		option, err = o.asker.SelectCreationOption()
+		if err != nil {
+			return err
+		}
+		apply = option == asker.CreateOnCluster
+	}
+
+	if apply {
+		_, err = o.clientset.KubernetesClient.PatchDynamicResource(instance)
+		if err != nil {
+			return err
+		}
+		log.Successf("The service %q has been created on the cluster", o.name)
+	} else {
+		var manifest []byte
+		manifest, err = yaml.Marshal(instance.UnstructuredContent())
+		if err != nil {
+			return err
+		}
+		err = edit.EditFile(o.clientset.FS, odocontext.GetDevfilePath(ctx), func(d *edit.Devfile) error {
+			return d.AddComponent(devfilev1.Component{
+				Name: o.name,
+				ComponentUnion: devfilev1.ComponentUnion{
+					Kubernetes: &devfilev1.KubernetesComponent{
+						K8sLikeComponent: devfilev1.K8sLikeComponent{
+							K8sLikeComponentLocation: devfilev1.K8sLikeComponentLocation{
+								Inlined: string(manifest),
+							},
+						},
+					},
+				},
+			})
+		})
+		if err != nil {
+			return err
+		}
+		log.Successf("The service %q has been added to the devfile", o.name)
+		log.Info("Run `odo dev` to create it on the cluster.")
+	}
+
+	if interactive {
+		log.Infof("You can automate this command by executing:\n  %s", o.automationCommand(crd, apply))
+	}
+	return nil
+}
+
+// automationCommand returns the non-interactive command creating the same service instance
+func (o *ServiceCreateOptions) automationCommand(crd service.OperatorCRD, apply bool) string {
+	command := fmt.Sprintf("odo create service %s --kind %s/%s", o.name, crd.KindGroup(), crd.CRD.Version)
+	keys := make([]string, 0, len(o.params))
+	for key := range o.params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		command += fmt.Sprintf(" --param '%s=%s'", key, o.params[key])
+	}
+	if apply {
+		command += " --apply"
+	}
+	return command
+}
+
+// NewCmdServiceCreate creates the service create command
+func NewCmdServiceCreate(name, fullName string) *cobra.Command {
+	o := NewServiceCreateOptions()
+	serviceCreateCmd := &cobra.Command{
+		Use:     name + " [NAME] [--kind KIND --param KEY=VALUE...]",
+		Short:   createShortDesc,
+		Long:    createLongDesc,
+		Example: fmt.Sprintf(createExample, fullName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	serviceCreateCmd.Flags().StringVar(&o.kindFlag, "kind", "",
+		"Kind of the service to create, provided by an Operator, in the format <kind>[.<group>][/<version>]")
+	serviceCreateCmd.Flags().StringArrayVar(&o.paramFlag, "param", nil,
+		"Parameter (KEY=VALUE) of the spec of the service, with the fields of the KEY separated by dots. Can be repeated")
+	serviceCreateCmd.Flags().BoolVar(&o.applyFlag, "apply", false,
+		"Create the service on the cluster instead of adding it to the Devfile")
+
+	clientset.Add(serviceCreateCmd, clientset.KUBERNETES, clientset.FILESYSTEM)
+	util.SetCommandGroup(serviceCreateCmd, util.MainGroup)
+
+	return serviceCreateCmd
+}
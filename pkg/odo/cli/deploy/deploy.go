@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/devfile/image"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/cli/messages"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
@@ -28,6 +29,20 @@ const RecommendedCommandName = "deploy"
 type DeployOptions struct {
 	// Clients
 	clientset *clientset.Clientset
+
+	// Flags
+	forceBuildFlag bool
+	platformFlag   string
+	pushFlag       bool
+	loadFlag       bool
+	buildArgFlag   []string
+	targetFlag     string
+	secretFlag     []string
+
+	// Variables
+	platforms []string
+	load      bool
+	skipPush  bool
 }
 
 var _ genericclioptions.Runnable = (*DeployOptions)(nil)
@@ -35,6 +50,18 @@ var _ genericclioptions.Runnable = (*DeployOptions)(nil)
 var deployExample = templates.Examples(`
   # Deploy components defined in the devfile
   %[1]s
+
+  # Deploy components, building images even if their build inputs did not change since their last build
+  %[1]s --force-build
+
+  # Deploy components to a local kind, minikube or CRC cluster, loading the images into the cluster instead of pushing them
+  %[1]s --push=false --load
+
+  # Deploy components, building multi-architecture images
+  %[1]s --platform linux/amd64,linux/arm64
+
+  # Deploy components, building images with a build argument overriding the devfile, and a secret
+  %[1]s --build-arg VERSION=1.2 --secret id=npmrc,src=$HOME/.npmrc
 `)
 
 // NewDeployOptions creates a new DeployOptions instance
@@ -52,6 +79,24 @@ func (o *DeployOptions) PreInit() string {
 
 // Complete DeployOptions after they've been created
 func (o *DeployOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	o.platforms, err = image.ParsePlatforms(o.platformFlag)
+	if err != nil {
+		return err
+	}
+	err = image.ValidateBuildArgs(o.buildArgFlag)
+	if err != nil {
+		return err
+	}
+	err = image.ValidateSecrets(o.secretFlag)
+	if err != nil {
+		return err
+	}
+	o.load = o.loadFlag
+	if !cmdline.IsFlagSet("load") {
+		o.load = o.clientset.PreferenceClient.GetImageLoad()
+	}
+	// When the images are loaded into the cluster, they are not pushed, unless explicitly requested
+	o.skipPush = !o.pushFlag || (o.load && !cmdline.IsFlagSet("push"))
 	return nil
 }
 
@@ -81,6 +126,16 @@ func (o *DeployOptions) Run(ctx context.Context) error {
 		"Namespace: "+namespace,
 		"odo version: "+version.VERSION)
 
+	ctx = image.WithBuildOptions(ctx, image.BuildOptions{
+		Force:     o.forceBuildFlag,
+		Platforms: o.platforms,
+		Load:      o.load,
+		SkipPush:  o.skipPush,
+		BuildArgs: o.buildArgFlag,
+		Target:    o.targetFlag,
+		Secrets:   o.secretFlag,
+	})
+
 	// Run actual deploy command to be used
 	err := o.clientset.DeployClient.Deploy(ctx)
 
@@ -104,7 +159,22 @@ func NewCmdDeploy(name, fullName string) *cobra.Command {
 			return genericclioptions.GenericRun(o, cmd, args)
 		},
 	}
-	clientset.Add(deployCmd, clientset.INIT, clientset.DEPLOY, clientset.FILESYSTEM)
+	deployCmd.Flags().BoolVar(&o.pushFlag, "push", true, "If false, do not push the images to their registries")
+	deployCmd.Flags().BoolVar(&o.loadFlag, "load", false,
+		"If true, load the images into the local cluster (kind, minikube or CRC) of the current context. Defaults to the value of the ImageLoad preference")
+	deployCmd.Flags().StringVar(&o.platformFlag, "platform", "",
+		"Comma-separated list of platforms (os/arch[/variant]) to build the images for. Overrides the "+image.PlatformsAttribute+" attribute of the image components")
+	deployCmd.Flags().BoolVar(&o.forceBuildFlag, "force-build", false, "Build the images even if their build inputs did not change since their last build")
+	deployCmd.Flags().StringArrayVar(&o.buildArgFlag, "build-arg", nil,
+		"Build argument (KEY=VALUE) passed to the image builds, overriding the value defined in the devfile. Can be repeated")
+	deployCmd.Flags().StringVar(&o.targetFlag, "target", "", "Stage of the Dockerfiles to build, overriding the target defined in the devfile")
+	deployCmd.Flags().StringArrayVar(&o.secretFlag, "secret", nil,
+		"Secret (id=ID,src=PATH) exposed to the image builds with RUN --mount=type=secret, without being stored in the images. Can be repeated")
+	clientset.Add(deployCmd, clientset.INIT, clientset.DEPLOY, clientset.FILESYSTEM, clientset.PREFERENCE)
+
+	historyCmd := NewCmdHistory(HistoryRecommendedCommandName, util.GetFullName(fullName, HistoryRecommendedCommandName))
+	rollbackCmd := NewCmdRollback(RollbackRecommendedCommandName, util.GetFullName(fullName, RollbackRecommendedCommandName))
+	deployCmd.AddCommand(historyCmd, rollbackCmd)
 
 	// Add a defined annotation in order to appear in the help menu
 	util.SetCommandGroup(deployCmd, util.MainGroup)
@@ -0,0 +1,142 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+)
+
+// HistoryRecommendedCommandName is the recommended history sub-command name
+const HistoryRecommendedCommandName = "history"
+
+var historyExample = templates.Examples(`
+  # List the revisions deployed for the component
+  %[1]s
+`)
+
+// HistoryOptions encapsulates the options for the odo deploy history command
+type HistoryOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+}
+
+var _ genericclioptions.Runnable = (*HistoryOptions)(nil)
+var _ genericclioptions.JsonOutputter = (*HistoryOptions)(nil)
+
+// NewHistoryOptions creates a new HistoryOptions instance
+func NewHistoryOptions() *HistoryOptions {
+	return &HistoryOptions{}
+}
+
+func (o *HistoryOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete HistoryOptions after they've been created
+func (o *HistoryOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	return nil
+}
+
+// Validate validates the HistoryOptions based on completed values
+func (o *HistoryOptions) Validate(ctx context.Context) error {
+	devfileObj := odocontext.GetDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	return nil
+}
+
+// Run contains the logic for the odo command
+func (o *HistoryOptions) Run(ctx context.Context) error {
+	revisions, err := o.clientset.DeployClient.History(ctx)
+	if err != nil {
+		return err
+	}
+	if len(revisions) == 0 {
+		log.Infof("No deploy revision recorded for the component %q. Run `odo deploy` first.", odocontext.GetComponentName(ctx))
+		return nil
+	}
+	humanReadableHistory(revisions)
+	return nil
+}
+
+// RunForJsonOutput contains the logic for the JSON output of the odo command
+func (o *HistoryOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	return o.clientset.DeployClient.History(ctx)
+}
+
+func humanReadableHistory(revisions []api.DeployRevision) {
+	t := ui.NewTable()
+	t.AppendHeader(table.Row{"REVISION", "DEPLOYED", "USER", "DEVFILE", "IMAGES", "DESCRIPTION"})
+	for _, rev := range revisions {
+		description := rev.Description
+		if description == "" {
+			description = "Deploy"
+		}
+		t.AppendRow(table.Row{
+			strconv.Itoa(rev.Revision),
+			rev.Timestamp.Local().Format(time.RFC3339),
+			rev.User,
+			shortHash(rev.DevfileHash),
+			formatImages(rev.Images),
+			description,
+		})
+	}
+	t.Render()
+}
+
+// shortHash returns the first characters of the hash, enough to differentiate Devfiles
+func shortHash(hash string) string {
+	if len(hash) > 12 {
+		return hash[:12]
+	}
+	return hash
+}
+
+// formatImages returns the images, one per line, with their digests when they are known
+func formatImages(images map[string]string) string {
+	lines := make([]string, 0, len(images))
+	for name, repoDigest := range images {
+		if repoDigest != "" {
+			lines = append(lines, fmt.Sprintf("%s (%s)", name, repoDigest[strings.LastIndex(repoDigest, "@")+1:]))
+			continue
+		}
+		lines = append(lines, name)
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
+// NewCmdHistory implements the odo deploy history command
+func NewCmdHistory(name, fullName string) *cobra.Command {
+	o := NewHistoryOptions()
+	historyCmd := &cobra.Command{
+		Use:     name,
+		Short:   "List the deploy revisions of the component",
+		Long:    "List the revisions recorded in the cluster each time the component is deployed",
+		Example: fmt.Sprintf(historyExample, fullName),
+		Args:    genericclioptions.NoArgsAndSilenceJSON,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(historyCmd, clientset.DEPLOY)
+	commonflags.UseOutputFlag(historyCmd)
+	return historyCmd
+}
@@ -0,0 +1,102 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/version"
+)
+
+// RollbackRecommendedCommandName is the recommended rollback sub-command name
+const RollbackRecommendedCommandName = "rollback"
+
+var rollbackExample = templates.Examples(`
+  # Deploy again the revision preceding the latest one
+  %[1]s
+
+  # Deploy again the revision 3, as listed by odo deploy history
+  %[1]s --to 3
+`)
+
+// RollbackOptions encapsulates the options for the odo deploy rollback command
+type RollbackOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	toFlag int
+}
+
+var _ genericclioptions.Runnable = (*RollbackOptions)(nil)
+
+// NewRollbackOptions creates a new RollbackOptions instance
+func NewRollbackOptions() *RollbackOptions {
+	return &RollbackOptions{}
+}
+
+func (o *RollbackOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete RollbackOptions after they've been created
+func (o *RollbackOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	return nil
+}
+
+// Validate validates the RollbackOptions based on completed values
+func (o *RollbackOptions) Validate(ctx context.Context) error {
+	devfileObj := odocontext.GetDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	if o.toFlag < 0 {
+		return errors.New("the revision passed with --to must be a positive number")
+	}
+	return nil
+}
+
+// Run contains the logic for the odo command
+func (o *RollbackOptions) Run(ctx context.Context) error {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		namespace     = odocontext.GetNamespace(ctx)
+	)
+	log.Title("Rolling back the deployment of the "+componentName+" component",
+		"Namespace: "+namespace,
+		"odo version: "+version.VERSION)
+
+	rev, err := o.clientset.DeployClient.Rollback(ctx, o.toFlag)
+	if err != nil {
+		return err
+	}
+	log.Infof("\n%s, recorded as revision %d", rev.Description, rev.Revision)
+	return nil
+}
+
+// NewCmdRollback implements the odo deploy rollback command
+func NewCmdRollback(name, fullName string) *cobra.Command {
+	o := NewRollbackOptions()
+	rollbackCmd := &cobra.Command{
+		Use:   name,
+		Short: "Roll back the deployment of the component",
+		Long: "Deploy again the resources of a revision recorded by odo deploy, and delete the deployed resources which are not part of this revision.\n" +
+			"The images are deployed using the digests recorded with the revision, when known.",
+		Example: fmt.Sprintf(rollbackExample, fullName),
+		Args:    cobra.MaximumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	rollbackCmd.Flags().IntVar(&o.toFlag, "to", 0, "Revision to roll back to. Defaults to the revision preceding the latest one")
+	clientset.Add(rollbackCmd, clientset.DEPLOY)
+	return rollbackCmd
+}
@@ -2,6 +2,7 @@ package describe
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -10,6 +11,8 @@ import (
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/binding"
+	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
@@ -27,11 +30,23 @@ var describeBindingExample = ktemplates.Examples(`
 
 # Describe a binding on the cluster
 %[1]s --name frontend
+
+# Describe the bindings in the current devfile, with the values read from the running component, masked
+%[1]s --show-values
+
+# Describe the bindings in the current devfile, with the values read from the container 'runtime' of the running component
+%[1]s --show-values --reveal --container runtime
 `)
 
 type BindingOptions struct {
 	// nameFlag of the component to describe, optional
 	nameFlag string
+	// showValuesFlag indicates to read the values of the bindings from the running component
+	showValuesFlag bool
+	// revealFlag indicates to display the values of the bindings, instead of masking them
+	revealFlag bool
+	// containerFlag is the container of the component from which the values are read, optional
+	containerFlag string
 
 	// Clients
 	clientset *clientset.Clientset
@@ -61,6 +76,12 @@ func (o *BindingOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline,
 }
 
 func (o *BindingOptions) Validate(ctx context.Context) (err error) {
+	if o.showValuesFlag && o.nameFlag != "" {
+		return errors.New("--show-values cannot be used with --name, the values are read from the component of the current devfile")
+	}
+	if !o.showValuesFlag && (o.revealFlag || o.containerFlag != "") {
+		return errors.New("--reveal and --container can only be used with --show-values")
+	}
 	return nil
 }
 
@@ -96,7 +117,37 @@ func (o *BindingOptions) runWithoutName(ctx context.Context) ([]api.ServiceBindi
 		devfileObj = odocontext.GetDevfileObj(ctx)
 	)
 
-	return o.clientset.BindingClient.GetBindingsFromDevfile(*devfileObj, workingDir)
+	bindings, err := o.clientset.BindingClient.GetBindingsFromDevfile(*devfileObj, workingDir)
+	if err != nil || !o.showValuesFlag {
+		return bindings, err
+	}
+	return bindings, o.getBindingValues(ctx, bindings)
+}
+
+// getBindingValues reads the values of the bindings from the container of the running component
+func (o *BindingOptions) getBindingValues(ctx context.Context, bindings []api.ServiceBinding) error {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+		devfileObj    = odocontext.GetDevfileObj(ctx)
+	)
+
+	if o.clientset.KubernetesClient == nil {
+		return errors.New("unable to access the cluster, the values of the bindings cannot be read from the running component")
+	}
+
+	pod, containerName, err := component.GetDevContainer(o.clientset.KubernetesClient, *devfileObj, componentName, appName, o.containerFlag)
+	if err != nil {
+		return err
+	}
+
+	for i := range bindings {
+		err = binding.GetBindingValues(o.clientset.KubernetesClient, pod.Name, containerName, bindings[i].Status, o.revealFlag)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (o *BindingOptions) runWithName() (api.ServiceBinding, error) {
@@ -118,7 +169,12 @@ func NewCmdBinding(name, fullName string) *cobra.Command {
 		},
 	}
 	bindingCmd.Flags().StringVar(&o.nameFlag, "name", "", "Name of the binding to describe, optional. By default, the bindings in the local devfile are described")
-	clientset.Add(bindingCmd, clientset.KUBERNETES, clientset.BINDING, clientset.FILESYSTEM)
+	bindingCmd.Flags().BoolVar(&o.showValuesFlag, "show-values", false,
+		"Show the values of the binding files and environment variables, read from the running component. The values are masked, unless --reveal is used")
+	bindingCmd.Flags().BoolVar(&o.revealFlag, "reveal", false, "Display the values of the bindings in clear text, only with --show-values")
+	bindingCmd.Flags().StringVar(&o.containerFlag, "container", "",
+		"Container of the component from which the values are read, only with --show-values. By default, the container running the application")
+	clientset.Add(bindingCmd, clientset.KUBERNETES, clientset.BINDING, clientset.EXEC, clientset.FILESYSTEM)
 	commonflags.UseOutputFlag(bindingCmd)
 
 	return bindingCmd
@@ -130,12 +186,19 @@ func printSingleBindingHumanReadableOutput(binding api.ServiceBinding) bool {
 	log.Info("Services:")
 	for _, service := range binding.Spec.Services {
 		gvk := schema.FromAPIVersionAndKind(service.APIVersion, service.Kind)
+		kindGroup := gvk.Kind
+		if gvk.Group != "" {
+			kindGroup += "." + gvk.Group
+		}
 		if service.Namespace != "" {
-			log.Printf("%s (%s.%s) (namespace: %s)", service.Name, gvk.Kind, gvk.Group, service.Namespace)
+			log.Printf("%s (%s) (namespace: %s)", service.Name, kindGroup, service.Namespace)
 		} else {
-			log.Printf("%s (%s.%s)", service.Name, gvk.Kind, gvk.Group)
+			log.Printf("%s (%s)", service.Name, kindGroup)
 		}
 	}
+	if binding.Spec.Native {
+		log.Describef("Binding mode: ", "native, projected by odo")
+	}
 	log.Describef("Bind as files: ", strconv.FormatBool(binding.Spec.BindAsFiles))
 	log.Describef("Detect binding resources: ", strconv.FormatBool(binding.Spec.DetectBindingResources))
 
@@ -147,6 +210,17 @@ func printSingleBindingHumanReadableOutput(binding api.ServiceBinding) bool {
 		log.Describef("Available binding information: ", "unknown")
 		return true
 	}
+	if len(binding.Status.Values) > 0 {
+		log.Infof("Available binding information (values in the container %q):", binding.Status.ValuesContainer)
+		for _, value := range binding.Status.Values {
+			if value.Missing {
+				log.Printf("%s: missing in the container", value.Name)
+				continue
+			}
+			log.Printf("%s: %s", value.Name, value.Value)
+		}
+		return false
+	}
 	log.Info("Available binding information:")
 	for _, info := range binding.Status.BindingFiles {
 		log.Printf(info)
@@ -5,11 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/pkg/devfile/parser"
 	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
 	"github.com/spf13/cobra"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"k8s.io/apimachinery/pkg/util/duration"
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 
 	"github.com/redhat-developer/odo/pkg/api"
@@ -134,16 +138,22 @@ func (o *ComponentOptions) describeNamedComponent(ctx context.Context, name stri
 	if err != nil {
 		return api.Component{}, nil, fmt.Errorf("failed to get ingresses/routes: %w", err)
 	}
+	runtimeStatus, err := component.GetRuntimeStatus(ctx, o.clientset.KubernetesClient, name)
+	if err != nil {
+		err = clierrors.NewWarning("failed to get the runtime status", err)
+		// Do not return the error yet, as it is only a warning
+	}
 
 	return api.Component{
 		DevfileData: &api.DevfileData{
 			Devfile: devfile.Data,
 		},
-		RunningIn: runningIn,
-		ManagedBy: "odo",
-		Ingresses: ingresses,
-		Routes:    routes,
-	}, &devfile, nil
+		RunningIn:     runningIn,
+		ManagedBy:     "odo",
+		Ingresses:     ingresses,
+		Routes:        routes,
+		RuntimeStatus: runtimeStatus,
+	}, &devfile, err
 }
 
 // describeDevfileComponent describes the component defined by the devfile in the current directory
@@ -173,6 +183,15 @@ func (o *ComponentOptions) describeDevfileComponent(ctx context.Context) (result
 		err = clierrors.NewWarning("failed to get ingresses/routes", err)
 		// Do not return the error yet, as it is only a warning
 	}
+	runtimeStatus, runtimeErr := component.GetRuntimeStatus(ctx, o.clientset.KubernetesClient, componentName)
+	if runtimeErr != nil {
+		if err == nil {
+			err = clierrors.NewWarning("failed to get the runtime status", runtimeErr)
+		} else {
+			// keep both warnings
+			err = clierrors.NewWarning(err.Error()+", and failed to get the runtime status", runtimeErr)
+		}
+	}
 
 	return api.Component{
 		DevfilePath:       devfilePath,
@@ -182,6 +201,7 @@ func (o *ComponentOptions) describeDevfileComponent(ctx context.Context) (result
 		ManagedBy:         "odo",
 		Ingresses:         ingresses,
 		Routes:            routes,
+		RuntimeStatus:     runtimeStatus,
 	}, devfileObj, err
 }
 
@@ -260,9 +280,80 @@ func printHumanReadableOutput(cmp api.Component, devfileObj *parser.DevfileObj)
 		fmt.Println()
 	}
 
+	if cmp.RuntimeStatus != nil {
+		printRuntimeStatus(*cmp.RuntimeStatus)
+	}
+
 	return nil
 }
 
+func printRuntimeStatus(status api.RuntimeStatus) {
+	for _, mode := range []api.RunningMode{api.RunningModeDev, api.RunningModeDeploy} {
+		var pods []api.PodStatus
+		for _, pod := range status.Pods {
+			if pod.Mode == mode {
+				pods = append(pods, pod)
+			}
+		}
+		if len(pods) == 0 {
+			continue
+		}
+		log.Info(cases.Title(language.Und).String(string(mode)) + " pods:")
+		for _, pod := range pods {
+			var ready int
+			for _, container := range pod.Containers {
+				if container.Ready {
+					ready++
+				}
+			}
+			log.Printf("%s: %s, %d/%d containers ready", pod.Name, pod.Phase, ready, len(pod.Containers))
+			for _, container := range pod.Containers {
+				log.Printf("%s/%s: %s", pod.Name, container.Name, getContainerStatusDescription(container))
+			}
+		}
+		fmt.Println()
+	}
+
+	if len(status.Events) != 0 {
+		log.Info("Recent warning events:")
+		for _, event := range status.Events {
+			msg := fmt.Sprintf("%s ago, %s, %s: %s", duration.HumanDuration(time.Since(event.LastTimestamp)), event.Object, event.Reason, event.Message)
+			if event.Count > 1 {
+				msg += fmt.Sprintf(" (x%d)", event.Count)
+			}
+			log.Printf("%s", msg)
+		}
+		fmt.Println()
+	}
+}
+
+func getContainerStatusDescription(container api.ContainerStatus) string {
+	state := container.State
+	if state == "" {
+		state = "unknown"
+	}
+	if container.StateReason != "" {
+		state += " (" + container.StateReason + ")"
+	}
+	parts := []string{state}
+	if container.Ready {
+		parts = append(parts, "ready")
+	} else {
+		parts = append(parts, "not ready")
+	}
+	restarts := fmt.Sprintf("%d restarts", container.RestartCount)
+	if container.LastTerminationReason != "" {
+		restarts += fmt.Sprintf(" (last termination: %s)", container.LastTerminationReason)
+	}
+	parts = append(parts, restarts)
+	if container.Usage != nil {
+		parts = append(parts,
+			fmt.Sprintf("CPU: %dm", container.Usage.CPUMillicores),
+			fmt.Sprintf("memory: %dMi", container.Usage.MemoryBytes/(1024*1024)))
+	}
+	return strings.Join(parts, ", ")
+}
+
 func listComponentsNames(title string, devfileObj *parser.DevfileObj, typ v1alpha2.ComponentType) error {
 	if devfileObj == nil {
 		log.Describef(title, " Unknown")
@@ -12,6 +12,7 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/devfile/image"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
 	clierrors "github.com/redhat-developer/odo/pkg/odo/cli/errors"
@@ -49,11 +50,13 @@ type DevOptions struct {
 	cancel context.CancelFunc
 
 	// Flags
-	noWatchFlag      bool
-	randomPortsFlag  bool
-	debugFlag        bool
-	buildCommandFlag string
-	runCommandFlag   string
+	noWatchFlag         bool
+	randomPortsFlag     bool
+	debugFlag           bool
+	buildCommandFlag    string
+	runCommandFlag      string
+	forceBuildFlag      bool
+	forwardBindingsFlag bool
 }
 
 var _ genericclioptions.Runnable = (*DevOptions)(nil)
@@ -87,7 +90,13 @@ func (o *DevOptions) PreInit() string {
 
 func (o *DevOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
 	// Define this first so that if user hits Ctrl+c very soon after running odo dev, odo doesn't panic
-	o.ctx, o.cancel = context.WithCancel(ctx)
+	// With the ImageLoad preference, images are loaded into the local cluster instead of being pushed
+	load := o.clientset.PreferenceClient.GetImageLoad()
+	o.ctx, o.cancel = context.WithCancel(image.WithBuildOptions(ctx, image.BuildOptions{
+		Force:    o.forceBuildFlag,
+		Load:     load,
+		SkipPush: load,
+	}))
 
 	return nil
 }
@@ -111,6 +120,12 @@ func (o *DevOptions) Validate(ctx context.Context) error {
 		if o.clientset.PodmanClient == nil {
 			return errors.New("unable to access podman. Do you have podman client installed?")
 		}
+		if o.forwardBindingsFlag && o.clientset.KubernetesClient == nil {
+			return errors.New("unable to access the cluster, --forward-bindings needs a connection to the cluster running the bound services")
+		}
+	}
+	if o.forwardBindingsFlag && platform != commonflags.RunOnPodman {
+		return errors.New("--forward-bindings can only be used when running on podman")
 	}
 	return nil
 }
@@ -177,13 +192,14 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 		o.out,
 		o.errOut,
 		dev.StartOptions{
-			IgnorePaths:  o.ignorePaths,
-			Debug:        o.debugFlag,
-			BuildCommand: o.buildCommandFlag,
-			RunCommand:   o.runCommandFlag,
-			RandomPorts:  o.randomPortsFlag,
-			WatchFiles:   !o.noWatchFlag,
-			Variables:    variables,
+			IgnorePaths:     o.ignorePaths,
+			Debug:           o.debugFlag,
+			BuildCommand:    o.buildCommandFlag,
+			RunCommand:      o.runCommandFlag,
+			RandomPorts:     o.randomPortsFlag,
+			WatchFiles:      !o.noWatchFlag,
+			Variables:       variables,
+			ForwardBindings: o.forwardBindingsFlag,
 		},
 	)
 }
@@ -223,6 +239,10 @@ It forwards endpoints with any exposure values ('public', 'internal' or 'none')
 		"Alternative build command. The default one will be used if this flag is not set.")
 	devCmd.Flags().StringVar(&o.runCommandFlag, "run-command", "",
 		"Alternative run command to execute. The default one will be used if this flag is not set.")
+	devCmd.Flags().BoolVar(&o.forceBuildFlag, "force-build", false,
+		"Build the images even if their build inputs did not change since their last build")
+	devCmd.Flags().BoolVar(&o.forwardBindingsFlag, "forward-bindings", false,
+		"Forward local ports to the services bound by the ServiceBindings resolved from the cluster, when running on podman")
 	clientset.Add(devCmd,
 		clientset.BINDING,
 		clientset.DEV,
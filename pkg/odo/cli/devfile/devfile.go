@@ -0,0 +1,39 @@
+package devfile
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/redhat-developer/odo/pkg/odo/cli/devfile/migrate"
+	"github.com/redhat-developer/odo/pkg/odo/cli/devfile/upgrade"
+	"github.com/redhat-developer/odo/pkg/odo/cli/devfile/validate"
+	"github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended devfile command name
+const RecommendedCommandName = "devfile"
+
+// NewCmdDevfile implements the devfile odo command
+func NewCmdDevfile(name, fullName string) *cobra.Command {
+	upgradeCmd := upgrade.NewCmdUpgrade(upgrade.RecommendedCommandName, util.GetFullName(fullName, upgrade.RecommendedCommandName))
+	validateCmd := validate.NewCmdValidate(validate.RecommendedCommandName, util.GetFullName(fullName, validate.RecommendedCommandName))
+	migrateCmd := migrate.NewCmdMigrate(migrate.RecommendedCommandName, util.GetFullName(fullName, migrate.RecommendedCommandName))
+	devfileCmd := &cobra.Command{
+		Use:   name,
+		Short: "Manage the devfile of the component",
+		Long:  "Manage the devfile of the component",
+		Example: fmt.Sprintf("%s\n%s\n%s\n",
+			upgradeCmd.Example,
+			validateCmd.Example,
+			migrateCmd.Example,
+		),
+	}
+
+	devfileCmd.AddCommand(upgradeCmd, validateCmd, migrateCmd)
+
+	util.SetCommandGroup(devfileCmd, util.ManagementGroup)
+	devfileCmd.SetUsageTemplate(util.CmdUsageTemplate)
+
+	return devfileCmd
+}
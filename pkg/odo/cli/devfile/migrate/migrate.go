@@ -0,0 +1,155 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/devfile/migrate"
+	"github.com/redhat-developer/odo/pkg/devfile/validate"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "migrate"
+
+var migrateExample = ktemplates.Examples(`
+  # Migrate the devfile to the latest schema version supported by odo
+  %[1]s
+
+  # Migrate the devfile to a specific schema version
+  %[1]s --schema-version 2.1.0
+
+  # Display the changes without modifying the devfile
+  %[1]s --dry-run
+`)
+
+// MigrateOptions encapsulates the options for the odo devfile migrate command
+type MigrateOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Variables
+	devfilePath string
+
+	// Flags
+	schemaVersionFlag string
+	dryRunFlag        bool
+}
+
+var (
+	_ genericclioptions.Runnable      = (*MigrateOptions)(nil)
+	_ genericclioptions.DevfileParser = (*MigrateOptions)(nil)
+)
+
+// NewMigrateOptions creates a new MigrateOptions instance
+func NewMigrateOptions() *MigrateOptions {
+	return &MigrateOptions{}
+}
+
+func (o *MigrateOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// ParsesDevfile indicates that the devfile is parsed by the command, as the devfile may not be valid
+// for the schema version it declares
+func (o *MigrateOptions) ParsesDevfile() {}
+
+// Complete completes MigrateOptions after they've been created
+func (o *MigrateOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	o.devfilePath = location.DevfileLocation(odocontext.GetWorkingDirectory(ctx))
+	return nil
+}
+
+// Validate validates the MigrateOptions based on completed values
+func (o *MigrateOptions) Validate(ctx context.Context) (err error) {
+	if _, err = o.clientset.FS.Stat(o.devfilePath); err != nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	return nil
+}
+
+// Run contains the logic for the odo devfile migrate command
+func (o *MigrateOptions) Run(ctx context.Context) (err error) {
+	content, err := o.clientset.FS.ReadFile(o.devfilePath)
+	if err != nil {
+		return err
+	}
+	result, err := migrate.Migrate(content, o.schemaVersionFlag)
+	if err != nil {
+		return err
+	}
+
+	if len(result.Changes) == 0 {
+		log.Infof("The devfile already uses the schema version %s", o.schemaVersionFlag)
+	} else {
+		diff, err := migrate.Diff(filepath.Base(o.devfilePath), content, result.Content)
+		if err != nil {
+			return err
+		}
+		log.Println()
+		fmt.Fprint(log.GetStdout(), diff)
+		log.Println()
+		for _, change := range result.Changes {
+			log.Printf("%s", change)
+		}
+	}
+	for _, warning := range result.Warnings {
+		log.Warning(warning)
+	}
+
+	if len(result.Changes) == 0 {
+		return nil
+	}
+	if o.dryRunFlag {
+		log.Infof("The devfile has not been modified (dry run)")
+		return nil
+	}
+	info, err := o.clientset.FS.Stat(o.devfilePath)
+	if err != nil {
+		return err
+	}
+	err = o.clientset.FS.WriteFile(o.devfilePath, result.Content, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if validate.HasErrors(validate.DiagnoseContent(o.devfilePath, result.Content)) {
+		log.Warning("The migrated devfile has errors, run `odo devfile validate` to display them")
+	}
+	log.Successf("The devfile has been migrated to the schema version %s", o.schemaVersionFlag)
+	return nil
+}
+
+// NewCmdMigrate implements the odo devfile migrate command
+func NewCmdMigrate(name, fullName string) *cobra.Command {
+	o := NewMigrateOptions()
+	migrateCmd := &cobra.Command{
+		Use:   name,
+		Short: "Migrate the devfile to a newer schema version",
+		Long: `Migrate the devfile to a newer version of the Devfile schema.
+
+The fields replaced by other fields in the new schema version are converted, and the fields removed from the schema
+without equivalent are removed and reported.
+The fields ignored by odo, which may behave differently than with other tools, are also reported.`,
+		Example: fmt.Sprintf(migrateExample, fullName),
+		Args:    cobra.MaximumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	migrateCmd.Flags().StringVar(&o.schemaVersionFlag, "schema-version", migrate.SchemaVersions[len(migrate.SchemaVersions)-1],
+		"Version of the Devfile schema to migrate to")
+	migrateCmd.Flags().BoolVar(&o.dryRunFlag, "dry-run", false, "If true, only display the changes, without modifying the devfile")
+	clientset.Add(migrateCmd, clientset.FILESYSTEM)
+	migrateCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return migrateCmd
+}
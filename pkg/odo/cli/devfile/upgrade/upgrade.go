@@ -0,0 +1,171 @@
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/upgrade"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "upgrade"
+
+var upgradeExample = ktemplates.Examples(`
+  # Upgrade the devfile to the latest version of its stack
+  %[1]s
+
+  # Upgrade the devfile to a specific version of its stack
+  %[1]s --devfile-version 2.1.1
+
+  # Display the changes without modifying the devfile
+  %[1]s --dry-run
+
+  # Upgrade the devfile, specifying its stack and registry
+  %[1]s --devfile nodejs --devfile-registry DefaultDevfileRegistry
+`)
+
+// UpgradeOptions encapsulates the options for the odo devfile upgrade command
+type UpgradeOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	devfileFlag         string
+	devfileRegistryFlag string
+	devfileVersionFlag  string
+	dryRunFlag          bool
+}
+
+var _ genericclioptions.Runnable = (*UpgradeOptions)(nil)
+
+// NewUpgradeOptions creates a new UpgradeOptions instance
+func NewUpgradeOptions() *UpgradeOptions {
+	return &UpgradeOptions{}
+}
+
+func (o *UpgradeOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes UpgradeOptions after they've been created
+func (o *UpgradeOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	return nil
+}
+
+// Validate validates the UpgradeOptions based on completed values
+func (o *UpgradeOptions) Validate(ctx context.Context) (err error) {
+	devfileObj := odocontext.GetDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	if devfileObj.Data.GetMetadata().Version == "" {
+		return errors.New("the version of the stack the devfile has been created from is not recorded in metadata.version, the devfile cannot be upgraded")
+	}
+	return nil
+}
+
+// Run contains the logic for the odo devfile upgrade command
+func (o *UpgradeOptions) Run(ctx context.Context) (err error) {
+	var (
+		devfilePath = odocontext.GetDevfilePath(ctx)
+		metadata    = odocontext.GetDevfileObj(ctx).Data.GetMetadata()
+	)
+
+	stack, err := upgrade.FindStack(ctx, o.clientset.RegistryClient, o.devfileRegistryFlag, o.devfileFlag, metadata)
+	if err != nil {
+		return err
+	}
+	targetVersion, err := upgrade.ResolveVersion(stack, o.devfileVersionFlag)
+	if err != nil {
+		return err
+	}
+	if targetVersion == metadata.Version {
+		log.Infof("The devfile already uses the version %s of the stack %q", targetVersion, stack.Name)
+		return nil
+	}
+
+	spinner := log.Spinnerf("Fetching the versions %s and %s of the stack %q from registry %q", metadata.Version, targetVersion, stack.Name, stack.Registry.Name)
+	base, err := upgrade.PullDevfile(ctx, o.clientset.RegistryClient, stack, metadata.Version)
+	if err != nil {
+		spinner.End(false)
+		return fmt.Errorf("unable to get the version %s of the stack the devfile has been created from: %w", metadata.Version, err)
+	}
+	target, err := upgrade.PullDevfile(ctx, o.clientset.RegistryClient, stack, targetVersion)
+	if err != nil {
+		spinner.End(false)
+		return err
+	}
+	spinner.End(true)
+
+	local, err := o.clientset.FS.ReadFile(devfilePath)
+	if err != nil {
+		return err
+	}
+	merged, conflicts, err := upgrade.Merge(base, local, target)
+	if err != nil {
+		return err
+	}
+
+	diff, err := upgrade.Diff(filepath.Base(devfilePath), local, merged)
+	if err != nil {
+		return err
+	}
+	log.Println()
+	fmt.Fprint(log.GetStdout(), diff)
+	log.Println()
+
+	for _, conflict := range conflicts {
+		log.Warningf("Conflict on %s, the local value is kept", conflict)
+	}
+
+	if o.dryRunFlag {
+		log.Infof("The devfile has not been modified (dry run)")
+		return nil
+	}
+	info, err := o.clientset.FS.Stat(devfilePath)
+	if err != nil {
+		return err
+	}
+	err = o.clientset.FS.WriteFile(devfilePath, merged, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	log.Successf("The devfile has been upgraded from version %s to version %s of the stack %q", metadata.Version, targetVersion, stack.Name)
+	return nil
+}
+
+// NewCmdUpgrade implements the odo devfile upgrade command
+func NewCmdUpgrade(name, fullName string) *cobra.Command {
+	o := NewUpgradeOptions()
+	upgradeCmd := &cobra.Command{
+		Use:   name,
+		Short: "Upgrade the devfile to another version of its stack",
+		Long: `Upgrade the devfile to another version of its stack.
+
+The devfile is merged with the new version of the stack, using the version of the stack recorded in its metadata as the merge base.
+The changes made to the devfile (endpoints, environment variables, variables, ...) are kept.`,
+		Example: fmt.Sprintf(upgradeExample, fullName),
+		Args:    cobra.MaximumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	upgradeCmd.Flags().StringVar(&o.devfileFlag, "devfile", "", "Name of the stack of the devfile in the registry; by default, it is determined from the metadata of the devfile")
+	upgradeCmd.Flags().StringVar(&o.devfileRegistryFlag, "devfile-registry", "", "Name of the registry of the stack (as configured in \"odo preference view\")")
+	upgradeCmd.Flags().StringVar(&o.devfileVersionFlag, "devfile-version", "latest", "Version of the stack to upgrade to")
+	upgradeCmd.Flags().BoolVar(&o.dryRunFlag, "dry-run", false, "If true, only display the changes, without modifying the devfile")
+	clientset.Add(upgradeCmd, clientset.FILESYSTEM, clientset.REGISTRY)
+	upgradeCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return upgradeCmd
+}
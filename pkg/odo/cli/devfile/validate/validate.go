@@ -0,0 +1,159 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/devfile/validate"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "validate"
+
+var validateExample = ktemplates.Examples(`
+  # Validate the devfile of the current directory
+  %[1]s
+
+  # Validate a devfile
+  %[1]s path/to/devfile.yaml
+
+  # Validate the devfile and get the problems found in JSON format
+  %[1]s -o json
+`)
+
+// ValidateOptions encapsulates the options for the odo devfile validate command
+type ValidateOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Variables
+	devfilePath string
+}
+
+var (
+	_ genericclioptions.Runnable      = (*ValidateOptions)(nil)
+	_ genericclioptions.JsonOutputter = (*ValidateOptions)(nil)
+	_ genericclioptions.DevfileParser = (*ValidateOptions)(nil)
+)
+
+// NewValidateOptions creates a new ValidateOptions instance
+func NewValidateOptions() *ValidateOptions {
+	return &ValidateOptions{}
+}
+
+func (o *ValidateOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// ParsesDevfile indicates that the devfile is parsed by the command, to report all its problems
+func (o *ValidateOptions) ParsesDevfile() {}
+
+// Complete completes ValidateOptions after they've been created
+func (o *ValidateOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	path := odocontext.GetWorkingDirectory(ctx)
+	if len(args) > 0 {
+		path = args[0]
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(odocontext.GetWorkingDirectory(ctx), path)
+		}
+	}
+	info, err := o.clientset.FS.Stat(path)
+	if err != nil {
+		return err
+	}
+	o.devfilePath = path
+	if info.IsDir() {
+		o.devfilePath = location.DevfileLocation(path)
+	}
+	return nil
+}
+
+// Validate validates the ValidateOptions based on completed values
+func (o *ValidateOptions) Validate(ctx context.Context) (err error) {
+	if _, err = o.clientset.FS.Stat(o.devfilePath); err != nil {
+		return genericclioptions.NewNoDevfileError(filepath.Dir(o.devfilePath))
+	}
+	return nil
+}
+
+// Run contains the logic for the odo devfile validate command
+func (o *ValidateOptions) Run(ctx context.Context) (err error) {
+	diagnostics, err := validate.Diagnose(o.clientset.FS, o.devfilePath)
+	if err != nil {
+		return err
+	}
+	file := o.devfilePath
+	if rel, err := filepath.Rel(odocontext.GetWorkingDirectory(ctx), file); err == nil {
+		file = rel
+	}
+
+	var nbErrors int
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == api.DiagnosticSeverityError {
+			nbErrors++
+		}
+		position := fmt.Sprintf("%s:%d:%d", file, diagnostic.Line, diagnostic.Column)
+		if diagnostic.Path != "" {
+			position += " (" + diagnostic.Path + ")"
+		}
+		fmt.Fprintf(log.GetStdout(), "%s: %s: %s\n", position, diagnostic.Severity, diagnostic.Message)
+	}
+	if nbErrors > 0 {
+		return fmt.Errorf("the devfile %s is invalid: %d error(s) found", file, nbErrors)
+	}
+	if len(diagnostics) > 0 {
+		log.Println()
+	}
+	log.Successf("The devfile %s is valid", file)
+	return nil
+}
+
+// RunForJsonOutput contains the logic for the JSON Output
+func (o *ValidateOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	diagnostics, err := validate.Diagnose(o.clientset.FS, o.devfilePath)
+	if err != nil {
+		return nil, err
+	}
+	if diagnostics == nil {
+		diagnostics = []api.DevfileDiagnostic{}
+	}
+	return api.DevfileValidation{
+		Valid:       !validate.HasErrors(diagnostics),
+		Diagnostics: diagnostics,
+	}, nil
+}
+
+// NewCmdValidate implements the odo devfile validate command
+func NewCmdValidate(name, fullName string) *cobra.Command {
+	o := NewValidateOptions()
+	validateCmd := &cobra.Command{
+		Use:   name + " [devfile]",
+		Short: "Validate the devfile",
+		Long: `Validate the devfile, and report all the problems found, with their location in the file.
+
+The devfile is validated as odo does before running the other commands, and semantic checks are run:
+references to commands and components, volume mounts, endpoint ports and default commands.`,
+		Example: fmt.Sprintf(validateExample, fullName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(validateCmd, clientset.FILESYSTEM)
+	commonflags.UseOutputFlag(validateCmd)
+	validateCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return validateCmd
+}
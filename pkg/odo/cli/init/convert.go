@@ -0,0 +1,141 @@
+package init
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/devfile/library/pkg/devfile"
+	"github.com/devfile/library/pkg/devfile/parser"
+	dfutil "github.com/devfile/library/pkg/util"
+	"k8s.io/klog"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/devfile/convert"
+	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/init/backend"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cli/files"
+	"github.com/redhat-developer/odo/pkg/odo/cli/messages"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/util"
+	"github.com/redhat-developer/odo/pkg/version"
+)
+
+// isConversion returns true if the devfile is generated from a Docker Compose file or a Dev Container configuration
+func (o *InitOptions) isConversion() bool {
+	return o.fromComposeFlag != "" || o.fromDevcontainerFlag != ""
+}
+
+func (o *InitOptions) validateConversion(ctx context.Context) error {
+	if o.fromComposeFlag != "" && o.fromDevcontainerFlag != "" {
+		return errors.New("--from-compose and --from-devcontainer cannot be used together")
+	}
+	if o.composeServiceFlag != "" && o.fromComposeFlag == "" {
+		return errors.New("--compose-service can be used only with --from-compose")
+	}
+	if o.isMultiComponents() {
+		return errors.New("--from-compose and --from-devcontainer cannot be used with --all-components or --component-path")
+	}
+	for flag := range o.flags {
+		if flag != backend.FLAG_NAME {
+			return fmt.Errorf("--%s cannot be used with --from-compose or --from-devcontainer", flag)
+		}
+	}
+	if name := o.flags[backend.FLAG_NAME]; name != "" {
+		if err := dfutil.ValidateK8sResourceName("name", name); err != nil {
+			return err
+		}
+	}
+
+	workingDir := odocontext.GetWorkingDirectory(ctx)
+	devfilePresent, err := location.DirectoryContainsDevfile(o.clientset.FS, workingDir)
+	if err != nil {
+		return err
+	}
+	if devfilePresent {
+		return errors.New("a devfile already exists in the current directory")
+	}
+	return nil
+}
+
+// runConversion generates the devfile from a Docker Compose file or a Dev Container configuration,
+// and returns the devfile and its path
+func (o *InitOptions) runConversion(ctx context.Context) (parser.DevfileObj, string, error) {
+	workingDir := odocontext.GetWorkingDirectory(ctx)
+
+	log.Title(messages.InitializingNewComponent, "", "odo version: "+version.VERSION)
+	log.Println()
+
+	name := o.flags[backend.FLAG_NAME]
+	if name == "" {
+		name = util.GetDNS1123Name(filepath.Base(workingDir))
+	}
+
+	var (
+		sourcePath string
+		result     convert.Result
+		err        error
+	)
+	if o.fromComposeFlag != "" {
+		sourcePath, err = o.conversionSource(workingDir, o.fromComposeFlag, convert.FindComposeFile)
+		if err != nil {
+			return parser.DevfileObj{}, "", err
+		}
+		result, err = convert.FromCompose(o.clientset.FS, sourcePath, name, o.composeServiceFlag)
+	} else {
+		sourcePath, err = o.conversionSource(workingDir, o.fromDevcontainerFlag, convert.FindDevcontainerFile)
+		if err != nil {
+			return parser.DevfileObj{}, "", err
+		}
+		result, err = convert.FromDevcontainer(o.clientset.FS, sourcePath, workingDir, name)
+	}
+	if err != nil {
+		return parser.DevfileObj{}, "", err
+	}
+
+	devfilePath := filepath.Join(workingDir, "devfile.yaml")
+	if err = o.clientset.FS.WriteFile(devfilePath, result.Devfile, 0644); err != nil {
+		return parser.DevfileObj{}, "", err
+	}
+	devfileObj, _, err := devfile.ParseDevfileAndValidate(parser.ParserArgs{Path: devfilePath, FlattenedDevfile: pointer.BoolPtr(false)})
+	if err != nil {
+		_ = o.clientset.FS.Remove(devfilePath)
+		return parser.DevfileObj{}, "", fmt.Errorf("the devfile generated from %s is invalid: %w", sourcePath, err)
+	}
+
+	err = files.ReportLocalFileGeneratedByOdo(o.clientset.FS, workingDir, filepath.Base(devfilePath))
+	if err != nil {
+		klog.V(4).Infof("error trying to report local file generated: %v", err)
+	}
+
+	source := sourcePath
+	if rel, err := filepath.Rel(workingDir, sourcePath); err == nil {
+		source = rel
+	}
+	log.Successf("Devfile generated from %s", source)
+	if len(result.Warnings) > 0 {
+		log.Warning("Some parts could not be converted:")
+		for _, warning := range result.Warnings {
+			log.Printf("%s", warning)
+		}
+	}
+	return devfileObj, devfilePath, nil
+}
+
+// conversionSource returns the path of the file to convert, given as a file or a directory in which the file is searched
+func (o *InitOptions) conversionSource(workingDir string, path string, find func(fsys filesystem.Filesystem, dir string) (string, error)) (string, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(workingDir, path)
+	}
+	info, err := o.clientset.FS.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() {
+		return find(o.clientset.FS, path)
+	}
+	return path, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"k8s.io/klog"
@@ -19,6 +20,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/init/backend"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cli/alizer"
 	"github.com/redhat-developer/odo/pkg/odo/cli/files"
 	"github.com/redhat-developer/odo/pkg/odo/cli/messages"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
@@ -34,6 +36,7 @@ import (
 
 	"k8s.io/kubectl/pkg/util/templates"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
 )
 
 // RecommendedCommandName is the recommended command name
@@ -64,6 +67,36 @@ var initExample = templates.Examples(`
 
   # Bootstrap a new component and download a starter project
   %[1]s --name my-app --devfile nodejs --starter nodejs-starter
+
+  # Bootstrap a new component and download a starter project from a sub-directory of a git repository, at a tag
+  %[1]s --name my-app --devfile nodejs --starter-git https://github.com/example/templates.git --starter-revision v1.2.0 --starter-subdir nodejs/express
+
+  # Bootstrap a new component in a non empty directory, downloading a starter project without overwriting any file
+  %[1]s --name my-app --devfile nodejs --starter nodejs-starter --starter-no-overwrite
+
+  # Bootstrap a new component in interactive mode, and save the answers in a file
+  %[1]s --save-answers answers.yaml
+
+  # Bootstrap a new component with the answers of a file
+  %[1]s --answers answers.yaml
+
+  # Bootstrap a new component with answers in JSON format read from the standard input
+  echo '{"name": "my-app", "devfile": "nodejs"}' | %[1]s --answers -
+
+  # Bootstrap a component in the directory of each component detected in the current directory and its sub-directories
+  %[1]s --all-components
+
+  # Bootstrap components only in some of the directories of the detected components
+  %[1]s --component-path services/api --component-path services/web
+
+  # Bootstrap a new component from the Docker Compose file of the current directory
+  %[1]s --name my-app --from-compose
+
+  # Bootstrap a new component from a Docker Compose file, the service "api" running the sources
+  %[1]s --from-compose=compose.dev.yaml --compose-service api
+
+  # Bootstrap a new component from the Dev Container configuration of the current directory
+  %[1]s --from-devcontainer
   `)
 
 type InitOptions struct {
@@ -72,6 +105,18 @@ type InitOptions struct {
 
 	// Flags passed to the command
 	flags map[string]string
+
+	// Flags for the initialization of multiple components
+	allComponentsFlag bool
+	componentPathFlag []string
+
+	// Flags for the conversion of a Docker Compose file or a Dev Container configuration
+	fromComposeFlag      string
+	fromDevcontainerFlag string
+	composeServiceFlag   string
+
+	// saveAnswersFlag is the file in which the answers given in interactive mode are saved
+	saveAnswersFlag string
 }
 
 var _ genericclioptions.Runnable = (*InitOptions)(nil)
@@ -93,16 +138,38 @@ func (o *InitOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, arg
 
 	o.flags = o.clientset.InitClient.GetFlags(cmdline.GetFlags())
 
-	scontext.SetInteractive(cmdline.Context(), len(o.flags) == 0)
+	scontext.SetInteractive(cmdline.Context(), len(o.flags) == 0 && !o.isMultiComponents() && !o.isConversion())
 
 	return nil
 }
 
+// isMultiComponents returns true if a component is initialized for each component detected under the current directory
+func (o *InitOptions) isMultiComponents() bool {
+	return o.allComponentsFlag || len(o.componentPathFlag) > 0
+}
+
 // Validate validates the InitOptions based on completed values
 func (o *InitOptions) Validate(ctx context.Context) error {
+	if o.saveAnswersFlag != "" && (o.isConversion() || o.isMultiComponents()) {
+		return errors.New("--save-answers can be used only in interactive mode")
+	}
+
+	if o.isConversion() {
+		return o.validateConversion(ctx)
+	}
 
 	workingDir := odocontext.GetWorkingDirectory(ctx)
 
+	if o.isMultiComponents() {
+		if len(o.flags) != 0 {
+			return errors.New("--all-components and --component-path cannot be used with flags selecting a devfile")
+		}
+		if o.allComponentsFlag && len(o.componentPathFlag) > 0 {
+			return errors.New("--all-components and --component-path cannot be used together")
+		}
+		return nil
+	}
+
 	devfilePresent, err := location.DirectoryContainsDevfile(o.clientset.FS, workingDir)
 	if err != nil {
 		return err
@@ -119,12 +186,35 @@ func (o *InitOptions) Validate(ctx context.Context) error {
 	if len(o.flags) == 0 && fcontext.IsJsonOutput(ctx) {
 		return errors.New("parameters are expected to select a devfile")
 	}
+	if len(o.flags) != 0 && o.saveAnswersFlag != "" {
+		return errors.New("--save-answers can be used only in interactive mode")
+	}
 	return nil
 }
 
 // Run contains the logic for the odo command
 func (o *InitOptions) Run(ctx context.Context) (err error) {
 
+	if o.isConversion() {
+		devfileObj, _, err := o.runConversion(ctx)
+		if err != nil {
+			return err
+		}
+		log.Infof("\nYour new component '%s' is ready in the current directory.", devfileObj.GetMetadataName())
+		log.Info("Review the devfile, then use 'odo dev' to start editing your component.")
+		return nil
+	}
+
+	if o.isMultiComponents() {
+		components, err := o.runComponents(ctx)
+		if err != nil {
+			return err
+		}
+		log.Infof("\nYour new components are ready in the directories: %s", strings.Join(components, ", "))
+		log.Info("To start editing a component, use 'odo dev' from its directory.")
+		return nil
+	}
+
 	devfileObj, _, name, devfileLocation, starterInfo, err := o.run(ctx)
 	if err != nil {
 		return err
@@ -135,7 +225,9 @@ Your new component '%s' is ready in the current directory.
 To start editing your component, use 'odo dev' and open this folder in your favorite IDE.
 Changes will be directly reflected on the cluster.`, devfileObj.Data.GetMetadata().Name)
 
-	if len(o.flags) == 0 {
+	if o.saveAnswersFlag != "" {
+		log.Infof("\nYour answers have been saved in %s. You can automate this command by executing:\n   odo init --answers %s", o.saveAnswersFlag, o.saveAnswersFlag)
+	} else if len(o.flags) == 0 {
 		automateCommand := fmt.Sprintf("odo init --name %s --devfile %s --devfile-registry %s", name, devfileLocation.Devfile, devfileLocation.DevfileRegistry)
 		if devfileLocation.DevfileVersion != "" {
 			automateCommand = fmt.Sprintf("%s --devfile-version %s", automateCommand, devfileLocation.DevfileVersion)
@@ -158,7 +250,18 @@ Changes will be directly reflected on the cluster.`, devfileObj.Data.GetMetadata
 
 // RunForJsonOutput is executed instead of Run when -o json flag is given
 func (o *InitOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
-	devfileObj, devfilePath, _, _, _, err := o.run(ctx)
+	if o.isMultiComponents() {
+		return o.runComponentsForJsonOutput(ctx)
+	}
+	var (
+		devfileObj  parser.DevfileObj
+		devfilePath string
+	)
+	if o.isConversion() {
+		devfileObj, devfilePath, err = o.runConversion(ctx)
+	} else {
+		devfileObj, devfilePath, _, _, _, err = o.run(ctx)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -213,6 +316,15 @@ func (o *InitOptions) run(ctx context.Context) (devfileObj parser.DevfileObj, pa
 		return parser.DevfileObj{}, "", "", nil, nil, err
 	}
 
+	var originalDevfileObj parser.DevfileObj
+	if o.saveAnswersFlag != "" {
+		// The devfile has not been written since its download, the changes made by the personalization are only in memory
+		originalDevfileObj, _, err = devfile.ParseDevfileAndValidate(parser.ParserArgs{Path: devfilePath, FlattenedDevfile: pointer.BoolPtr(false)})
+		if err != nil {
+			return parser.DevfileObj{}, "", "", nil, nil, err
+		}
+	}
+
 	starterInfo, err = o.clientset.InitClient.SelectStarterProject(devfileObj, o.flags, o.clientset.FS, workingDir)
 	if err != nil {
 		return parser.DevfileObj{}, "", "", nil, nil, err
@@ -226,8 +338,8 @@ func (o *InitOptions) run(ctx context.Context) (devfileObj parser.DevfileObj, pa
 	}
 
 	if starterInfo != nil {
-		// WARNING: this will remove all the content of the destination directory, ie the devfile.yaml file
-		err = o.clientset.InitClient.DownloadStarterProject(starterInfo, workingDir)
+		// WARNING: the devfile.yaml file is replaced if the starter project contains a devfile
+		err = o.clientset.InitClient.DownloadStarterProject(starterInfo, workingDir, o.flags)
 		if err != nil {
 			return parser.DevfileObj{}, "", "", nil, nil, fmt.Errorf("unable to download starter project %q: %w", starterInfo.Name, err)
 		}
@@ -251,6 +363,13 @@ func (o *InitOptions) run(ctx context.Context) (devfileObj parser.DevfileObj, pa
 		klog.V(4).Infof("error trying to report local file generated: %v", err)
 	}
 
+	if o.saveAnswersFlag != "" {
+		err = o.saveAnswers(workingDir, devfileLocation, starterInfo, name, originalDevfileObj, devfileObj)
+		if err != nil {
+			return parser.DevfileObj{}, "", "", nil, nil, fmt.Errorf("unable to save the answers: %w", err)
+		}
+	}
+
 	scontext.SetComponentType(ctx, component.GetComponentTypeFromDevfileMetadata(devfileObj.Data.GetMetadata()))
 	scontext.SetLanguage(ctx, devfileObj.Data.GetMetadata().Language)
 	scontext.SetProjectType(ctx, devfileObj.Data.GetMetadata().ProjectType)
@@ -259,6 +378,144 @@ func (o *InitOptions) run(ctx context.Context) (devfileObj parser.DevfileObj, pa
 	return devfileObj, devfilePath, name, devfileLocation, starterInfo, nil
 }
 
+// saveAnswers saves the answers given in interactive mode in the file passed with --save-answers
+func (o *InitOptions) saveAnswers(workingDir string, devfileLocation *api.DetectionResult, starterInfo *v1alpha2.StarterProject, name string,
+	original parser.DevfileObj, personalized parser.DevfileObj) error {
+	answers, err := backend.NewAnswers(devfileLocation, starterInfo, name, original, personalized)
+	if err != nil {
+		return err
+	}
+	content, err := yaml.Marshal(answers)
+	if err != nil {
+		return err
+	}
+	path := o.saveAnswersFlag
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(workingDir, path)
+	}
+	return o.clientset.FS.WriteFile(path, content, 0644)
+}
+
+// runComponents detects the components under the working directory, and creates a devfile for each one of the components selected.
+// Returns the paths of the directories of the components initialized
+func (o *InitOptions) runComponents(ctx context.Context) ([]string, error) {
+	results, err := o.initComponents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(results))
+	for _, result := range results {
+		paths = append(paths, result.component.Path)
+	}
+	return paths, nil
+}
+
+func (o *InitOptions) runComponentsForJsonOutput(ctx context.Context) ([]api.Component, error) {
+	results, err := o.initComponents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]api.Component, 0, len(results))
+	for _, result := range results {
+		out = append(out, api.Component{
+			DevfilePath:       result.devfilePath,
+			DevfileData:       api.GetDevfileData(result.devfileObj),
+			DevForwardedPorts: []api.ForwardedPort{},
+			RunningIn:         api.NewRunningModes(),
+			ManagedBy:         "odo",
+		})
+	}
+	return out, nil
+}
+
+// initializedComponent is a component initialized in a sub-directory
+type initializedComponent struct {
+	component   api.DetectionResult
+	devfileObj  parser.DevfileObj
+	devfilePath string
+}
+
+func (o *InitOptions) initComponents(ctx context.Context) ([]initializedComponent, error) {
+	workingDir := odocontext.GetWorkingDirectory(ctx)
+
+	if !fcontext.IsJsonOutput(ctx) {
+		log.Title(messages.InitializingNewComponent, messages.SourceCodeDetected, "odo version: "+version.VERSION)
+		log.Println()
+	}
+
+	detected, err := o.clientset.AlizerClient.DetectComponents(ctx, workingDir)
+	if err != nil {
+		return nil, err
+	}
+	components, err := selectComponents(detected, o.componentPathFlag)
+	if err != nil {
+		return nil, err
+	}
+	if len(components) == 0 {
+		return nil, errors.New("no components detected in the current directory")
+	}
+	if !fcontext.IsJsonOutput(ctx) {
+		log.Info("Components detected:")
+		alizer.PrintComponents(components)
+		log.Println()
+	}
+
+	var result []initializedComponent
+	for _, component := range components {
+		dir := filepath.Join(workingDir, filepath.FromSlash(component.Path))
+		devfilePresent, err := location.DirectoryContainsDevfile(o.clientset.FS, dir)
+		if err != nil {
+			return nil, err
+		}
+		if devfilePresent {
+			log.Warningf("A devfile already exists in the directory %q, the component is not initialized", component.Path)
+			continue
+		}
+
+		devfileObj, devfilePath, err := o.clientset.InitClient.InitComponent(ctx, component, workingDir)
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the component in the directory %q: %w", component.Path, err)
+		}
+
+		err = files.ReportLocalFileGeneratedByOdo(o.clientset.FS, dir, filepath.Base(devfilePath))
+		if err != nil {
+			klog.V(4).Infof("error trying to report local file generated: %v", err)
+		}
+		result = append(result, initializedComponent{
+			component:   component,
+			devfileObj:  devfileObj,
+			devfilePath: devfilePath,
+		})
+	}
+	if len(result) == 0 {
+		return nil, errors.New("all the components detected already have a devfile")
+	}
+	return result, nil
+}
+
+// selectComponents returns the detected components in the paths, or all the detected components if paths is empty
+func selectComponents(detected []api.DetectionResult, paths []string) ([]api.DetectionResult, error) {
+	if len(paths) == 0 {
+		return detected, nil
+	}
+	var result []api.DetectionResult
+	for _, path := range paths {
+		path = filepath.ToSlash(filepath.Clean(path))
+		found := false
+		for _, component := range detected {
+			if component.Path == path {
+				result = append(result, component)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no component detected in the directory %q", path)
+		}
+	}
+	return result, nil
+}
+
 // NewCmdInit implements the odo command
 func NewCmdInit(name, fullName string) *cobra.Command {
 
@@ -273,7 +530,7 @@ func NewCmdInit(name, fullName string) *cobra.Command {
 			return genericclioptions.GenericRun(o, cmd, args)
 		},
 	}
-	clientset.Add(initCmd, clientset.PREFERENCE, clientset.FILESYSTEM, clientset.REGISTRY, clientset.INIT)
+	clientset.Add(initCmd, clientset.PREFERENCE, clientset.FILESYSTEM, clientset.REGISTRY, clientset.INIT, clientset.ALIZER)
 
 	initCmd.Flags().String(backend.FLAG_NAME, "", "name of the component to create; it must follow the RFC 1123 Label Names standard and not be all-numeric")
 	initCmd.Flags().String(backend.FLAG_DEVFILE, "", "name of the devfile in devfile registry")
@@ -281,6 +538,25 @@ func NewCmdInit(name, fullName string) *cobra.Command {
 	initCmd.Flags().String(backend.FLAG_STARTER, "", "name of the starter project")
 	initCmd.Flags().String(backend.FLAG_DEVFILE_PATH, "", "path to a devfile. This is an alternative to using devfile from Devfile registry. It can be local filesystem path or http(s) URL")
 	initCmd.Flags().String(backend.FLAG_DEVFILE_VERSION, "", "version of the devfile stack; use \"latest\" to dowload the latest stack")
+	initCmd.Flags().String(backend.FLAG_STARTER_GIT, "", "URL of a git repository to download as starter project, instead of a starter project of the devfile. "+
+		"The credentials of private repositories are given by the git credential helpers")
+	initCmd.Flags().String(backend.FLAG_STARTER_REVISION, "", "branch or tag of the git repository of the starter project. It can be used only with --starter-git")
+	initCmd.Flags().String(backend.FLAG_STARTER_SUBDIR, "", "sub-directory of the git repository to download as starter project. It can be used only with --starter-git")
+	initCmd.Flags().Bool(backend.FLAG_STARTER_NO_OVERWRITE, false, "download the starter project in a non empty directory, refusing to download it if any file of the directory would be overwritten")
+	initCmd.Flags().String(backend.FLAG_ANSWERS, "", "path to a file, in YAML or JSON format, containing the answers to the questions of the interactive mode; use \"-\" to read the answers from the standard input")
+	initCmd.Flags().StringVar(&o.saveAnswersFlag, "save-answers", "", "path to a file in which to save the answers given in interactive mode, to be used later with --answers")
+	initCmd.Flags().BoolVar(&o.allComponentsFlag, "all-components", false,
+		"If true, detect all the components in the current directory and its sub-directories, and create a devfile in the directory of each component")
+	initCmd.Flags().StringArrayVar(&o.componentPathFlag, "component-path", nil,
+		"Path of the directory of a detected component, relative to the current directory, in which to create a devfile. Can be repeated")
+	initCmd.Flags().StringVar(&o.fromComposeFlag, "from-compose", "",
+		"Generate the devfile from a Docker Compose file; without value, the Compose file of the current directory is used")
+	initCmd.Flags().Lookup("from-compose").NoOptDefVal = "."
+	initCmd.Flags().StringVar(&o.composeServiceFlag, "compose-service", "",
+		"Service of the Docker Compose file running the sources of the component; by default, the first service built from sources")
+	initCmd.Flags().StringVar(&o.fromDevcontainerFlag, "from-devcontainer", "",
+		"Generate the devfile from a Dev Container configuration (devcontainer.json); without value, the configuration of the current directory is used")
+	initCmd.Flags().Lookup("from-devcontainer").NoOptDefVal = "."
 
 	commonflags.UseOutputFlag(initCmd)
 	// Add a defined annotation in order to appear in the help menu
@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 
+	"github.com/redhat-developer/odo/pkg/api"
 	_init "github.com/redhat-developer/odo/pkg/init"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
@@ -74,3 +76,47 @@ func TestInitOptions_Complete(t *testing.T) {
 		})
 	}
 }
+
+func Test_selectComponents(t *testing.T) {
+	detected := []api.DetectionResult{
+		{Name: "api", Path: "services/api", Devfile: "go"},
+		{Name: "web", Path: "services/web", Devfile: "nodejs"},
+		{Name: "worker", Path: "services/worker", Devfile: "python"},
+	}
+	tests := []struct {
+		name    string
+		paths   []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "all components",
+			want: []string{"services/api", "services/web", "services/worker"},
+		},
+		{
+			name:  "selected components",
+			paths: []string{"services/worker", "./services/api/"},
+			want:  []string{"services/worker", "services/api"},
+		},
+		{
+			name:    "no component in path",
+			paths:   []string{"services/db"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectComponents(detected, tt.paths)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("selectComponents() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var gotPaths []string
+			for _, component := range got {
+				gotPaths = append(gotPaths, component.Path)
+			}
+			if diff := cmp.Diff(tt.want, gotPaths); diff != "" {
+				t.Errorf("selectComponents() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -4,16 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/odo/cli/feature"
 	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
 	"github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/podman"
 
 	"github.com/redhat-developer/odo/pkg/component"
 
@@ -32,8 +36,17 @@ const RecommendedCommandName = "component"
 
 var listExample = ktemplates.Examples(`  # List all components in the application
 %[1]s
+
+  # List all components managed by odo in all namespaces
+%[1]s --all-namespaces
+
+  # List all components in the application, and watch for changes
+%[1]s --watch
   `)
 
+// watchDebounce is the delay after the last event received from the watchers before listing the components again
+const watchDebounce = 500 * time.Millisecond
+
 // ListOptions ...
 type ListOptions struct {
 	// Clients
@@ -41,9 +54,13 @@ type ListOptions struct {
 
 	// Local variables
 	namespaceFilter string
+	// columnsWidths are the widths of the columns of the tables displayed in watch mode
+	columnsWidths []int
 
 	// Flags
-	namespaceFlag string
+	namespaceFlag     string
+	allNamespacesFlag bool
+	watchFlag         bool
 }
 
 var _ genericclioptions.Runnable = (*ListOptions)(nil)
@@ -60,6 +77,27 @@ func (o *ListOptions) SetClientset(clientset *clientset.Clientset) {
 
 // Complete ...
 func (lo *ListOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	if lo.allNamespacesFlag {
+		if lo.namespaceFlag != "" {
+			return errors.New("--namespace and --all-namespaces cannot be used together")
+		}
+		if lo.clientset.KubernetesClient == nil && fcontext.GetRunOn(ctx, "") != commonflags.RunOnPodman {
+			return errors.New("cluster is non accessible")
+		}
+		if lo.clientset.KubernetesClient != nil {
+			// warn once here, as the components are listed again at each change in watch mode
+			_, listed, err := component.GetAccessibleNamespaces(lo.clientset.KubernetesClient)
+			if err != nil {
+				return err
+			}
+			if !listed {
+				log.Warningf("Not allowed to list the namespaces, only the namespace %q is used if not allowed to list the components of all namespaces",
+					lo.clientset.KubernetesClient.GetCurrentNamespace())
+			}
+		}
+		return nil
+	}
+
 	// If the namespace flag has been passed, we will search there.
 	// if it hasn't, we will search from the default project / namespace.
 	if lo.namespaceFlag != "" {
@@ -76,6 +114,9 @@ func (lo *ListOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, ar
 
 // Validate ...
 func (lo *ListOptions) Validate(ctx context.Context) (err error) {
+	if lo.watchFlag && fcontext.IsJsonOutput(ctx) {
+		return errors.New("--watch cannot be used with -o json")
+	}
 	if lo.clientset.KubernetesClient == nil {
 		log.Warning("No connection to cluster defined")
 	}
@@ -84,7 +125,12 @@ func (lo *ListOptions) Validate(ctx context.Context) (err error) {
 
 // Run has the logic to perform the required actions as part of command
 func (lo *ListOptions) Run(ctx context.Context) error {
-	listSpinner := log.Spinnerf("Listing components from namespace '%s'", lo.namespaceFilter)
+	var listSpinner *log.Status
+	if lo.allNamespacesFlag {
+		listSpinner = log.Spinner("Listing components from all namespaces")
+	} else {
+		listSpinner = log.Spinnerf("Listing components from namespace '%s'", lo.namespaceFilter)
+	}
 	defer listSpinner.End(false)
 
 	list, err := lo.run(ctx)
@@ -94,7 +140,11 @@ func (lo *ListOptions) Run(ctx context.Context) error {
 
 	listSpinner.End(true)
 
-	HumanReadableOutput(ctx, list)
+	if lo.watchFlag {
+		return lo.watch(ctx, list)
+	}
+
+	humanReadableOutput(ctx, list, lo.allNamespacesFlag)
 	return nil
 }
 
@@ -103,11 +153,9 @@ func (lo *ListOptions) RunForJsonOutput(ctx context.Context) (out interface{}, e
 	return lo.run(ctx)
 }
 
-func (lo *ListOptions) run(ctx context.Context) (api.ResourcesList, error) {
+// getClients returns the clients of the platforms on which to list the components, depending on the --run-on flag
+func (lo *ListOptions) getClients(ctx context.Context) (kclient.ClientInterface, podman.Client) {
 	var (
-		devfileObj    = odocontext.GetDevfileObj(ctx)
-		componentName = odocontext.GetComponentName(ctx)
-
 		kubeClient   = lo.clientset.KubernetesClient
 		podmanClient = lo.clientset.PodmanClient
 	)
@@ -118,9 +166,28 @@ func (lo *ListOptions) run(ctx context.Context) (api.ResourcesList, error) {
 	case commonflags.RunOnPodman:
 		kubeClient = nil
 	}
+	return kubeClient, podmanClient
+}
+
+func (lo *ListOptions) run(ctx context.Context) (api.ResourcesList, error) {
+	var (
+		devfileObj    = odocontext.GetDevfileObj(ctx)
+		componentName = odocontext.GetComponentName(ctx)
+
+		allComponents      []api.ComponentAbstract
+		componentInDevfile string
+		err                error
+	)
+
+	kubeClient, podmanClient := lo.getClients(ctx)
 
-	allComponents, componentInDevfile, err := component.ListAllComponents(
-		kubeClient, podmanClient, lo.namespaceFilter, devfileObj, componentName)
+	if lo.allNamespacesFlag {
+		// the local component is not marked, as components with the same name can run in several namespaces
+		allComponents, err = component.ListAllOdoComponentsInAllNamespaces(kubeClient, podmanClient)
+	} else {
+		allComponents, componentInDevfile, err = component.ListAllComponents(
+			kubeClient, podmanClient, lo.namespaceFilter, devfileObj, componentName)
+	}
 	if err != nil {
 		return api.ResourcesList{}, err
 	}
@@ -157,6 +224,8 @@ func NewCmdComponentList(ctx context.Context, name, fullName string) *cobra.Comm
 		clientset.Add(listCmd, clientset.PODMAN_NULLABLE)
 	}
 	listCmd.Flags().StringVar(&o.namespaceFlag, "namespace", "", "Namespace for odo to scan for components")
+	listCmd.Flags().BoolVar(&o.allNamespacesFlag, "all-namespaces", false, "List the components managed by odo in all the namespaces accessible to the user")
+	listCmd.Flags().BoolVar(&o.watchFlag, "watch", false, "After listing the components, watch for changes and display the components created, modified or deleted")
 
 	util.SetCommandGroup(listCmd, util.ManagementGroup)
 	commonflags.UseOutputFlag(listCmd)
@@ -165,71 +234,117 @@ func NewCmdComponentList(ctx context.Context, name, fullName string) *cobra.Comm
 	return listCmd
 }
 
+// HumanReadableOutput outputs the list of components of a namespace in a human readable format
 func HumanReadableOutput(ctx context.Context, list api.ResourcesList) {
+	humanReadableOutput(ctx, list, false)
+}
+
+func humanReadableOutput(ctx context.Context, list api.ResourcesList, allNamespaces bool) {
 	components := list.Components
 	if len(components) == 0 {
 		log.Error("There are no components deployed.")
 		return
 	}
 
+	t := newComponentsTable(ctx, allNamespaces, false)
+	for _, comp := range components {
+		t.AppendRow(getComponentRow(ctx, comp, list.ComponentInDevfile, allNamespaces))
+	}
+	t.Render()
+}
+
+// newComponentsTable returns a table with the header for the components, and an EVENT column first if withEvents is true
+func newComponentsTable(ctx context.Context, allNamespaces bool, withEvents bool) table.Writer {
 	t := ui.NewTable()
 
 	// Create the header and then sort accordingly
-	headers := table.Row{"NAME", "PROJECT TYPE", "RUNNING IN", "MANAGED"}
+	t.AppendHeader(getComponentsHeaders(ctx, allNamespaces, withEvents))
+
+	var sortBy []table.SortBy
+	if allNamespaces {
+		sortBy = append(sortBy, table.SortBy{Name: "NAMESPACE", Mode: table.Asc})
+	}
+	sortBy = append(sortBy,
+		table.SortBy{Name: "MANAGED", Mode: table.Asc},
+		table.SortBy{Name: "NAME", Mode: table.Dsc},
+	)
+	t.SortBy(sortBy)
+	return t
+}
+
+func getComponentsHeaders(ctx context.Context, allNamespaces bool, withEvents bool) table.Row {
+	var headers table.Row
+	if withEvents {
+		headers = append(headers, "EVENT")
+	}
+	if allNamespaces {
+		headers = append(headers, "NAMESPACE")
+	}
+	headers = append(headers, "NAME", "PROJECT TYPE", "RUNNING IN", "MANAGED")
 	if feature.IsEnabled(ctx, feature.GenericRunOnFlag) {
 		headers = append(headers, "RUNNING ON")
 	}
-	t.AppendHeader(headers)
-	t.SortBy([]table.SortBy{
-		{Name: "MANAGED", Mode: table.Asc},
-		{Name: "NAME", Mode: table.Dsc},
-	})
+	if allNamespaces {
+		headers = append(headers, "AGE")
+	}
+	return headers
+}
 
-	// Go through each component and add it to the table
-	for _, comp := range components {
+func getComponentRow(ctx context.Context, comp api.ComponentAbstract, componentInDevfile string, allNamespaces bool) table.Row {
+	// Mark the name as yellow in the index to it's easier to see.
+	name := text.Colors{text.FgHiYellow}.Sprint(comp.Name)
 
-		// Mark the name as yellow in the index to it's easier to see.
-		name := text.Colors{text.FgHiYellow}.Sprint(comp.Name)
+	// Get the managed by label
+	managedBy := comp.ManagedBy
+	if managedBy == "" {
+		managedBy = api.TypeUnknown
+	}
 
-		// Get the managed by label
-		managedBy := comp.ManagedBy
-		if managedBy == "" {
-			managedBy = api.TypeUnknown
-		}
+	// Get the mode (dev or deploy)
+	mode := comp.RunningIn.String()
 
-		// Get the mode (dev or deploy)
-		mode := comp.RunningIn.String()
+	// Get the type of the component
+	componentType := comp.Type
+	if componentType == "" {
+		componentType = api.TypeUnknown
+	}
 
-		// Get the type of the component
-		componentType := comp.Type
-		if componentType == "" {
-			componentType = api.TypeUnknown
-		}
+	// If we find our local unpushed component, let's change the output appropriately.
+	if componentInDevfile == comp.Name {
+		name = fmt.Sprintf("* %s", name)
+	}
+	if comp.ManagedByVersion != "" {
+		managedBy += fmt.Sprintf(" (%s)", comp.ManagedByVersion)
+	}
+	// If we are managing that component, output it as blue (our logo colour) to indicate it's used by odo
+	if comp.ManagedBy == "odo" {
+		managedBy = text.Colors{text.FgBlue}.Sprintf(managedBy)
+	}
 
-		// If we find our local unpushed component, let's change the output appropriately.
-		if list.ComponentInDevfile == comp.Name {
-			name = fmt.Sprintf("* %s", name)
-		}
-		if comp.ManagedByVersion != "" {
-			managedBy += fmt.Sprintf(" (%s)", comp.ManagedByVersion)
+	var row table.Row
+	if allNamespaces {
+		namespace := comp.Namespace
+		if namespace == "" {
+			namespace = "-"
 		}
-		// If we are managing that component, output it as blue (our logo colour) to indicate it's used by odo
-		if comp.ManagedBy == "odo" {
-			managedBy = text.Colors{text.FgBlue}.Sprintf(managedBy)
-		}
-
-		row := table.Row{name, componentType, mode, managedBy}
+		row = append(row, namespace)
+	}
+	row = append(row, name, componentType, mode, managedBy)
 
-		if feature.IsEnabled(ctx, feature.GenericRunOnFlag) {
-			runningOn := comp.RunningOn
-			if runningOn == "" {
-				runningOn = "None"
-			}
-			row = append(row, runningOn)
+	if feature.IsEnabled(ctx, feature.GenericRunOnFlag) {
+		runningOn := comp.RunningOn
+		if runningOn == "" {
+			runningOn = "None"
 		}
-
-		t.AppendRow(row)
+		row = append(row, runningOn)
 	}
-	t.Render()
 
+	if allNamespaces {
+		age := api.TypeUnknown
+		if comp.CreatedAt != nil {
+			age = duration.HumanDuration(time.Since(*comp.CreatedAt))
+		}
+		row = append(row, age)
+	}
+	return row
 }
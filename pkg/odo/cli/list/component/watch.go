@@ -0,0 +1,181 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+const (
+	// watchRestartDelay is the delay before restarting the watchers, when they have been closed.
+	// The delay is doubled each time the watchers are closed right after being started
+	watchRestartDelay = time.Second
+	// watchMinDuration is the duration under which the watchers are considered closed right after being started
+	watchMinDuration = 5 * time.Second
+	// watchMaxFailures is the number of consecutive times the watchers can be closed right after being started
+	watchMaxFailures = 5
+)
+
+// componentEvent is a change of a component between two listings
+type componentEvent struct {
+	Type      watch.EventType
+	Component api.ComponentAbstract
+}
+
+// watch displays the components of list, then watches for changes and displays the components
+// created, modified or deleted, until the context is cancelled
+func (lo *ListOptions) watch(ctx context.Context, list api.ResourcesList) error {
+	kubeClient, podmanClient := lo.getClients(ctx)
+
+	lo.printEvents(ctx, getComponentEvents(nil, list.Components), list.ComponentInDevfile, true)
+
+	resync := false
+	failures := 0
+	for {
+		watcher, err := component.NewComponentsWatcher(ctx, kubeClient, podmanClient, lo.namespaceFilter, lo.allNamespacesFlag)
+		if err != nil {
+			return err
+		}
+		start := time.Now()
+		list = lo.watchChanges(ctx, watcher, list, resync)
+		watcher.Stop()
+
+		if time.Since(start) < watchMinDuration {
+			failures++
+		} else {
+			failures = 0
+		}
+		if failures >= watchMaxFailures {
+			return fmt.Errorf("the watchers of the components have been closed %d times right after being started", failures)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchRestartDelay << failures):
+		}
+		// The watchers have been closed, for example by the API server after a timeout.
+		// Restart them, and list the components again to catch the changes done in the meantime
+		klog.V(4).Infof("restarting the components watchers")
+		resync = true
+	}
+}
+
+// watchChanges lists the components each time events are received from the watcher, and displays the changes
+// compared to the previous list. It returns the last list when the watcher is closed or the context is cancelled.
+// If resync is true, the components are listed once without waiting for an event.
+func (lo *ListOptions) watchChanges(ctx context.Context, watcher watch.Interface, previous api.ResourcesList, resync bool) api.ResourcesList {
+	listTimer := time.NewTimer(watchDebounce)
+	if !resync {
+		listTimer.Stop()
+	}
+	defer listTimer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return previous
+		case ev, ok := <-watcher.ResultChan():
+			if !ok {
+				return previous
+			}
+			klog.V(4).Infof("components watcher event: %s", ev.Type)
+			listTimer.Reset(watchDebounce)
+		case <-listTimer.C:
+			current, err := lo.run(ctx)
+			if err != nil {
+				log.Warningf("Unable to list the components: %v", err)
+				continue
+			}
+			lo.printEvents(ctx, getComponentEvents(previous.Components, current.Components), current.ComponentInDevfile, false)
+			previous = current
+		}
+	}
+}
+
+// printEvents displays the changed components in a table, with the header only if withHeader is true.
+// The columns keep the widths of the previously displayed tables, so the rows stay aligned with the header
+func (lo *ListOptions) printEvents(ctx context.Context, events []componentEvent, componentInDevfile string, withHeader bool) {
+	if len(events) == 0 && !withHeader {
+		return
+	}
+
+	rows := make([]table.Row, 0, len(events))
+	for _, event := range events {
+		row := append(table.Row{string(event.Type)}, getComponentRow(ctx, event.Component, componentInDevfile, lo.allNamespacesFlag)...)
+		rows = append(rows, row)
+	}
+	if withHeader {
+		lo.updateColumnsWidths(getComponentsHeaders(ctx, lo.allNamespacesFlag, true))
+		// reserve the width of the longest event type
+		lo.updateColumnsWidths(table.Row{string(watch.Modified)})
+	}
+	for _, row := range rows {
+		lo.updateColumnsWidths(row)
+	}
+
+	t := newComponentsTable(ctx, lo.allNamespacesFlag, true)
+	if !withHeader {
+		t.ResetHeaders()
+	}
+	configs := make([]table.ColumnConfig, 0, len(lo.columnsWidths))
+	for i, width := range lo.columnsWidths {
+		configs = append(configs, table.ColumnConfig{Number: i + 1, WidthMin: width})
+	}
+	t.SetColumnConfigs(configs)
+	t.AppendRows(rows)
+	t.Render()
+}
+
+// updateColumnsWidths records the widths of the columns needed to display the row
+func (lo *ListOptions) updateColumnsWidths(row table.Row) {
+	for i, cell := range row {
+		width := text.RuneWidthWithoutEscSequences(fmt.Sprint(cell))
+		if i >= len(lo.columnsWidths) {
+			lo.columnsWidths = append(lo.columnsWidths, width)
+		} else if width > lo.columnsWidths[i] {
+			lo.columnsWidths[i] = width
+		}
+	}
+}
+
+// getComponentEvents returns the components added to, modified in or deleted from the previous list of components
+func getComponentEvents(previous, current []api.ComponentAbstract) []componentEvent {
+	key := func(comp api.ComponentAbstract) string {
+		return comp.Namespace + "/" + comp.RunningOn + "/" + comp.Name
+	}
+
+	previousByKey := make(map[string]api.ComponentAbstract, len(previous))
+	for _, comp := range previous {
+		previousByKey[key(comp)] = comp
+	}
+
+	var events []componentEvent
+	currentKeys := make(map[string]struct{}, len(current))
+	for _, comp := range current {
+		currentKeys[key(comp)] = struct{}{}
+		previousComp, found := previousByKey[key(comp)]
+		switch {
+		case !found:
+			events = append(events, componentEvent{Type: watch.Added, Component: comp})
+		case !reflect.DeepEqual(previousComp, comp):
+			events = append(events, componentEvent{Type: watch.Modified, Component: comp})
+		}
+	}
+	for _, comp := range previous {
+		if _, found := currentKeys[key(comp)]; !found {
+			events = append(events, componentEvent{Type: watch.Deleted, Component: comp})
+		}
+	}
+	return events
+}
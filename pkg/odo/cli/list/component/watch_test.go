@@ -0,0 +1,70 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+func Test_getComponentEvents(t *testing.T) {
+	devModes := api.RunningModes{api.RunningModeDev: true, api.RunningModeDeploy: false}
+	bothModes := api.RunningModes{api.RunningModeDev: true, api.RunningModeDeploy: true}
+
+	compA := api.ComponentAbstract{Name: "a", Namespace: "ns1", RunningOn: "cluster", RunningIn: devModes}
+	compAInNs2 := api.ComponentAbstract{Name: "a", Namespace: "ns2", RunningOn: "cluster", RunningIn: devModes}
+	compAOnPodman := api.ComponentAbstract{Name: "a", RunningOn: "podman", RunningIn: devModes}
+	compB := api.ComponentAbstract{Name: "b", Namespace: "ns1", RunningOn: "cluster", RunningIn: devModes}
+	compBBothModes := api.ComponentAbstract{Name: "b", Namespace: "ns1", RunningOn: "cluster", RunningIn: bothModes}
+
+	tests := []struct {
+		name     string
+		previous []api.ComponentAbstract
+		current  []api.ComponentAbstract
+		want     []componentEvent
+	}{
+		{
+			name:     "initial list",
+			previous: nil,
+			current:  []api.ComponentAbstract{compA, compB},
+			want: []componentEvent{
+				{Type: watch.Added, Component: compA},
+				{Type: watch.Added, Component: compB},
+			},
+		},
+		{
+			name:     "no change",
+			previous: []api.ComponentAbstract{compA, compB},
+			current:  []api.ComponentAbstract{compB, compA},
+			want:     nil,
+		},
+		{
+			name:     "component with same name in another namespace and on podman",
+			previous: []api.ComponentAbstract{compA},
+			current:  []api.ComponentAbstract{compA, compAInNs2, compAOnPodman},
+			want: []componentEvent{
+				{Type: watch.Added, Component: compAInNs2},
+				{Type: watch.Added, Component: compAOnPodman},
+			},
+		},
+		{
+			name:     "component changing mode and component deleted",
+			previous: []api.ComponentAbstract{compA, compB},
+			current:  []api.ComponentAbstract{compBBothModes},
+			want: []componentEvent{
+				{Type: watch.Modified, Component: compBBothModes},
+				{Type: watch.Deleted, Component: compA},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getComponentEvents(tt.previous, tt.current)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getComponentEvents() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -10,8 +10,13 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/fatih/color"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
 
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
 
@@ -25,9 +30,12 @@ import (
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/platform"
 )
 
 const RecommendedCommandName = "logs"
@@ -43,9 +51,16 @@ type LogsOptions struct {
 	devMode    bool
 	deployMode bool
 	follow     bool
+	container  string
+	since      time.Duration
+	tail       int64
+	timestamps bool
+	previous   bool
+	limitBytes int64
 }
 
 var _ genericclioptions.Runnable = (*LogsOptions)(nil)
+var _ genericclioptions.JsonOutputter = (*LogsOptions)(nil)
 
 type logsMode string
 
@@ -63,6 +78,15 @@ func NewLogsOptions() *LogsOptions {
 var logsExample = ktemplates.Examples(`
 	# Show logs of all containers
 	%[1]s
+
+	# Follow the logs of all containers as JSON lines
+	%[1]s --follow -o json
+
+	# Show the last 20 lines of the logs of the last hour of the container 'runtime', with their timestamps
+	%[1]s --container runtime --since 1h --tail 20 --timestamps
+
+	# Show the logs of the previous instance of the restarted containers, to understand why they terminated
+	%[1]s --previous
 `)
 
 func (o *LogsOptions) SetClientset(clientset *clientset.Clientset) {
@@ -91,10 +115,60 @@ func (o *LogsOptions) Validate(ctx context.Context) error {
 	if o.devMode && o.deployMode {
 		return errors.New("pass only one of --dev or --deploy flags; pass no flag to see logs for both modes")
 	}
+	if o.since < 0 {
+		return errors.New("--since must be a positive duration")
+	}
+	if o.tail < -1 {
+		return errors.New("--tail must be a positive number of lines, or -1 to show all the lines")
+	}
+	if o.limitBytes < 0 {
+		return errors.New("--limit-bytes must be a positive number of bytes, or 0 for no limit")
+	}
+
+	switch fcontext.GetRunOn(ctx, commonflags.RunOnCluster) {
+	case commonflags.RunOnCluster:
+		if o.clientset.KubernetesClient == nil {
+			return errors.New("no connection to cluster defined")
+		}
+	case commonflags.RunOnPodman:
+		if o.clientset.PodmanClient == nil {
+			return errors.New("unable to access podman. Do you have podman client installed?")
+		}
+		if o.previous {
+			return errors.New("--previous cannot be used on podman, which keeps the logs of all the instances of a container")
+		}
+	}
 	return nil
 }
 
+// getLogOptions returns the options to get the logs of the containers, from the flags
+func (o *LogsOptions) getLogOptions() platform.LogOptions {
+	options := platform.LogOptions{
+		Follow:     o.follow,
+		Since:      o.since,
+		Timestamps: o.timestamps,
+		Previous:   o.previous,
+	}
+	if o.tail >= 0 {
+		options.TailLines = &o.tail
+	}
+	if o.limitBytes > 0 {
+		options.LimitBytes = &o.limitBytes
+	}
+	return options
+}
+
 func (o *LogsOptions) Run(ctx context.Context) error {
+	return o.run(ctx, false)
+}
+
+// RunForJsonOutput outputs the logs as JSON lines, one record per line of logs or restart of a container,
+// and returns no result
+func (o *LogsOptions) RunForJsonOutput(ctx context.Context) (interface{}, error) {
+	return nil, o.run(ctx, true)
+}
+
+func (o *LogsOptions) run(ctx context.Context, jsonOutput bool) error {
 	var logMode logsMode
 	var err error
 
@@ -116,18 +190,27 @@ func (o *LogsOptions) Run(ctx context.Context) error {
 		mode = odolabels.ComponentAnyMode
 	}
 
+	options := o.getLogOptions()
+	if jsonOutput {
+		// the timestamps are parsed from the lines, to be output in their own field
+		options.Timestamps = true
+	}
 	events, err := o.clientset.LogsClient.GetLogsForMode(
 		ctx,
 		mode,
 		componentName,
 		odocontext.GetNamespace(ctx),
-		o.follow,
+		o.container,
+		options,
 	)
 	if err != nil {
 		return err
 	}
 
+	platformName := fcontext.GetRunOn(ctx, commonflags.RunOnCluster)
 	uniqueContainerNames := map[string]struct{}{}
+	// uniqueNamesByContainer maps <pod>/<container> to the unique names of the containers
+	uniqueNamesByContainer := map[string]string{}
 	var goroutines struct{ count int64 } // keep a track of running goroutines so that we don't exit prematurely
 	errChan := make(chan error)          // errors are put on this channel
 	var mu sync.Mutex
@@ -137,39 +220,78 @@ func (o *LogsOptions) Run(ctx context.Context) error {
 		case containerLogs := <-events.Logs:
 			uniqueName := getUniqueContainerName(containerLogs.Name, uniqueContainerNames)
 			uniqueContainerNames[uniqueName] = struct{}{}
+			uniqueNamesByContainer[containerLogs.PodName+"/"+containerLogs.Name] = uniqueName
 			colour := log.ColorPicker()
 			logs := containerLogs.Logs
 
+			record := api.LogRecord{
+				Component: componentName,
+				Mode:      api.RunningMode(strings.ToLower(containerLogs.Mode)),
+				Platform:  platformName,
+				Pod:       containerLogs.PodName,
+				Container: containerLogs.Name,
+			}
+			if jsonOutput && containerLogs.Status != nil && containerLogs.Status.RestartCount > 0 {
+				machineoutput.OutputSuccessUnindented(getRestartRecord(record, *containerLogs.Status))
+			}
+			printContainerLogs := func(out io.Writer) error {
+				if jsonOutput {
+					return printJSONLogs(record, logs)
+				}
+				return printLogs(uniqueName, logs, out, colour, &mu)
+			}
+
 			if o.follow {
 				atomic.AddInt64(&goroutines.count, 1)
 				go func(out io.Writer) {
 					defer func() {
 						atomic.AddInt64(&goroutines.count, -1)
 					}()
-					err = printLogs(uniqueName, logs, out, colour, &mu)
+					err = printContainerLogs(out)
 					if err != nil {
 						errChan <- err
 					}
 					events.Done <- struct{}{}
 				}(o.out)
 			} else {
-				err = printLogs(uniqueName, logs, o.out, colour, &mu)
+				err = printContainerLogs(o.out)
 				if err != nil {
 					return err
 				}
 			}
+		case restart := <-events.Restarts:
+			if jsonOutput {
+				machineoutput.OutputSuccessUnindented(getRestartRecord(api.LogRecord{
+					Component: componentName,
+					Mode:      api.RunningMode(strings.ToLower(restart.Mode)),
+					Platform:  platformName,
+					Pod:       restart.PodName,
+					Container: restart.Name,
+				}, restart.Status))
+				continue
+			}
+			printRestart(uniqueNamesByContainer[restart.PodName+"/"+restart.Name], restart.Status, o.out, &mu)
 		case err = <-errChan:
 			return err
 		case err = <-events.Err:
 			return err
 		case <-events.Done:
 			if goroutines.count == 0 {
-				if len(uniqueContainerNames) == 0 {
+				if len(uniqueContainerNames) == 0 && !jsonOutput {
 					// This will be the case when:
 					// 1. user specifies --dev flag, but the component's running in Deploy mode
 					// 2. user specified --deploy flag, but the component's running in Dev mode
 					// 3. user passes no flag, but component is running in neither Dev nor Deploy mode
-					fmt.Fprintf(o.out, "no containers running in the specified mode for the component %q\n", componentName)
+					// 4. user specifies --container, but no container of the component has this name
+					// 5. user specifies --previous, but no container of the component has been restarted
+					switch {
+					case o.container != "":
+						fmt.Fprintf(o.out, "no container %q running in the specified mode for the component %q\n", o.container, componentName)
+					case o.previous:
+						fmt.Fprintf(o.out, "no restarted containers in the specified mode for the component %q\n", componentName)
+					default:
+						fmt.Fprintf(o.out, "no containers running in the specified mode for the component %q\n", componentName)
+					}
 				}
 				return nil
 			}
@@ -226,6 +348,59 @@ func printLogs(containerName string, rd io.ReadCloser, out io.Writer, colour col
 	return nil
 }
 
+// printJSONLogs outputs each line of the logs as a JSON record, completed from record, with its timestamp parsed
+func printJSONLogs(record api.LogRecord, rd io.ReadCloser) error {
+	scanner := bufio.NewScanner(rd)
+	scanner.Split(bufio.ScanLines)
+
+	record.Type = api.LogRecordTypeLog
+	for scanner.Scan() {
+		record.Timestamp, record.Message = parseTimestamp(scanner.Text())
+		machineoutput.OutputSuccessUnindented(record)
+	}
+	return nil
+}
+
+// parseTimestamp returns the RFC3339 timestamp prefixing the line of logs, if any, and the message of the line
+func parseTimestamp(line string) (timestamp string, message string) {
+	before, after, found := strings.Cut(line, " ")
+	if !found {
+		before, after = line, ""
+	}
+	if _, err := time.Parse(time.RFC3339Nano, before); err != nil {
+		return "", line
+	}
+	return before, after
+}
+
+// getRestartRecord returns the restart record of the container, completed from record, from the status of the container
+func getRestartRecord(record api.LogRecord, status corev1.ContainerStatus) api.LogRecord {
+	record.Type = api.LogRecordTypeRestart
+	record.RestartCount = status.RestartCount
+	record.Message = fmt.Sprintf("container restarted %d times", status.RestartCount)
+	if terminated := status.LastTerminationState.Terminated; terminated != nil {
+		record.Reason = terminated.Reason
+		exitCode := terminated.ExitCode
+		record.ExitCode = &exitCode
+		if !terminated.FinishedAt.IsZero() {
+			record.Timestamp = terminated.FinishedAt.UTC().Format(time.RFC3339)
+		}
+		record.Message += fmt.Sprintf(", last terminated with reason %q and exit code %d", terminated.Reason, terminated.ExitCode)
+	}
+	return record
+}
+
+// printRestart prints the restart of the container, with its unique name
+func printRestart(containerName string, status corev1.ContainerStatus, out io.Writer, mu *sync.Mutex) {
+	mu.Lock()
+	defer mu.Unlock()
+	message := "container restarted"
+	if terminated := status.LastTerminationState.Terminated; terminated != nil {
+		message += fmt.Sprintf(", the previous instance terminated with reason %q and exit code %d", terminated.Reason, terminated.ExitCode)
+	}
+	log.Fwarning(out, fmt.Sprintf("%s: %s", containerName, message))
+}
+
 func NewCmdLogs(name, fullname string) *cobra.Command {
 	o := NewLogsOptions()
 	logsCmd := &cobra.Command{
@@ -234,7 +409,7 @@ func NewCmdLogs(name, fullname string) *cobra.Command {
 		Long: `odo logs shows logs of all containers of the component. 
 By default it shows logs of all containers running in both Dev and Deploy mode. It prefixes each log message with the container name.`,
 		Example: fmt.Sprintf(logsExample, fullname),
-		Args:    cobra.MaximumNArgs(0),
+		Args:    genericclioptions.NoArgsAndSilenceJSON,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return genericclioptions.GenericRun(o, cmd, args)
 		},
@@ -242,8 +417,16 @@ By default it shows logs of all containers running in both Dev and Deploy mode.
 	logsCmd.Flags().BoolVar(&o.devMode, string(DevMode), false, "Show logs for containers running only in Dev mode")
 	logsCmd.Flags().BoolVar(&o.deployMode, string(DeployMode), false, "Show logs for containers running only in Deploy mode")
 	logsCmd.Flags().BoolVar(&o.follow, "follow", false, "Follow/tail the logs of the pods")
+	logsCmd.Flags().StringVar(&o.container, "container", "", "Show logs only for the containers with this name")
+	logsCmd.Flags().DurationVar(&o.since, "since", 0, "Show only the logs newer than a relative duration like 5s, 2m, or 3h. Defaults to all logs")
+	logsCmd.Flags().Int64Var(&o.tail, "tail", -1, "Number of lines from the end of the logs to show for each container. Defaults to all lines")
+	logsCmd.Flags().BoolVar(&o.timestamps, "timestamps", false, "Include the timestamps of the log lines")
+	logsCmd.Flags().BoolVar(&o.previous, "previous", false, "Show the logs of the previous instance of the restarted containers")
+	logsCmd.Flags().Int64Var(&o.limitBytes, "limit-bytes", 0, "Maximum number of bytes of logs to show for each container. Defaults to no limit")
 
 	clientset.Add(logsCmd, clientset.LOGS, clientset.FILESYSTEM)
+	commonflags.UseRunOnFlag(logsCmd)
+	commonflags.UseOutputFlag(logsCmd)
 	util.SetCommandGroup(logsCmd, util.MainGroup)
 	logsCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 	return logsCmd
@@ -0,0 +1,88 @@
+package logs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+func Test_parseTimestamp(t *testing.T) {
+	tests := []struct {
+		name          string
+		line          string
+		wantTimestamp string
+		wantMessage   string
+	}{
+		{
+			name:          "timestamp from the cluster",
+			line:          "2022-09-21T08:27:12.123456789Z listening on port 8080",
+			wantTimestamp: "2022-09-21T08:27:12.123456789Z",
+			wantMessage:   "listening on port 8080",
+		},
+		{
+			name:          "timestamp from podman",
+			line:          "2022-09-21T10:27:12.123456789+02:00 listening on port 8080",
+			wantTimestamp: "2022-09-21T10:27:12.123456789+02:00",
+			wantMessage:   "listening on port 8080",
+		},
+		{
+			name:          "empty line",
+			line:          "2022-09-21T08:27:12Z",
+			wantTimestamp: "2022-09-21T08:27:12Z",
+		},
+		{
+			name:        "no timestamp",
+			line:        "listening on port 8080",
+			wantMessage: "listening on port 8080",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotTimestamp, gotMessage := parseTimestamp(tt.line)
+			if gotTimestamp != tt.wantTimestamp || gotMessage != tt.wantMessage {
+				t.Errorf("parseTimestamp() = %q, %q, want %q, %q", gotTimestamp, gotMessage, tt.wantTimestamp, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func Test_getRestartRecord(t *testing.T) {
+	record := api.LogRecord{
+		Component: "my-app",
+		Mode:      api.RunningModeDev,
+		Platform:  "cluster",
+		Pod:       "my-app-app-1234",
+		Container: "runtime",
+	}
+	status := corev1.ContainerStatus{
+		Name:         "runtime",
+		RestartCount: 3,
+		LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+			Reason:     "OOMKilled",
+			ExitCode:   137,
+			FinishedAt: metav1.NewTime(time.Date(2022, 9, 21, 8, 27, 12, 0, time.UTC)),
+		}},
+	}
+	exitCode := int32(137)
+	want := api.LogRecord{
+		Type:         api.LogRecordTypeRestart,
+		Component:    "my-app",
+		Mode:         api.RunningModeDev,
+		Platform:     "cluster",
+		Pod:          "my-app-app-1234",
+		Container:    "runtime",
+		Timestamp:    "2022-09-21T08:27:12Z",
+		Message:      `container restarted 3 times, last terminated with reason "OOMKilled" and exit code 137`,
+		RestartCount: 3,
+		Reason:       "OOMKilled",
+		ExitCode:     &exitCode,
+	}
+	if diff := cmp.Diff(want, getRestartRecord(record, status)); diff != "" {
+		t.Errorf("getRestartRecord() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -28,6 +28,9 @@ var (
 
 	addExample = ktemplates.Examples(`# Add devfile registry
 	%[1]s CheRegistry https://che-devfile-registry.openshift.io
+
+	# Add a registry mirrored with odo registry mirror
+	%[1]s MirrorRegistry file:///path/to/registry-mirror
 	`)
 )
 
@@ -69,6 +72,9 @@ func (o *RegistryOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline,
 
 // Validate validates the RegistryOptions based on completed values
 func (o *RegistryOptions) Validate(ctx context.Context) (err error) {
+	if registry.IsFileRegistry(o.registryURL) {
+		return registry.ValidateFileRegistry(o.clientset.FS, o.registryURL)
+	}
 	err = util.ValidateURL(o.registryURL)
 	if err != nil {
 		return err
@@ -119,7 +125,7 @@ func NewCmdRegistry(name, fullName string) *cobra.Command {
 			return genericclioptions.GenericRun(o, cmd, args)
 		},
 	}
-	clientset.Add(registryCmd, clientset.PREFERENCE)
+	clientset.Add(registryCmd, clientset.FILESYSTEM, clientset.PREFERENCE)
 
 	registryCmd.Flags().StringVar(&o.tokenFlag, "token", "", "Token to be used to access secure registry")
 
@@ -0,0 +1,100 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/registry"
+)
+
+// MirrorRecommendedCommandName is the recommended mirror sub-command name
+const MirrorRecommendedCommandName = "mirror"
+
+var mirrorExample = templates.Examples(`
+  # Mirror the stacks of the default registry into the registry-mirror directory
+  %[1]s --to registry-mirror
+
+  # Mirror the stacks of a specific registry
+  %[1]s --devfile-registry MyRegistry --to registry-mirror
+
+  # Use the mirror as a registry, on a host without network access
+  odo preference add registry Mirror file:///path/to/registry-mirror
+`)
+
+// MirrorOptions encapsulates the options for the odo registry mirror command
+type MirrorOptions struct {
+	clientset *clientset.Clientset
+
+	// Flags
+	toFlag       string
+	registryFlag string
+}
+
+var _ genericclioptions.Runnable = (*MirrorOptions)(nil)
+
+// NewMirrorOptions creates a new MirrorOptions instance
+func NewMirrorOptions() *MirrorOptions {
+	return &MirrorOptions{}
+}
+
+func (o *MirrorOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes MirrorOptions after they've been created
+func (o *MirrorOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	return nil
+}
+
+// Validate validates the MirrorOptions based on completed values
+func (o *MirrorOptions) Validate(ctx context.Context) error {
+	if o.toFlag == "" {
+		return errors.New("the directory to mirror the registry into must be specified with --to")
+	}
+	return nil
+}
+
+// Run contains the logic for the command associated with MirrorOptions
+func (o *MirrorOptions) Run(ctx context.Context) error {
+	err := o.clientset.RegistryClient.MirrorRegistry(ctx, o.registryFlag, o.toFlag)
+	if err != nil {
+		return err
+	}
+	url, err := registry.GetFileRegistryURL(o.toFlag)
+	if err != nil {
+		return err
+	}
+	log.Successf("Registry %q mirrored into %s", o.registryFlag, o.toFlag)
+	log.Infof("\nUse the mirror as a registry with:\n  odo preference add registry <registry name> %s", url)
+	return nil
+}
+
+// NewCmdMirror implements the odo registry mirror command
+func NewCmdMirror(name, fullName string) *cobra.Command {
+	o := NewMirrorOptions()
+	mirrorCmd := &cobra.Command{
+		Use:   name,
+		Short: "Mirror a Devfile registry into a directory",
+		Long: "Snapshot all the versions of the stacks of a Devfile registry, with their resources, parent Devfiles and starter projects, into a directory.\n" +
+			"The directory can then be used as a registry, with a file:// URL, without network access.",
+		Example: fmt.Sprintf(mirrorExample, fullName),
+		Args:    cobra.MaximumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(mirrorCmd, clientset.REGISTRY)
+
+	mirrorCmd.Flags().StringVar(&o.toFlag, "to", "", "Directory to mirror the registry into")
+	mirrorCmd.Flags().StringVar(&o.registryFlag, "devfile-registry", preference.DefaultDevfileRegistryName, "Name of the Devfile registry to mirror")
+	return mirrorCmd
+}
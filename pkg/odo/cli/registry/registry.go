@@ -8,11 +8,13 @@ import (
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/spf13/cobra"
+	"k8s.io/klog"
 
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
@@ -31,6 +33,12 @@ var Example = `  # Get all devfile components
 # Filter by name and devfile registry
 %[1]s --filter nodejs --devfile-registry DefaultDevfileRegistry
 
+# Filter by tags, language and devfile schema version
+%[1]s --tags Java,Quarkus --language java --schema-version 2.2
+
+# Filter by project type and provider
+%[1]s --project-type springboot --provider "Red Hat"
+
 # Show more details
 %[1]s --details
 
@@ -45,10 +53,15 @@ type ListOptions struct {
 	devfileList registry.DevfileStackList
 
 	// Flags
-	filterFlag   string
-	devfileFlag  string
-	registryFlag string
-	detailsFlag  bool
+	filterFlag        string
+	tagsFlag          []string
+	languageFlag      string
+	projectTypeFlag   string
+	schemaVersionFlag string
+	providerFlag      string
+	devfileFlag       string
+	registryFlag      string
+	detailsFlag       bool
 }
 
 var _ genericclioptions.Runnable = (*ListOptions)(nil)
@@ -66,7 +79,24 @@ func (o *ListOptions) SetClientset(clientset *clientset.Clientset) {
 // Complete completes ListOptions after they've been created
 func (o *ListOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
 
-	o.devfileList, err = o.clientset.RegistryClient.ListDevfileStacks(ctx, o.registryFlag, o.devfileFlag, o.filterFlag, o.detailsFlag)
+	filter := registry.Filter{
+		Text:          o.filterFlag,
+		Tags:          o.tagsFlag,
+		Language:      o.languageFlag,
+		ProjectType:   o.projectTypeFlag,
+		SchemaVersion: o.schemaVersionFlag,
+		Provider:      o.providerFlag,
+	}
+	// The stacks of the languages of the current directory are listed first.
+	// The languages are not needed when a single Devfile stack is requested, as the stacks are not ranked
+	if o.devfileFlag == "" {
+		filter.PreferredLanguages, err = o.clientset.AlizerClient.DetectLanguages(odocontext.GetWorkingDirectory(ctx))
+		if err != nil {
+			klog.V(4).Infof("unable to detect the languages of the current directory: %v", err)
+		}
+	}
+
+	o.devfileList, err = o.clientset.RegistryClient.ListDevfileStacks(ctx, o.registryFlag, o.devfileFlag, filter, o.detailsFlag)
 	if err != nil {
 		return err
 	}
@@ -95,9 +125,21 @@ func (o *ListOptions) Run(ctx context.Context) (err error) {
 	return nil
 }
 
-// Run contains the logic for the command associated with ListOptions
+// RunForJsonOutput contains the logic for the command associated with ListOptions, for JSON output
 func (o *ListOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
-	return o.devfileList.Items, nil
+	items := make([]api.DevfileStack, 0, len(o.devfileList.Items))
+	for _, item := range o.devfileList.Items {
+		if len(item.Versions) == 0 && item.DefaultVersion != "" {
+			// For backward compatibility, the default version is the only version of registries without versions
+			item.Versions = []api.DevfileStackVersion{{
+				Version:         item.DefaultVersion,
+				IsDefault:       true,
+				StarterProjects: item.DefaultStarterProjects,
+			}}
+		}
+		items = append(items, item)
+	}
+	return items, nil
 }
 
 func NewCmdRegistry(name, fullName string) *cobra.Command {
@@ -113,10 +155,15 @@ func NewCmdRegistry(name, fullName string) *cobra.Command {
 		},
 	}
 
-	clientset.Add(listCmd, clientset.REGISTRY)
+	clientset.Add(listCmd, clientset.ALIZER, clientset.REGISTRY)
 
 	// Flags
 	listCmd.Flags().StringVar(&o.filterFlag, "filter", "", "Filter based on the name or description of the component")
+	listCmd.Flags().StringSliceVar(&o.tagsFlag, "tags", nil, "Only show components having all these tags")
+	listCmd.Flags().StringVar(&o.languageFlag, "language", "", "Only show components of this language")
+	listCmd.Flags().StringVar(&o.projectTypeFlag, "project-type", "", "Only show components of this project type")
+	listCmd.Flags().StringVar(&o.schemaVersionFlag, "schema-version", "", "Only show components with a version using this Devfile schema version, like 2.2 or 2.2.0")
+	listCmd.Flags().StringVar(&o.providerFlag, "provider", "", "Only show components from this provider")
 	listCmd.Flags().StringVar(&o.devfileFlag, "devfile", "", "Only the specific Devfile component")
 	listCmd.Flags().StringVar(&o.registryFlag, "devfile-registry", "", "Only show components from the specific Devfile registry")
 	listCmd.Flags().BoolVar(&o.detailsFlag, "details", false, "Show details of each component")
@@ -126,6 +173,9 @@ func NewCmdRegistry(name, fullName string) *cobra.Command {
 	listCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 
 	commonflags.UseOutputFlag(listCmd)
+
+	mirrorCmd := NewCmdMirror(MirrorRecommendedCommandName, odoutil.GetFullName(fullName, MirrorRecommendedCommandName))
+	listCmd.AddCommand(mirrorCmd)
 	return listCmd
 }
 
@@ -0,0 +1,90 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/edit"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "command"
+
+var removeCommandExample = ktemplates.Examples(`
+  # Remove a command
+  %[1]s lint
+`)
+
+// RemoveCommandOptions encapsulates the options for the odo remove command command
+type RemoveCommandOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Variables
+	id string
+}
+
+var _ genericclioptions.Runnable = (*RemoveCommandOptions)(nil)
+
+// NewRemoveCommandOptions creates a new RemoveCommandOptions instance
+func NewRemoveCommandOptions() *RemoveCommandOptions {
+	return &RemoveCommandOptions{}
+}
+
+func (o *RemoveCommandOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes RemoveCommandOptions after they've been created
+func (o *RemoveCommandOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	if odocontext.GetDevfileObj(ctx) == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	o.id = args[0]
+	return nil
+}
+
+// Validate validates the RemoveCommandOptions based on completed values
+func (o *RemoveCommandOptions) Validate(ctx context.Context) (err error) {
+	return nil
+}
+
+// Run contains the logic for the odo remove command command
+func (o *RemoveCommandOptions) Run(ctx context.Context) (err error) {
+	err = edit.EditFile(o.clientset.FS, odocontext.GetDevfilePath(ctx), func(d *edit.Devfile) error {
+		return d.RemoveCommand(o.id)
+	})
+	if err != nil {
+		return err
+	}
+	log.Successf("The command %q has been removed from the devfile", o.id)
+	return nil
+}
+
+// NewCmdCommand implements the odo remove command command
+func NewCmdCommand(name, fullName string) *cobra.Command {
+	o := NewRemoveCommandOptions()
+	commandCmd := &cobra.Command{
+		Use:   name + " ID",
+		Short: "Remove a command from the devfile",
+		Long: `Remove a command from the devfile. The devfile is modified only if the result is valid:
+the composite commands and the events referencing the command must be modified first.`,
+		Example: fmt.Sprintf(removeCommandExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(commandCmd, clientset.FILESYSTEM)
+	commandCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return commandCmd
+}
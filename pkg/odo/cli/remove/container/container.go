@@ -0,0 +1,90 @@
+package container
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/edit"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "container"
+
+var removeContainerExample = ktemplates.Examples(`
+  # Remove a container component
+  %[1]s db
+`)
+
+// RemoveContainerOptions encapsulates the options for the odo remove container command
+type RemoveContainerOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Variables
+	name string
+}
+
+var _ genericclioptions.Runnable = (*RemoveContainerOptions)(nil)
+
+// NewRemoveContainerOptions creates a new RemoveContainerOptions instance
+func NewRemoveContainerOptions() *RemoveContainerOptions {
+	return &RemoveContainerOptions{}
+}
+
+func (o *RemoveContainerOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes RemoveContainerOptions after they've been created
+func (o *RemoveContainerOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	if odocontext.GetDevfileObj(ctx) == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	o.name = args[0]
+	return nil
+}
+
+// Validate validates the RemoveContainerOptions based on completed values
+func (o *RemoveContainerOptions) Validate(ctx context.Context) (err error) {
+	return nil
+}
+
+// Run contains the logic for the odo remove container command
+func (o *RemoveContainerOptions) Run(ctx context.Context) (err error) {
+	err = edit.EditFile(o.clientset.FS, odocontext.GetDevfilePath(ctx), func(d *edit.Devfile) error {
+		return d.RemoveContainer(o.name)
+	})
+	if err != nil {
+		return err
+	}
+	log.Successf("The container component %q has been removed from the devfile", o.name)
+	return nil
+}
+
+// NewCmdContainer implements the odo remove container command
+func NewCmdContainer(name, fullName string) *cobra.Command {
+	o := NewRemoveContainerOptions()
+	containerCmd := &cobra.Command{
+		Use:   name + " NAME",
+		Short: "Remove a container component from the devfile",
+		Long: `Remove a container component from the devfile. The devfile is modified only if the result is valid:
+the commands running in the container must be removed first.`,
+		Example: fmt.Sprintf(removeContainerExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(containerCmd, clientset.FILESYSTEM)
+	containerCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return containerCmd
+}
@@ -0,0 +1,89 @@
+package endpoint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/edit"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "endpoint"
+
+var removeEndpointExample = ktemplates.Examples(`
+  # Remove an endpoint from the container component defining it
+  %[1]s http-admin
+`)
+
+// RemoveEndpointOptions encapsulates the options for the odo remove endpoint command
+type RemoveEndpointOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Variables
+	name string
+}
+
+var _ genericclioptions.Runnable = (*RemoveEndpointOptions)(nil)
+
+// NewRemoveEndpointOptions creates a new RemoveEndpointOptions instance
+func NewRemoveEndpointOptions() *RemoveEndpointOptions {
+	return &RemoveEndpointOptions{}
+}
+
+func (o *RemoveEndpointOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes RemoveEndpointOptions after they've been created
+func (o *RemoveEndpointOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	if odocontext.GetDevfileObj(ctx) == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	o.name = args[0]
+	return nil
+}
+
+// Validate validates the RemoveEndpointOptions based on completed values
+func (o *RemoveEndpointOptions) Validate(ctx context.Context) (err error) {
+	return nil
+}
+
+// Run contains the logic for the odo remove endpoint command
+func (o *RemoveEndpointOptions) Run(ctx context.Context) (err error) {
+	err = edit.EditFile(o.clientset.FS, odocontext.GetDevfilePath(ctx), func(d *edit.Devfile) error {
+		return d.RemoveEndpoint(o.name)
+	})
+	if err != nil {
+		return err
+	}
+	log.Successf("The endpoint %q has been removed from the devfile", o.name)
+	return nil
+}
+
+// NewCmdEndpoint implements the odo remove endpoint command
+func NewCmdEndpoint(name, fullName string) *cobra.Command {
+	o := NewRemoveEndpointOptions()
+	endpointCmd := &cobra.Command{
+		Use:     name + " NAME",
+		Short:   "Remove an endpoint from the devfile",
+		Long:    `Remove an endpoint from the container component defining it. The devfile is modified only if the result is valid.`,
+		Example: fmt.Sprintf(removeEndpointExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(endpointCmd, clientset.FILESYSTEM)
+	endpointCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return endpointCmd
+}
@@ -0,0 +1,99 @@
+package env
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/edit"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "env"
+
+var removeEnvExample = ktemplates.Examples(`
+  # Remove environment variables from the only container component of the devfile
+  %[1]s DEBUG LOG_LEVEL
+
+  # Remove an environment variable from a container component
+  %[1]s --container db POSTGRES_PASSWORD
+`)
+
+// RemoveEnvOptions encapsulates the options for the odo remove env command
+type RemoveEnvOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	containerFlag string
+
+	// Variables
+	names     []string
+	container string
+}
+
+var _ genericclioptions.Runnable = (*RemoveEnvOptions)(nil)
+
+// NewRemoveEnvOptions creates a new RemoveEnvOptions instance
+func NewRemoveEnvOptions() *RemoveEnvOptions {
+	return &RemoveEnvOptions{}
+}
+
+func (o *RemoveEnvOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes RemoveEnvOptions after they've been created
+func (o *RemoveEnvOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	devfileObj := odocontext.GetDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	o.names = args
+	o.container, err = edit.ContainerName(*devfileObj, o.containerFlag)
+	return err
+}
+
+// Validate validates the RemoveEnvOptions based on completed values
+func (o *RemoveEnvOptions) Validate(ctx context.Context) (err error) {
+	return nil
+}
+
+// Run contains the logic for the odo remove env command
+func (o *RemoveEnvOptions) Run(ctx context.Context) (err error) {
+	err = edit.EditFile(o.clientset.FS, odocontext.GetDevfilePath(ctx), func(d *edit.Devfile) error {
+		return d.RemoveEnv(o.container, o.names)
+	})
+	if err != nil {
+		return err
+	}
+	log.Successf("The environment variables have been removed from the container component %q", o.container)
+	return nil
+}
+
+// NewCmdEnv implements the odo remove env command
+func NewCmdEnv(name, fullName string) *cobra.Command {
+	o := NewRemoveEnvOptions()
+	envCmd := &cobra.Command{
+		Use:     name + " NAME...",
+		Short:   "Remove environment variables from a container component of the devfile",
+		Long:    "Remove environment variables from a container component of the devfile. The devfile is modified only if the result is valid.",
+		Example: fmt.Sprintf(removeEnvExample, fullName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	envCmd.Flags().StringVar(&o.containerFlag, "container", "", "Container component of the environment variables; can be omitted if the devfile defines a single container component")
+	clientset.Add(envCmd, clientset.FILESYSTEM)
+	envCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return envCmd
+}
@@ -4,6 +4,11 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/redhat-developer/odo/pkg/odo/cli/remove/binding"
+	"github.com/redhat-developer/odo/pkg/odo/cli/remove/command"
+	"github.com/redhat-developer/odo/pkg/odo/cli/remove/container"
+	"github.com/redhat-developer/odo/pkg/odo/cli/remove/endpoint"
+	"github.com/redhat-developer/odo/pkg/odo/cli/remove/env"
+	"github.com/redhat-developer/odo/pkg/odo/cli/remove/volume"
 	"github.com/redhat-developer/odo/pkg/odo/util"
 )
 
@@ -19,6 +24,11 @@ func NewCmdRemove(name, fullName string) *cobra.Command {
 
 	bindingCmd := binding.NewCmdBinding(binding.BindingRecommendedCommandName, util.GetFullName(fullName, binding.BindingRecommendedCommandName))
 	removeCmd.AddCommand(bindingCmd)
+	removeCmd.AddCommand(command.NewCmdCommand(command.RecommendedCommandName, util.GetFullName(fullName, command.RecommendedCommandName)))
+	removeCmd.AddCommand(container.NewCmdContainer(container.RecommendedCommandName, util.GetFullName(fullName, container.RecommendedCommandName)))
+	removeCmd.AddCommand(endpoint.NewCmdEndpoint(endpoint.RecommendedCommandName, util.GetFullName(fullName, endpoint.RecommendedCommandName)))
+	removeCmd.AddCommand(env.NewCmdEnv(env.RecommendedCommandName, util.GetFullName(fullName, env.RecommendedCommandName)))
+	removeCmd.AddCommand(volume.NewCmdVolume(volume.RecommendedCommandName, util.GetFullName(fullName, volume.RecommendedCommandName)))
 	util.SetCommandGroup(removeCmd, util.ManagementGroup)
 	removeCmd.SetUsageTemplate(util.CmdUsageTemplate)
 
@@ -0,0 +1,90 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/edit"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "volume"
+
+var removeVolumeExample = ktemplates.Examples(`
+  # Remove a volume, and its mounts from the container components
+  %[1]s data
+`)
+
+// RemoveVolumeOptions encapsulates the options for the odo remove volume command
+type RemoveVolumeOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Variables
+	name string
+}
+
+var _ genericclioptions.Runnable = (*RemoveVolumeOptions)(nil)
+
+// NewRemoveVolumeOptions creates a new RemoveVolumeOptions instance
+func NewRemoveVolumeOptions() *RemoveVolumeOptions {
+	return &RemoveVolumeOptions{}
+}
+
+func (o *RemoveVolumeOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes RemoveVolumeOptions after they've been created
+func (o *RemoveVolumeOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	if odocontext.GetDevfileObj(ctx) == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	o.name = args[0]
+	return nil
+}
+
+// Validate validates the RemoveVolumeOptions based on completed values
+func (o *RemoveVolumeOptions) Validate(ctx context.Context) (err error) {
+	return nil
+}
+
+// Run contains the logic for the odo remove volume command
+func (o *RemoveVolumeOptions) Run(ctx context.Context) (err error) {
+	err = edit.EditFile(o.clientset.FS, odocontext.GetDevfilePath(ctx), func(d *edit.Devfile) error {
+		return d.RemoveVolume(o.name)
+	})
+	if err != nil {
+		return err
+	}
+	log.Successf("The volume %q has been removed from the devfile", o.name)
+	return nil
+}
+
+// NewCmdVolume implements the odo remove volume command
+func NewCmdVolume(name, fullName string) *cobra.Command {
+	o := NewRemoveVolumeOptions()
+	volumeCmd := &cobra.Command{
+		Use:   name + " NAME",
+		Short: "Remove a volume from the devfile",
+		Long: `Remove a volume component from the devfile, and its mounts from the container components.
+The devfile is modified only if the result is valid.`,
+		Example: fmt.Sprintf(removeVolumeExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(volumeCmd, clientset.FILESYSTEM)
+	volumeCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return volumeCmd
+}
@@ -0,0 +1,130 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/edit"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "command"
+
+var setCommandExample = ktemplates.Examples(`
+  # Make a command the default command of the run group
+  %[1]s run-debug --group run --default
+
+  # Move a command to the test group
+  %[1]s integration --group test
+
+  # Remove a command from its group
+  %[1]s lint --no-group
+`)
+
+// SetCommandOptions encapsulates the options for the odo set command command
+type SetCommandOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	groupFlag   string
+	defaultFlag bool
+	noGroupFlag bool
+
+	// Variables
+	id string
+}
+
+var _ genericclioptions.Runnable = (*SetCommandOptions)(nil)
+
+// NewSetCommandOptions creates a new SetCommandOptions instance
+func NewSetCommandOptions() *SetCommandOptions {
+	return &SetCommandOptions{}
+}
+
+func (o *SetCommandOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes SetCommandOptions after they've been created
+func (o *SetCommandOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	if odocontext.GetDevfileObj(ctx) == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	o.id = args[0]
+	return nil
+}
+
+// Validate validates the SetCommandOptions based on completed values
+func (o *SetCommandOptions) Validate(ctx context.Context) (err error) {
+	if o.noGroupFlag {
+		if o.groupFlag != "" || o.defaultFlag {
+			return errors.New("--no-group cannot be used with --group or --default")
+		}
+		return nil
+	}
+	if o.groupFlag == "" {
+		return errors.New("the group of the command must be specified with --group, or removed with --no-group")
+	}
+	return edit.ValidateGroupKind(o.groupFlag)
+}
+
+// Run contains the logic for the odo set command command
+func (o *SetCommandOptions) Run(ctx context.Context) (err error) {
+	var group *devfilev1.CommandGroup
+	if !o.noGroupFlag {
+		group = &devfilev1.CommandGroup{Kind: devfilev1.CommandGroupKind(o.groupFlag)}
+		if o.defaultFlag {
+			group.IsDefault = &o.defaultFlag
+		}
+	}
+	err = edit.EditFile(o.clientset.FS, odocontext.GetDevfilePath(ctx), func(d *edit.Devfile) error {
+		return d.SetCommandGroup(o.id, group)
+	})
+	if err != nil {
+		return err
+	}
+	switch {
+	case group == nil:
+		log.Successf("The command %q has been removed from its group", o.id)
+	case o.defaultFlag:
+		log.Successf("The command %q is now the default command of the %s group", o.id, o.groupFlag)
+	default:
+		log.Successf("The command %q is now in the %s group", o.id, o.groupFlag)
+	}
+	return nil
+}
+
+// NewCmdCommand implements the odo set command command
+func NewCmdCommand(name, fullName string) *cobra.Command {
+	o := NewSetCommandOptions()
+	commandCmd := &cobra.Command{
+		Use:   name + " ID",
+		Short: "Set the group of a command of the devfile",
+		Long: `Set the group of an exec, composite or apply command of the devfile. The devfile is modified only if the result is valid.
+
+If the command becomes the default command of the group, the other commands of the group are not default anymore.`,
+		Example: fmt.Sprintf(setCommandExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	commandCmd.Flags().StringVar(&o.groupFlag, "group", "", "Group of the command: build, run, test, debug or deploy")
+	commandCmd.Flags().BoolVar(&o.defaultFlag, "default", false, "If true, the command is the default command of its group")
+	commandCmd.Flags().BoolVar(&o.noGroupFlag, "no-group", false, "If true, the command is removed from its group")
+	clientset.Add(commandCmd, clientset.FILESYSTEM)
+	commandCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return commandCmd
+}
@@ -0,0 +1,105 @@
+package env
+
+import (
+	"context"
+	"fmt"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/edit"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "env"
+
+var setEnvExample = ktemplates.Examples(`
+  # Set environment variables of the only container component of the devfile
+  %[1]s DEBUG=false LOG_LEVEL=debug
+
+  # Set an environment variable of a container component
+  %[1]s --container db POSTGRES_PASSWORD=changed
+`)
+
+// SetEnvOptions encapsulates the options for the odo set env command
+type SetEnvOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	containerFlag string
+
+	// Variables
+	envVars   []devfilev1.EnvVar
+	container string
+}
+
+var _ genericclioptions.Runnable = (*SetEnvOptions)(nil)
+
+// NewSetEnvOptions creates a new SetEnvOptions instance
+func NewSetEnvOptions() *SetEnvOptions {
+	return &SetEnvOptions{}
+}
+
+func (o *SetEnvOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes SetEnvOptions after they've been created
+func (o *SetEnvOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	devfileObj := odocontext.GetDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	o.envVars, err = edit.ParseEnvVars(args)
+	if err != nil {
+		return err
+	}
+	o.container, err = edit.ContainerName(*devfileObj, o.containerFlag)
+	return err
+}
+
+// Validate validates the SetEnvOptions based on completed values
+func (o *SetEnvOptions) Validate(ctx context.Context) (err error) {
+	return nil
+}
+
+// Run contains the logic for the odo set env command
+func (o *SetEnvOptions) Run(ctx context.Context) (err error) {
+	err = edit.EditFile(o.clientset.FS, odocontext.GetDevfilePath(ctx), func(d *edit.Devfile) error {
+		return d.SetEnv(o.container, o.envVars, true)
+	})
+	if err != nil {
+		return err
+	}
+	log.Successf("The environment variables have been set in the container component %q", o.container)
+	return nil
+}
+
+// NewCmdEnv implements the odo set env command
+func NewCmdEnv(name, fullName string) *cobra.Command {
+	o := NewSetEnvOptions()
+	envCmd := &cobra.Command{
+		Use:   name + " NAME=VALUE...",
+		Short: "Set environment variables of a container component of the devfile",
+		Long: `Set environment variables of a container component of the devfile. The devfile is modified only if the result is valid.
+
+The values of the variables already defined are replaced, and the other variables are added.`,
+		Example: fmt.Sprintf(setEnvExample, fullName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	envCmd.Flags().StringVar(&o.containerFlag, "container", "", "Container component of the environment variables; can be omitted if the devfile defines a single container component")
+	clientset.Add(envCmd, clientset.FILESYSTEM)
+	envCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return envCmd
+}
@@ -3,6 +3,8 @@ package set
 import (
 	"fmt"
 
+	"github.com/redhat-developer/odo/pkg/odo/cli/set/command"
+	"github.com/redhat-developer/odo/pkg/odo/cli/set/env"
 	"github.com/redhat-developer/odo/pkg/odo/cli/set/namespace"
 	"github.com/redhat-developer/odo/pkg/odo/util"
 
@@ -17,16 +19,22 @@ func NewCmdSet(name, fullName string) *cobra.Command {
 
 	namespaceSetCmd := namespace.NewCmdNamespaceSet(namespace.RecommendedCommandName,
 		util.GetFullName(fullName, namespace.RecommendedCommandName))
+	commandSetCmd := command.NewCmdCommand(command.RecommendedCommandName,
+		util.GetFullName(fullName, command.RecommendedCommandName))
+	envSetCmd := env.NewCmdEnv(env.RecommendedCommandName,
+		util.GetFullName(fullName, env.RecommendedCommandName))
 	setCmd := &cobra.Command{
 		Use:   name + " [options]",
 		Short: "Perform set operation",
 		Long:  "Perform set operation",
-		Example: fmt.Sprintf("%s\n",
+		Example: fmt.Sprintf("%s\n\n%s\n\n%s\n",
 			namespaceSetCmd.Example,
+			envSetCmd.Example,
+			commandSetCmd.Example,
 		),
 	}
 
-	setCmd.AddCommand(namespaceSetCmd)
+	setCmd.AddCommand(namespaceSetCmd, envSetCmd, commandSetCmd)
 
 	util.SetCommandGroup(setCmd, util.ManagementGroup)
 	setCmd.SetUsageTemplate(util.CmdUsageTemplate)
@@ -242,6 +242,7 @@ func Fetch(command *cobra.Command, platform string) (*Clientset, error) {
 		case commonflags.RunOnPodman:
 			dep.DevClient = podmandev.NewDevClient(
 				dep.PodmanClient,
+				dep.KubernetesClient,
 				dep.SyncClient,
 				dep.ExecClient,
 				dep.StateClient,
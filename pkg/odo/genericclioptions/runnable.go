@@ -66,10 +66,17 @@ type PreIniter interface {
 	PreInit() string
 }
 
+// A DevfileParser command parses the Devfile by itself, for example to report all the problems of an invalid Devfile.
+// The Devfile is not parsed before running the command, and is not available from the context
+type DevfileParser interface {
+	ParsesDevfile()
+}
+
 // JsonOutputter must be implemented by commands with JSON output
 // For these commands, the `-o json` flag will be added
 // when err is not nil, the text of the error will be returned in a `message` field on stderr with an exit status of 1
-// when err is nil, the result of RunForJsonOutput will be returned in JSON format on stdout with an exit status of 0
+// when err is nil, the result of RunForJsonOutput will be returned in JSON format on stdout with an exit status of 0,
+// unless the result is nil, for the commands writing their JSON output themselves, for example as JSON lines
 type JsonOutputter interface {
 	RunForJsonOutput(ctx context.Context) (result interface{}, err error)
 }
@@ -244,16 +251,18 @@ func GenericRun(o Runnable, cmd *cobra.Command, args []string) error {
 			}
 		}
 
-		var devfilePath, componentName string
-		var devfileObj *parser.DevfileObj
-		devfilePath, devfileObj, componentName, err = getDevfileInfo(cwd, variables)
-		if err != nil {
-			startTelemetry(cmd, err, startTime)
-			return err
+		if _, ok := o.(DevfileParser); !ok {
+			var devfilePath, componentName string
+			var devfileObj *parser.DevfileObj
+			devfilePath, devfileObj, componentName, err = getDevfileInfo(cwd, variables)
+			if err != nil {
+				startTelemetry(cmd, err, startTime)
+				return err
+			}
+			ctx = odocontext.WithDevfilePath(ctx, devfilePath)
+			ctx = odocontext.WithDevfileObj(ctx, devfileObj)
+			ctx = odocontext.WithComponentName(ctx, componentName)
 		}
-		ctx = odocontext.WithDevfilePath(ctx, devfilePath)
-		ctx = odocontext.WithDevfileObj(ctx, devfileObj)
-		ctx = odocontext.WithComponentName(ctx, componentName)
 	}
 
 	// Run completion, validation and run.
@@ -273,7 +282,7 @@ func GenericRun(o Runnable, cmd *cobra.Command, args []string) error {
 	if jsonOutputter, ok := o.(JsonOutputter); ok && log.IsJSON() {
 		var out interface{}
 		out, err = jsonOutputter.RunForJsonOutput(ctx)
-		if err == nil {
+		if err == nil && out != nil {
 			machineoutput.OutputSuccess(out)
 		}
 	} else {
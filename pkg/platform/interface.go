@@ -16,7 +16,8 @@ type Client interface {
 
 	// GetPodLogs returns the logs of the specified pod container.
 	// All logs for all containers part of the pod are returned if an empty string is provided as container name.
-	GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error)
+	// The logs returned are filtered with the options.
+	GetPodLogs(podName, containerName string, options LogOptions) (io.ReadCloser, error)
 
 	// GetPodsMatchingSelector returns all pods matching the given label selector.
 	GetPodsMatchingSelector(selector string) (*corev1.PodList, error)
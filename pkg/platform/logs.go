@@ -0,0 +1,19 @@
+package platform
+
+import "time"
+
+// LogOptions are the options to get the logs of a container
+type LogOptions struct {
+	// Follow streams the logs of the container until it stops
+	Follow bool
+	// Since returns only the logs newer than this duration, or all the logs when zero
+	Since time.Duration
+	// TailLines, if set, returns only this number of lines from the end of the logs
+	TailLines *int64
+	// Timestamps prefixes each line of the logs with its RFC3339 timestamp
+	Timestamps bool
+	// Previous returns the logs of the previous terminated instance of the container
+	Previous bool
+	// LimitBytes, if set, is the maximum number of bytes of logs to return
+	LimitBytes *int64
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/redhat-developer/odo/pkg/api"
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
@@ -13,8 +14,9 @@ import (
 )
 
 type ListPodsReport struct {
-	Name   string
-	Labels map[string]string
+	Name    string
+	Labels  map[string]string
+	Created string
 }
 
 func (o *PodmanCli) ListAllComponents() ([]api.ComponentAbstract, error) {
@@ -72,6 +74,9 @@ func (o *PodmanCli) ListAllComponents() ([]api.ComponentAbstract, error) {
 			ManagedByVersion: managedByVersion,
 			RunningOn:        commonflags.RunOnPodman,
 		}
+		if created, err := time.Parse(time.RFC3339Nano, pod.Created); err == nil {
+			component.CreatedAt = &created
+		}
 		mode := odolabels.GetMode(labels)
 		if mode != "" {
 			component.RunningIn = api.NewRunningModes()
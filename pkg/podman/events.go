@@ -0,0 +1,92 @@
+package podman
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os/exec"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog"
+)
+
+// podEvent is an event of a pod, as returned by the "podman events" command
+type podEvent struct {
+	Name   string
+	Status string
+	Type   string
+}
+
+// PodsWatcher returns a watcher receiving an event each time a pod is created, started, stopped or removed.
+// The watcher relies on a "podman events" process, killed when the watcher is stopped.
+func (o *PodmanCli) PodsWatcher(ctx context.Context) (watch.Interface, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	cmd := exec.CommandContext(ctx, o.podmanCmd, "events", "--format", "json", "--filter", "type=pod")
+	klog.V(3).Infof("executing %v", cmd.Args)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if err = cmd.Start(); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	resultChan := make(chan watch.Event)
+	watcher := watch.NewProxyWatcher(resultChan)
+
+	go func() {
+		<-watcher.StopChan()
+		cancel()
+	}()
+
+	go func() {
+		defer close(resultChan)
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			event, err := parsePodEvent(scanner.Bytes())
+			if err != nil {
+				klog.V(4).Infof("unable to parse podman event %q: %v", scanner.Text(), err)
+				continue
+			}
+			select {
+			case resultChan <- event:
+			case <-watcher.StopChan():
+				return
+			}
+		}
+		err := cmd.Wait()
+		klog.V(4).Infof("podman events terminated: %v", err)
+	}()
+
+	return watcher, nil
+}
+
+// parsePodEvent parses a line of the output of "podman events --format json" into a watch event on the pod
+func parsePodEvent(line []byte) (watch.Event, error) {
+	var event podEvent
+	err := json.Unmarshal(line, &event)
+	if err != nil {
+		return watch.Event{}, err
+	}
+	var eventType watch.EventType
+	switch event.Status {
+	case "create":
+		eventType = watch.Added
+	case "remove":
+		eventType = watch.Deleted
+	default:
+		eventType = watch.Modified
+	}
+	return watch.Event{
+		Type: eventType,
+		Object: &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: event.Name,
+			},
+		},
+	}, nil
+}
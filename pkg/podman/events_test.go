@@ -0,0 +1,61 @@
+package podman
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func Test_parsePodEvent(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     string
+		wantType watch.EventType
+		wantErr  bool
+	}{
+		{
+			name:     "pod created",
+			line:     `{"ID":"1234","Name":"my-app-app","Status":"create","Time":"2023-01-19T10:00:00+01:00","Type":"pod"}`,
+			wantType: watch.Added,
+		},
+		{
+			name:     "pod started",
+			line:     `{"ID":"1234","Name":"my-app-app","Status":"start","Time":"2023-01-19T10:00:00+01:00","Type":"pod"}`,
+			wantType: watch.Modified,
+		},
+		{
+			name:     "pod removed",
+			line:     `{"ID":"1234","Name":"my-app-app","Status":"remove","Time":"2023-01-19T10:00:00+01:00","Type":"pod"}`,
+			wantType: watch.Deleted,
+		},
+		{
+			name:    "invalid event",
+			line:    `not json`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePodEvent([]byte(tt.line))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parsePodEvent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			want := watch.Event{
+				Type: tt.wantType,
+				Object: &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-app-app"},
+				},
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("parsePodEvent() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -1,16 +1,24 @@
 package podman
 
 import (
+	"context"
 	"io"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/platform"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
 )
 
 type Client interface {
-	// PlayKube creates the Pod with Podman
-	PlayKube(pod *corev1.Pod) error
+	// PlayKube creates the Pod with Podman.
+	// If network is not empty, it is used as the network mode of the pod
+	PlayKube(pod *corev1.Pod, network string) error
+
+	// HostLoopbackNetwork returns the network mode allowing the containers of a pod to reach the loopback address
+	// of the host through host.containers.internal, or an empty string if the network of podman does not support it
+	HostLoopbackNetwork() (string, error)
 
 	// KubeGenerate returns a Kubernetes Pod definition of an existing Pod
 	KubeGenerate(name string) (*corev1.Pod, error)
@@ -37,7 +45,8 @@ type Client interface {
 
 	// GetPodLogs returns the logs of the specified pod container.
 	// All logs for all containers part of the pod are returned if an empty string is provided as container name.
-	GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error)
+	// The logs returned are filtered with the options.
+	GetPodLogs(podName, containerName string, options platform.LogOptions) (io.ReadCloser, error)
 
 	// GetPodsMatchingSelector returns all pods matching the given label selector.
 	GetPodsMatchingSelector(selector string) (*corev1.PodList, error)
@@ -53,4 +62,7 @@ type Client interface {
 	GetRunningPodFromSelector(selector string) (*corev1.Pod, error)
 
 	ListAllComponents() ([]api.ComponentAbstract, error)
+
+	// PodsWatcher returns a watcher receiving an event each time a pod is created, started, stopped or removed
+	PodsWatcher(ctx context.Context) (watch.Interface, error)
 }
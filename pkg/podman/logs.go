@@ -1,10 +1,77 @@
 package podman
 
-import "io"
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+)
 
 // GetPodLogs returns the logs of the specified pod container.
 // All logs for all containers part of the pod are returned if an empty string is provided as container name.
-func (o *PodmanCli) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
-	// TODO(feloy)
-	return nil, nil
+// The logs of the previous instance of a container are not available, as podman keeps the logs of all the instances
+// of a restarted container together.
+func (o *PodmanCli) GetPodLogs(podName, containerName string, options platform.LogOptions) (io.ReadCloser, error) {
+	if options.Previous {
+		return nil, errors.New("the logs of the previous instance of a container are not available on podman")
+	}
+
+	cmd := exec.Command(o.podmanCmd, getLogsArgs(podName, containerName, options)...)
+	klog.V(3).Infof("executing %v", cmd.Args)
+	// the logs written by the container on its standard error are written by podman on its standard error
+	pr, pw := io.Pipe()
+	cmd.Stdout = pw
+	cmd.Stderr = pw
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	go func() {
+		_ = pw.CloseWithError(cmd.Wait())
+	}()
+
+	logs := &podmanLogs{Reader: pr, pipe: pr, cmd: cmd}
+	if options.LimitBytes != nil {
+		logs.Reader = io.LimitReader(pr, *options.LimitBytes)
+	}
+	return logs, nil
+}
+
+// getLogsArgs returns the arguments of the podman command getting the logs of the container containerName of the pod podName,
+// or of all its containers if containerName is empty
+func getLogsArgs(podName, containerName string, options platform.LogOptions) []string {
+	args := []string{"logs"}
+	if options.Follow {
+		args = append(args, "--follow")
+	}
+	if options.Since > 0 {
+		args = append(args, "--since", options.Since.String())
+	}
+	if options.TailLines != nil {
+		args = append(args, "--tail", strconv.FormatInt(*options.TailLines, 10))
+	}
+	if options.Timestamps {
+		args = append(args, "--timestamps")
+	}
+	if containerName == "" {
+		return append(append([]string{"pod"}, args...), podName)
+	}
+	return append(args, fmt.Sprintf("%s-%s", podName, containerName))
+}
+
+// podmanLogs reads the logs from the output of a podman command, killing the command when closed
+type podmanLogs struct {
+	io.Reader
+	pipe *io.PipeReader
+	cmd  *exec.Cmd
+}
+
+func (o *podmanLogs) Close() error {
+	// the error is ignored, as the command may have terminated in the meantime
+	_ = o.cmd.Process.Kill()
+	return o.pipe.Close()
 }
@@ -0,0 +1,51 @@
+package podman
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+func Test_getLogsArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		containerName string
+		options       platform.LogOptions
+		want          []string
+	}{
+		{
+			name:          "container without options",
+			containerName: "runtime",
+			want:          []string{"logs", "my-app-app-runtime"},
+		},
+		{
+			name:          "container with all options",
+			containerName: "runtime",
+			options: platform.LogOptions{
+				Follow:     true,
+				Since:      90 * time.Second,
+				TailLines:  pointer.Int64Ptr(0),
+				Timestamps: true,
+				LimitBytes: pointer.Int64Ptr(1024),
+			},
+			want: []string{"logs", "--follow", "--since", "1m30s", "--tail", "0", "--timestamps", "my-app-app-runtime"},
+		},
+		{
+			name:    "all the containers of the pod",
+			options: platform.LogOptions{TailLines: pointer.Int64Ptr(10)},
+			want:    []string{"pod", "logs", "--tail", "10", "my-app-app"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getLogsArgs("my-app-app", tt.containerName, tt.options)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getLogsArgs() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
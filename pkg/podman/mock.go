@@ -5,13 +5,16 @@
 package podman
 
 import (
+	context "context"
 	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
 	api "github.com/redhat-developer/odo/pkg/api"
+	platform "github.com/redhat-developer/odo/pkg/platform"
 	v1 "k8s.io/api/core/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	watch "k8s.io/apimachinery/pkg/watch"
 )
 
 // MockClient is a mock of Client interface.
@@ -96,18 +99,18 @@ func (mr *MockClientMockRecorder) GetAllResourcesFromSelector(selector, ns inter
 }
 
 // GetPodLogs mocks base method.
-func (m *MockClient) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
+func (m *MockClient) GetPodLogs(podName, containerName string, options platform.LogOptions) (io.ReadCloser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPodLogs", podName, containerName, followLog)
+	ret := m.ctrl.Call(m, "GetPodLogs", podName, containerName, options)
 	ret0, _ := ret[0].(io.ReadCloser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPodLogs indicates an expected call of GetPodLogs.
-func (mr *MockClientMockRecorder) GetPodLogs(podName, containerName, followLog interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) GetPodLogs(podName, containerName, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodLogs", reflect.TypeOf((*MockClient)(nil).GetPodLogs), podName, containerName, followLog)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodLogs", reflect.TypeOf((*MockClient)(nil).GetPodLogs), podName, containerName, options)
 }
 
 // GetPodsMatchingSelector mocks base method.
@@ -140,6 +143,21 @@ func (mr *MockClientMockRecorder) GetRunningPodFromSelector(selector interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunningPodFromSelector", reflect.TypeOf((*MockClient)(nil).GetRunningPodFromSelector), selector)
 }
 
+// HostLoopbackNetwork mocks base method.
+func (m *MockClient) HostLoopbackNetwork() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HostLoopbackNetwork")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HostLoopbackNetwork indicates an expected call of HostLoopbackNetwork.
+func (mr *MockClientMockRecorder) HostLoopbackNetwork() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostLoopbackNetwork", reflect.TypeOf((*MockClient)(nil).HostLoopbackNetwork))
+}
+
 // KubeGenerate mocks base method.
 func (m *MockClient) KubeGenerate(name string) (*v1.Pod, error) {
 	m.ctrl.T.Helper()
@@ -171,17 +189,17 @@ func (mr *MockClientMockRecorder) ListAllComponents() *gomock.Call {
 }
 
 // PlayKube mocks base method.
-func (m *MockClient) PlayKube(pod *v1.Pod) error {
+func (m *MockClient) PlayKube(pod *v1.Pod, network string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PlayKube", pod)
+	ret := m.ctrl.Call(m, "PlayKube", pod, network)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PlayKube indicates an expected call of PlayKube.
-func (mr *MockClientMockRecorder) PlayKube(pod interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) PlayKube(pod, network interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayKube", reflect.TypeOf((*MockClient)(nil).PlayKube), pod)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayKube", reflect.TypeOf((*MockClient)(nil).PlayKube), pod, network)
 }
 
 // PodLs mocks base method.
@@ -227,6 +245,21 @@ func (mr *MockClientMockRecorder) PodStop(podname interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodStop", reflect.TypeOf((*MockClient)(nil).PodStop), podname)
 }
 
+// PodsWatcher mocks base method.
+func (m *MockClient) PodsWatcher(ctx context.Context) (watch.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PodsWatcher", ctx)
+	ret0, _ := ret[0].(watch.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PodsWatcher indicates an expected call of PodsWatcher.
+func (mr *MockClientMockRecorder) PodsWatcher(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodsWatcher", reflect.TypeOf((*MockClient)(nil).PodsWatcher), ctx)
+}
+
 // VolumeLs mocks base method.
 func (m *MockClient) VolumeLs() (map[string]bool, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,57 @@
+package podman
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+
+	"k8s.io/klog"
+)
+
+// slirp4netnsHostLoopbackNetwork is the network mode of the pods allowing their containers
+// to reach the loopback address of the host through host.containers.internal
+const slirp4netnsHostLoopbackNetwork = "slirp4netns:allow_host_loopback=true"
+
+type hostInfo struct {
+	// RootlessNetworkCmd is the network command used for the rootless containers (slirp4netns or pasta),
+	// not reported by the versions of podman before 5.0, which use slirp4netns
+	RootlessNetworkCmd string `json:"rootlessNetworkCmd"`
+	Security           struct {
+		Rootless bool `json:"rootless"`
+	} `json:"security"`
+}
+
+type systemInfoReport struct {
+	Host hostInfo `json:"host"`
+}
+
+func (o *PodmanCli) HostLoopbackNetwork() (string, error) {
+	cmd := exec.Command(o.podmanCmd, "info", "--format", "json")
+	klog.V(3).Infof("executing %v", cmd.Args)
+	out, err := cmd.Output()
+	if err != nil {
+		if exiterr, ok := err.(*exec.ExitError); ok {
+			err = fmt.Errorf("%s: %s", err, string(exiterr.Stderr))
+		}
+		return "", err
+	}
+	var info systemInfoReport
+	err = json.Unmarshal(out, &info)
+	if err != nil {
+		return "", err
+	}
+	return getHostLoopbackNetwork(info.Host), nil
+}
+
+// getHostLoopbackNetwork returns the network mode allowing the containers to reach the loopback address of the host,
+// which is only supported by rootless podman using slirp4netns
+func getHostLoopbackNetwork(host hostInfo) string {
+	if !host.Security.Rootless {
+		return ""
+	}
+	switch host.RootlessNetworkCmd {
+	case "", "slirp4netns":
+		return slirp4netnsHostLoopbackNetwork
+	}
+	return ""
+}
@@ -0,0 +1,46 @@
+package podman
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func Test_getHostLoopbackNetwork(t *testing.T) {
+	tests := []struct {
+		name string
+		info string
+		want string
+	}{
+		{
+			name: "rootless podman with slirp4netns",
+			info: `{"host": {"rootlessNetworkCmd": "slirp4netns", "security": {"rootless": true}}}`,
+			want: "slirp4netns:allow_host_loopback=true",
+		},
+		{
+			name: "rootless podman before 5.0",
+			info: `{"host": {"security": {"rootless": true}}}`,
+			want: "slirp4netns:allow_host_loopback=true",
+		},
+		{
+			name: "rootless podman with pasta",
+			info: `{"host": {"rootlessNetworkCmd": "pasta", "security": {"rootless": true}}}`,
+			want: "",
+		},
+		{
+			name: "rootful podman",
+			info: `{"host": {"security": {"rootless": false}}}`,
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var info systemInfoReport
+			if err := json.Unmarshal([]byte(tt.info), &info); err != nil {
+				t.Fatal(err)
+			}
+			if got := getHostLoopbackNetwork(info.Host); got != tt.want {
+				t.Errorf("getHostLoopbackNetwork() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
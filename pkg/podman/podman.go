@@ -36,7 +36,7 @@ func NewPodmanCli(ctx context.Context) (*PodmanCli, error) {
 	return cli, nil
 }
 
-func (o *PodmanCli) PlayKube(pod *corev1.Pod) error {
+func (o *PodmanCli) PlayKube(pod *corev1.Pod, network string) error {
 	serializer := jsonserializer.NewSerializerWithOptions(
 		jsonserializer.SimpleMetaFactory{},
 		scheme.Scheme,
@@ -46,7 +46,12 @@ func (o *PodmanCli) PlayKube(pod *corev1.Pod) error {
 		},
 	)
 
-	cmd := exec.Command(o.podmanCmd, "play", "kube", "-")
+	args := []string{"play", "kube"}
+	if network != "" {
+		args = append(args, "--network", network)
+	}
+	args = append(args, "-")
+	cmd := exec.Command(o.podmanCmd, args...)
 	klog.V(3).Infof("executing %v", cmd.Args)
 	stdin, err := cmd.StdinPipe()
 	if err != nil {
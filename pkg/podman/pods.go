@@ -1,14 +1,55 @@
 package podman
 
 import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog"
 )
 
 // GetPodsMatchingSelector returns all pods matching the given label selector.
+// The pods are returned as generated by podman, without their status.
 func (o *PodmanCli) GetPodsMatchingSelector(selector string) (*corev1.PodList, error) {
-	// TODO(feloy) when pod is created with labels
-	return nil, nil
+	labelSelector, err := labels.Parse(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(o.podmanCmd, "pod", "ps", "--format", "json")
+	klog.V(3).Infof("executing %v", cmd.Args)
+	out, err := cmd.Output()
+	if err != nil {
+		if exiterr, ok := err.(*exec.ExitError); ok {
+			err = fmt.Errorf("%s: %s", err, string(exiterr.Stderr))
+		}
+		return nil, err
+	}
+	var list []ListPodsReport
+	if err = json.Unmarshal(out, &list); err != nil {
+		return nil, err
+	}
+
+	result := &corev1.PodList{}
+	for _, report := range list {
+		if !labelSelector.Matches(labels.Set(report.Labels)) {
+			continue
+		}
+		pod, err := o.KubeGenerate(report.Name)
+		if err != nil {
+			return nil, err
+		}
+		// the names of the containers are prefixed with the name of the pod by podman, depending on its version
+		for i := range pod.Spec.Containers {
+			pod.Spec.Containers[i].Name = strings.TrimPrefix(pod.Spec.Containers[i].Name, report.Name+"-")
+		}
+		result.Items = append(result.Items, *pod)
+	}
+	return result, nil
 }
 
 // GetAllResourcesFromSelector returns all resources of any kind matching the given label selector.
@@ -18,9 +59,9 @@ func (o *PodmanCli) GetAllResourcesFromSelector(selector string, ns string) ([]u
 }
 
 // GetAllPodsInNamespaceMatchingSelector returns all pods matching the given label selector and in the specified namespace.
+// Podman has no namespaces, all the pods matching the label selector are returned.
 func (o *PodmanCli) GetAllPodsInNamespaceMatchingSelector(selector string, ns string) (*corev1.PodList, error) {
-	// TODO(feloy) when pod is created with labels
-	return nil, nil
+	return o.GetPodsMatchingSelector(selector)
 }
 
 // GetRunningPodFromSelector returns any pod matching the given label selector.
@@ -45,6 +45,9 @@ type odoSettings struct {
 
 	// ConsentTelemetry if true collects telemetry for odo
 	ConsentTelemetry *bool `yaml:"ConsentTelemetry,omitempty"`
+
+	// ImageLoad if true loads the images built by odo into the local cluster instead of pushing them
+	ImageLoad *bool `yaml:"ImageLoad,omitempty"`
 }
 
 // Registry includes the registry metadata
@@ -305,6 +308,13 @@ func (c *preferenceInfo) SetConfiguration(parameter string, value string) error
 				return fmt.Errorf("unable to set %q to %q, value must be a boolean", parameter, value)
 			}
 			c.OdoSettings.ConsentTelemetry = &val
+
+		case "imageload":
+			val, err := strconv.ParseBool(strings.ToLower(value))
+			if err != nil {
+				return fmt.Errorf("unable to set %q to %q, value must be a boolean", parameter, value)
+			}
+			c.OdoSettings.ImageLoad = &val
 		}
 	} else {
 		return fmt.Errorf("unknown parameter : %q is not a parameter in odo preference, run `odo preference -h` to see list of available parameters", parameter)
@@ -392,6 +402,13 @@ func (c *preferenceInfo) GetConsentTelemetry() bool {
 	return kpointer.BoolDeref(c.OdoSettings.ConsentTelemetry, DefaultConsentTelemetrySetting)
 }
 
+// GetImageLoad returns the value of ImageLoad from preferences
+// and if absent then returns default
+// default value: false, images are pushed to their registries by default
+func (c *preferenceInfo) GetImageLoad() bool {
+	return kpointer.BoolDeref(c.OdoSettings.ImageLoad, DefaultImageLoadSetting)
+}
+
 // GetEphemeral returns the value of Ephemeral from preferences
 // and if absent then returns default
 // default value: true, ephemeral is enabled by default
@@ -427,6 +444,10 @@ func (c *preferenceInfo) ConsentTelemetry() *bool {
 	return c.OdoSettings.ConsentTelemetry
 }
 
+func (c *preferenceInfo) ImageLoad() *bool {
+	return c.OdoSettings.ImageLoad
+}
+
 // RegistryList returns the list of registries,
 // in reverse order compared to what is declared in the preferences file.
 //
@@ -67,6 +67,13 @@ func toPreferenceItems(prefInfo preferenceInfo) []PreferenceItem {
 			Type:        getType(prefInfo.GetEphemeral()),
 			Description: EphemeralSettingDescription,
 		},
+		{
+			Name:        ImageLoadSetting,
+			Value:       settings.ImageLoad,
+			Default:     DefaultImageLoadSetting,
+			Type:        getType(prefInfo.GetImageLoad()),
+			Description: ImageLoadSettingDescription,
+		},
 	}
 }
 
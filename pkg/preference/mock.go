@@ -90,6 +90,20 @@ func (mr *MockClientMockRecorder) GetConsentTelemetry() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsentTelemetry", reflect.TypeOf((*MockClient)(nil).GetConsentTelemetry))
 }
 
+// GetImageLoad mocks base method.
+func (m *MockClient) GetImageLoad() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImageLoad")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// GetImageLoad indicates an expected call of GetImageLoad.
+func (mr *MockClientMockRecorder) GetImageLoad() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageLoad", reflect.TypeOf((*MockClient)(nil).GetImageLoad))
+}
+
 // GetEphemeralSourceVolume mocks base method.
 func (m *MockClient) GetEphemeralSourceVolume() bool {
 	m.ctrl.T.Helper()
@@ -160,6 +174,20 @@ func (mr *MockClientMockRecorder) GetUpdateNotification() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateNotification", reflect.TypeOf((*MockClient)(nil).GetUpdateNotification))
 }
 
+// ImageLoad mocks base method.
+func (m *MockClient) ImageLoad() *bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageLoad")
+	ret0, _ := ret[0].(*bool)
+	return ret0
+}
+
+// ImageLoad indicates an expected call of ImageLoad.
+func (mr *MockClientMockRecorder) ImageLoad() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageLoad", reflect.TypeOf((*MockClient)(nil).ImageLoad))
+}
+
 // IsSet mocks base method.
 func (m *MockClient) IsSet(parameter string) bool {
 	m.ctrl.T.Helper()
@@ -14,6 +14,7 @@ type Client interface {
 	GetPushTimeout() time.Duration
 	GetEphemeralSourceVolume() bool
 	GetConsentTelemetry() bool
+	GetImageLoad() bool
 	GetRegistryCacheTime() time.Duration
 	RegistryHandler(operation string, registryName string, registryURL string, forceFlag bool, isSecure bool) error
 
@@ -23,6 +24,7 @@ type Client interface {
 	RegistryCacheTime() *time.Duration
 	EphemeralSourceVolume() *bool
 	ConsentTelemetry() *bool
+	ImageLoad() *bool
 	RegistryList() []Registry
 	RegistryNameExists(name string) bool
 
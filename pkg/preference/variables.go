@@ -61,6 +61,12 @@ const (
 
 	// DefaultConsentTelemetry is a default value for ConsentTelemetry preference
 	DefaultConsentTelemetrySetting = false
+
+	// ImageLoadSetting specifies if the images built by odo are loaded into the local cluster instead of being pushed
+	ImageLoadSetting = "ImageLoad"
+
+	// DefaultImageLoadSetting is a default value for ImageLoad preference
+	DefaultImageLoadSetting = false
 )
 
 // TimeoutSettingDescription is human-readable description for the timeout setting
@@ -78,6 +84,9 @@ var EphemeralSettingDescription = fmt.Sprintf("If true, odo will create an empty
 // ConsentTelemetrySettingDescription adds a description for TelemetryConsentSetting
 var ConsentTelemetrySettingDescription = fmt.Sprintf("If true, odo will collect telemetry for the user's odo usage (Default: %t)\n\t\t    For more information: https://developers.redhat.com/article/tool-data-collection", DefaultConsentTelemetrySetting)
 
+// ImageLoadSettingDescription adds a description for ImageLoadSetting
+var ImageLoadSettingDescription = fmt.Sprintf("If true, odo will load the images it builds into the local cluster (kind, minikube or CRC) of the current context, instead of pushing them to a registry (Default: %t)", DefaultImageLoadSetting)
+
 // This value can be provided to set a seperate directory for users 'homedir' resolution
 // note for mocking purpose ONLY
 var customHomeDir = os.Getenv("CUSTOM_HOMEDIR")
@@ -91,6 +100,7 @@ var (
 		RegistryCacheTimeSetting:  RegistryCacheTimeSettingDescription,
 		EphemeralSetting:          EphemeralSettingDescription,
 		ConsentTelemetrySetting:   ConsentTelemetrySettingDescription,
+		ImageLoadSetting:          ImageLoadSettingDescription,
 	}
 
 	// set-like map to quickly check if a parameter is supported
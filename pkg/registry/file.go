@@ -0,0 +1,199 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/blang/semver"
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	indexSchema "github.com/devfile/registry-support/index/generator/schema"
+	"github.com/devfile/registry-support/registry-library/library"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// A filesystem-backed registry is a directory, created by `odo registry mirror`, containing:
+//   - index.json: the index of the stacks, with all their versions
+//   - stacks/<stack>/<version>/: the resources of each version of each stack
+//   - starter-projects/index.json: the archives of the starter projects, indexed by the source of the starter projects
+//   - starter-projects/<stack>/<version>/<starter project>.zip: the archives of the starter projects
+const (
+	fileRegistryPrefix     = "file://"
+	fileRegistryIndex      = "index.json"
+	fileRegistryStacksDir  = "stacks"
+	fileRegistryStarterDir = "starter-projects"
+	// defaultStackVersionDir is the directory of the stacks of registries without versions
+	defaultStackVersionDir = "default"
+)
+
+// IsFileRegistry returns true if the registry URL references a filesystem-backed registry
+func IsFileRegistry(url string) bool {
+	return strings.HasPrefix(url, fileRegistryPrefix)
+}
+
+// GetFileRegistryPath returns the path of the directory of a filesystem-backed registry
+func GetFileRegistryPath(url string) string {
+	dir := strings.TrimPrefix(url, fileRegistryPrefix)
+	if runtime.GOOS == "windows" {
+		// file:///C:/path/to/registry
+		dir = filepath.FromSlash(strings.TrimPrefix(dir, "/"))
+	}
+	return dir
+}
+
+// GetFileRegistryURL returns the URL of the filesystem-backed registry in the directory dir
+func GetFileRegistryURL(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	abs = filepath.ToSlash(abs)
+	if !strings.HasPrefix(abs, "/") {
+		abs = "/" + abs
+	}
+	return fileRegistryPrefix + abs, nil
+}
+
+// ValidateFileRegistry checks that the directory of the filesystem-backed registry contains a registry index
+func ValidateFileRegistry(fsys filesystem.Filesystem, url string) error {
+	indexPath := filepath.Join(GetFileRegistryPath(url), fileRegistryIndex)
+	if _, err := fsys.Stat(indexPath); err != nil {
+		return fmt.Errorf("%s is not a valid filesystem registry, use `odo registry mirror` to create it: %w", url, err)
+	}
+	return nil
+}
+
+// getFileRegistryIndex returns the index of the stacks of the filesystem-backed registry
+func getFileRegistryIndex(fsys filesystem.Filesystem, url string) ([]indexSchema.Schema, error) {
+	content, err := fsys.ReadFile(filepath.Join(GetFileRegistryPath(url), fileRegistryIndex))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the index of registry %s: %w", url, err)
+	}
+	var index []indexSchema.Schema
+	err = json.Unmarshal(content, &index)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the index of registry %s: %w", url, err)
+	}
+	return index, nil
+}
+
+// pullStackFromFileRegistry copies the resources of the stack, in the form <stack>[:<version>], from the filesystem-backed registry
+// to the destination directory.
+// As with HTTP registries, the default version is used if no version is specified, and the `latest` version is the highest one.
+func pullStackFromFileRegistry(fsys filesystem.Filesystem, url string, stack string, destDir string) error {
+	name, requestVersion, err := library.SplitVersionFromStack(stack)
+	if err != nil {
+		return err
+	}
+	index, err := getFileRegistryIndex(fsys, url)
+	if err != nil {
+		return err
+	}
+	for _, entry := range index {
+		if entry.Name != name {
+			continue
+		}
+		version, err := resolveStackVersion(entry, requestVersion)
+		if err != nil {
+			return fmt.Errorf("%w in the registry %s", err, url)
+		}
+		return util.CopyDirWithFS(getStackDir(GetFileRegistryPath(url), name, version), destDir, fsys)
+	}
+	return fmt.Errorf("stack %s does not exist in the registry %s", name, url)
+}
+
+// resolveStackVersion returns the version of the stack matching the requested version
+// (empty for the default version, or latest for the highest version)
+func resolveStackVersion(entry indexSchema.Schema, requestVersion string) (string, error) {
+	if len(entry.Versions) == 0 {
+		if requestVersion == "" || requestVersion == "latest" || requestVersion == entry.Version {
+			return entry.Version, nil
+		}
+		return "", fmt.Errorf("the requested version %s for stack %s does not exist", requestVersion, entry.Name)
+	}
+
+	var latest *semver.Version
+	var latestVersion string
+	for _, v := range entry.Versions {
+		if (requestVersion == "" && v.Default) || v.Version == requestVersion {
+			return v.Version, nil
+		}
+		current, err := semver.Make(v.Version)
+		if err != nil {
+			continue
+		}
+		if latest == nil || current.GT(*latest) {
+			latest = &current
+			latestVersion = v.Version
+		}
+	}
+	if requestVersion == "latest" && latestVersion != "" {
+		return latestVersion, nil
+	}
+	if requestVersion == "" {
+		return "", fmt.Errorf("no version specified for stack %s which no default version exists", entry.Name)
+	}
+	return "", fmt.Errorf("the requested version %s for stack %s does not exist", requestVersion, entry.Name)
+}
+
+// getStackDir returns the directory containing the resources of the version of the stack, in the registry directory
+func getStackDir(registryDir string, stack string, version string) string {
+	if version == "" {
+		version = defaultStackVersionDir
+	}
+	return filepath.Join(registryDir, fileRegistryStacksDir, stack, version)
+}
+
+// getStarterProjectKey returns the key identifying the source of the starter project in the index of the starter projects
+func getStarterProjectKey(starterProject *devfilev1.StarterProject) (string, error) {
+	switch {
+	case starterProject.Git != nil:
+		_, remoteURL, revision, err := parsercommon.GetDefaultSource(starterProject.Git.GitLikeProjectSource)
+		if err != nil {
+			return "", err
+		}
+		return "git:" + remoteURL + "#" + revision, nil
+	case starterProject.Zip != nil:
+		return "zip:" + starterProject.Zip.Location, nil
+	}
+	return "", fmt.Errorf("the source of starter project %s is not supported", starterProject.Name)
+}
+
+// getStarterProjectsIndex returns the index of the starter projects archives of the registry directory,
+// mapping the keys of the starter projects with the paths of the archives, relative to the registry directory
+func getStarterProjectsIndex(fsys filesystem.Filesystem, registryDir string) (map[string]string, error) {
+	content, err := fsys.ReadFile(filepath.Join(registryDir, fileRegistryStarterDir, fileRegistryIndex))
+	if err != nil {
+		return nil, err
+	}
+	var index map[string]string
+	err = json.Unmarshal(content, &index)
+	return index, err
+}
+
+// findStarterProjectArchive returns the path of the archive of the starter project, mirrored in one of the filesystem-backed registries
+func findStarterProjectArchive(fsys filesystem.Filesystem, registryURLs []string, starterProject *devfilev1.StarterProject) (string, bool) {
+	key, err := getStarterProjectKey(starterProject)
+	if err != nil {
+		return "", false
+	}
+	for _, url := range registryURLs {
+		if !IsFileRegistry(url) {
+			continue
+		}
+		dir := GetFileRegistryPath(url)
+		index, err := getStarterProjectsIndex(fsys, dir)
+		if err != nil {
+			continue
+		}
+		if archive, found := index[key]; found {
+			return filepath.Join(dir, filepath.FromSlash(archive)), true
+		}
+	}
+	return "", false
+}
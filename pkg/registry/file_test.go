@@ -0,0 +1,145 @@
+package registry
+
+import (
+	"path/filepath"
+	"testing"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+const fileRegistryIndexContent = `[
+  {
+    "name": "go",
+    "versions": [
+      {"version": "1.0.2", "default": true},
+      {"version": "2.0.0"}
+    ]
+  },
+  {
+    "name": "nodejs",
+    "version": "1.0.0"
+  }
+]`
+
+func newFileRegistry(t *testing.T) (filesystem.Filesystem, string) {
+	dir, err := filepath.Abs(filepath.Join("path", "to", "mirror"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs := filesystem.NewFakeFs()
+	files := map[string]string{
+		"index.json":                        fileRegistryIndexContent,
+		"stacks/go/1.0.2/devfile.yaml":      "go 1.0.2",
+		"stacks/go/2.0.0/devfile.yaml":      "go 2.0.0",
+		"stacks/nodejs/1.0.0/devfile.yaml":  "nodejs 1.0.0",
+		"starter-projects/index.json":       `{"git:https://github.com/devfile-samples/go.git#": "starter-projects/go/1.0.2/go-starter.zip"}`,
+		"starter-projects/go/1.0.2/go.zip":  "archive",
+		"stacks/nodejs/1.0.0/resource.yaml": "resource",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err = fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err = fs.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	url, err := GetFileRegistryURL(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fs, url
+}
+
+func TestPullStackFromFileRegistry(t *testing.T) {
+	tests := []struct {
+		name        string
+		stack       string
+		wantDevfile string
+		wantErr     bool
+	}{
+		{
+			name:        "default version",
+			stack:       "go",
+			wantDevfile: "go 1.0.2",
+		},
+		{
+			name:        "specific version",
+			stack:       "go:2.0.0",
+			wantDevfile: "go 2.0.0",
+		},
+		{
+			name:        "latest version",
+			stack:       "go:latest",
+			wantDevfile: "go 2.0.0",
+		},
+		{
+			name:        "registry without versions",
+			stack:       "nodejs",
+			wantDevfile: "nodejs 1.0.0",
+		},
+		{
+			name:    "version not found",
+			stack:   "go:3.0.0",
+			wantErr: true,
+		},
+		{
+			name:    "stack not found",
+			stack:   "java",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, url := newFileRegistry(t)
+			destDir := filepath.Join(t.TempDir(), "dest")
+			err := pullStackFromFileRegistry(fs, url, tt.stack, destDir)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("pullStackFromFileRegistry() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			got, err := fs.ReadFile(filepath.Join(destDir, "devfile.yaml"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.wantDevfile {
+				t.Errorf("pulled devfile = %q, want %q", string(got), tt.wantDevfile)
+			}
+		})
+	}
+}
+
+func TestFindStarterProjectArchive(t *testing.T) {
+	fs, url := newFileRegistry(t)
+	gitStarter := func(remote string) *devfilev1.StarterProject {
+		return &devfilev1.StarterProject{
+			Name: "go-starter",
+			ProjectSource: devfilev1.ProjectSource{
+				Git: &devfilev1.GitProjectSource{
+					GitLikeProjectSource: devfilev1.GitLikeProjectSource{
+						Remotes: map[string]string{"origin": remote},
+					},
+				},
+			},
+		}
+	}
+
+	got, found := findStarterProjectArchive(fs, []string{"https://registry.devfile.io", url}, gitStarter("https://github.com/devfile-samples/go.git"))
+	if !found {
+		t.Fatal("mirrored starter project not found")
+	}
+	want := filepath.Join(GetFileRegistryPath(url), "starter-projects", "go", "1.0.2", "go-starter.zip")
+	if got != want {
+		t.Errorf("findStarterProjectArchive() = %q, want %q", got, want)
+	}
+
+	_, found = findStarterProjectArchive(fs, []string{url}, gitStarter("https://github.com/devfile-samples/other.git"))
+	if found {
+		t.Error("starter project not mirrored should not be found")
+	}
+}
@@ -0,0 +1,132 @@
+package registry
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+// Filter selects and ranks the devfile stacks listed from the registries
+type Filter struct {
+	// Text must be part of the name or the description of the stack
+	Text string
+	// Tags must all be tags of the stack, case-insensitively
+	Tags []string
+	// Language is the language of the stack, case-insensitively
+	Language string
+	// ProjectType is the project type of the stack, case-insensitively
+	ProjectType string
+	// SchemaVersion must be the devfile schema version of at least one version of the stack.
+	// A version without patch number, like 2.2, matches all its patch versions.
+	SchemaVersion string
+	// Provider is the provider of the stack, case-insensitively
+	Provider string
+
+	// PreferredLanguages are languages whose stacks are listed first, like the languages detected in the current directory.
+	// They do not exclude the stacks of other languages.
+	PreferredLanguages []string
+}
+
+// Matches returns true if the stack satisfies all the criteria of the filter
+func (o Filter) Matches(stack api.DevfileStack) bool {
+	if o.Text != "" && !strings.Contains(stack.Name, o.Text) && !strings.Contains(stack.Description, o.Text) {
+		return false
+	}
+	for _, tag := range o.Tags {
+		if !containsFold(stack.Tags, tag) {
+			return false
+		}
+	}
+	if o.Language != "" && !strings.EqualFold(stack.Language, o.Language) {
+		return false
+	}
+	if o.ProjectType != "" && !strings.EqualFold(stack.ProjectType, o.ProjectType) {
+		return false
+	}
+	if o.Provider != "" && !strings.EqualFold(stack.Provider, o.Provider) {
+		return false
+	}
+	if o.SchemaVersion != "" && !hasSchemaVersion(stack, o.SchemaVersion) {
+		return false
+	}
+	return true
+}
+
+// rank returns the relevance of the stack for the filter, higher being more relevant:
+// an exact match of the name with the text is the most relevant, then a name starting with the text,
+// then a stack of a preferred language
+func (o Filter) rank(stack api.DevfileStack) int {
+	var rank int
+	switch {
+	case o.Text == "":
+	case stack.Name == o.Text:
+		rank += 4
+	case strings.HasPrefix(stack.Name, o.Text):
+		rank += 2
+	}
+	if containsFold(o.PreferredLanguages, stack.Language) {
+		rank++
+	}
+	return rank
+}
+
+// Sort sorts the stacks by relevance for the filter, then by name,
+// then by priority of the registry (highest priority has highest index)
+func (o Filter) Sort(stacks []api.DevfileStack) {
+	sort.SliceStable(stacks, func(i, j int) bool {
+		if ri, rj := o.rank(stacks[i]), o.rank(stacks[j]); ri != rj {
+			return ri > rj
+		}
+		if stacks[i].Name == stacks[j].Name {
+			return stacks[i].Registry.Priority < stacks[j].Registry.Priority
+		}
+		return stacks[i].Name < stacks[j].Name
+	})
+}
+
+// needsDevfileData returns true if the Devfile of the stack is needed to check the schema version,
+// as the registry index does not provide the schema versions of the stack
+func (o Filter) needsDevfileData(stack api.DevfileStack) bool {
+	return o.SchemaVersion != "" && !hasIndexedSchemaVersions(stack)
+}
+
+// hasIndexedSchemaVersions returns true if the registry index provides the schema versions of the versions of the stack
+func hasIndexedSchemaVersions(stack api.DevfileStack) bool {
+	for _, version := range stack.Versions {
+		if version.SchemaVersion != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// hasSchemaVersion returns true if one of the versions of the stack uses the schema version.
+// When the registry index does not provide the versions of the stack, the schema version of the Devfile of the stack is used,
+// if its details have been fetched; otherwise the stack does not match.
+func hasSchemaVersion(stack api.DevfileStack, schemaVersion string) bool {
+	matches := func(version string) bool {
+		return version == schemaVersion || strings.HasPrefix(version, schemaVersion+".")
+	}
+	if !hasIndexedSchemaVersions(stack) {
+		return stack.DevfileData != nil && stack.DevfileData.Devfile != nil && matches(stack.DevfileData.Devfile.GetSchemaVersion())
+	}
+	for _, version := range stack.Versions {
+		if version.SchemaVersion != "" && matches(version.SchemaVersion) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
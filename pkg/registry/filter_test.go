@@ -0,0 +1,194 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/devfile/library/pkg/devfile/parser/data"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+func TestFilter_Matches(t *testing.T) {
+	quarkus := api.DevfileStack{
+		Name:        "java-quarkus",
+		Description: "Quarkus with Java",
+		Language:    "Java",
+		ProjectType: "Quarkus",
+		Provider:    "Red Hat",
+		Tags:        []string{"Java", "Quarkus", "Maven"},
+		Versions: []api.DevfileStackVersion{
+			{Version: "1.1.0", SchemaVersion: "2.0.0"},
+			{Version: "1.3.0", SchemaVersion: "2.2.0", IsDefault: true},
+		},
+	}
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{
+			name:   "empty filter",
+			filter: Filter{},
+			want:   true,
+		},
+		{
+			name:   "text in the name",
+			filter: Filter{Text: "quarkus"},
+			want:   true,
+		},
+		{
+			name:   "text in the description",
+			filter: Filter{Text: "with Java"},
+			want:   true,
+		},
+		{
+			name:   "text not found",
+			filter: Filter{Text: "spring"},
+			want:   false,
+		},
+		{
+			name:   "all tags, case-insensitively",
+			filter: Filter{Tags: []string{"java", "QUARKUS"}},
+			want:   true,
+		},
+		{
+			name:   "one missing tag",
+			filter: Filter{Tags: []string{"Java", "Gradle"}},
+			want:   false,
+		},
+		{
+			name:   "language, project type and provider",
+			filter: Filter{Language: "java", ProjectType: "quarkus", Provider: "red hat"},
+			want:   true,
+		},
+		{
+			name:   "other language",
+			filter: Filter{Language: "Go"},
+			want:   false,
+		},
+		{
+			name:   "other provider",
+			filter: Filter{Provider: "Someone"},
+			want:   false,
+		},
+		{
+			name:   "schema version of a non default version",
+			filter: Filter{SchemaVersion: "2.0.0"},
+			want:   true,
+		},
+		{
+			name:   "schema version without patch number",
+			filter: Filter{SchemaVersion: "2.2"},
+			want:   true,
+		},
+		{
+			name:   "schema version not a prefix of a whole number",
+			filter: Filter{SchemaVersion: "2.2.0.1"},
+			want:   false,
+		},
+		{
+			name:   "unknown schema version",
+			filter: Filter{SchemaVersion: "2.1"},
+			want:   false,
+		},
+		{
+			name:   "preferred languages do not exclude stacks",
+			filter: Filter{PreferredLanguages: []string{"Go"}},
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(quarkus); got != tt.want {
+				t.Errorf("Filter.Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHasSchemaVersion_WithoutVersions(t *testing.T) {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion220))
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileData.SetSchemaVersion("2.2.0")
+
+	tests := []struct {
+		name  string
+		stack api.DevfileStack
+		want  bool
+	}{
+		{
+			name:  "schema version of the Devfile of the stack",
+			stack: api.DevfileStack{Name: "go", DevfileData: &api.DevfileData{Devfile: devfileData}},
+			want:  true,
+		},
+		{
+			name:  "no schema version known",
+			stack: api.DevfileStack{Name: "go"},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasSchemaVersion(tt.stack, "2.2"); got != tt.want {
+				t.Errorf("hasSchemaVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter_Sort(t *testing.T) {
+	stacks := func() []api.DevfileStack {
+		return []api.DevfileStack{
+			{Name: "go", Language: "Go", Registry: api.Registry{Name: "reg1", Priority: 1}},
+			{Name: "java-springboot", Language: "Java"},
+			{Name: "go", Language: "Go", Registry: api.Registry{Name: "reg0", Priority: 0}},
+			{Name: "nodejs", Language: "JavaScript", Description: "Node.js with Java...Script"},
+			{Name: "java", Language: "Java"},
+		}
+	}
+	names := func(stacks []api.DevfileStack) []string {
+		var result []string
+		for _, stack := range stacks {
+			result = append(result, stack.Name+"/"+stack.Registry.Name)
+		}
+		return result
+	}
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []string
+	}{
+		{
+			name:   "by name and registry priority without criteria",
+			filter: Filter{},
+			want:   []string{"go/reg0", "go/reg1", "java/", "java-springboot/", "nodejs/"},
+		},
+		{
+			name:   "exact name match, then names starting with the text",
+			filter: Filter{Text: "java"},
+			want:   []string{"java/", "java-springboot/", "go/reg0", "go/reg1", "nodejs/"},
+		},
+		{
+			name:   "stacks of the preferred languages first",
+			filter: Filter{PreferredLanguages: []string{"javascript", "js"}},
+			want:   []string{"nodejs/", "go/reg0", "go/reg1", "java/", "java-springboot/"},
+		},
+		{
+			name:   "name match before preferred language",
+			filter: Filter{Text: "java", PreferredLanguages: []string{"JavaScript"}},
+			want:   []string{"java/", "java-springboot/", "nodejs/", "go/reg0", "go/reg1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := stacks()
+			tt.filter.Sort(got)
+			if diff := cmp.Diff(tt.want, names(got)); diff != "" {
+				t.Errorf("Filter.Sort() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package registry
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	dfutil "github.com/devfile/library/pkg/util"
+	indexSchema "github.com/devfile/registry-support/index/generator/schema"
+	"github.com/devfile/registry-support/registry-library/library"
+	"k8s.io/klog"
+	"sigs.k8s.io/yaml"
+
+	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/segment"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// maxParentDepth is the maximum number of levels of parent Devfiles mirrored
+const maxParentDepth = 5
+
+// MirrorRegistry snapshots all the versions of the stacks of the registry, with their resources, parent Devfiles and starter projects,
+// into the destination directory, which can then be used as a filesystem-backed registry
+func (o RegistryClient) MirrorRegistry(ctx context.Context, registryName string, destDir string) error {
+	registries, err := o.GetDevfileRegistries(registryName)
+	if err != nil {
+		return err
+	}
+	if len(registries) == 0 {
+		return fmt.Errorf("the registry %q is not in preferences", registryName)
+	}
+	registry := registries[0]
+	if IsFileRegistry(registry.URL) {
+		return fmt.Errorf("the registry %q is already a filesystem registry", registryName)
+	}
+
+	options := segment.GetRegistryOptions(ctx)
+	options.NewIndexSchema = true
+	index, err := library.GetRegistryIndex(registry.URL, options, indexSchema.StackDevfileType)
+	if err != nil {
+		return fmt.Errorf("unable to get the index of registry %q: %w", registryName, err)
+	}
+
+	starterProjects := map[string]string{}
+	for _, entry := range index {
+		versions := []string{entry.Version}
+		if len(entry.Versions) > 0 {
+			versions = versions[:0]
+			for _, v := range entry.Versions {
+				versions = append(versions, v.Version)
+			}
+		}
+		for _, version := range versions {
+			err = o.mirrorStackVersion(registry.URL, entry.Name, version, destDir, options, starterProjects)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = o.writeJSON(filepath.Join(destDir, fileRegistryStarterDir, fileRegistryIndex), starterProjects)
+	if err != nil {
+		return err
+	}
+	return o.writeJSON(filepath.Join(destDir, fileRegistryIndex), index)
+}
+
+// mirrorStackVersion pulls the resources of the version of the stack, with its parent Devfiles and starter projects.
+// The starter projects archives are added to the starterProjects index.
+func (o RegistryClient) mirrorStackVersion(registryURL string, stack string, version string, destDir string, options library.RegistryOptions, starterProjects map[string]string) error {
+	stackWithVersion := stack
+	if version != "" {
+		stackWithVersion = stack + ":" + version
+	}
+	spinner := log.Spinnerf("Mirroring stack %s", stackWithVersion)
+	defer spinner.End(false)
+
+	stackDir := getStackDir(destDir, stack, version)
+	err := o.fsys.MkdirAll(stackDir, 0755)
+	if err != nil {
+		return err
+	}
+	err = library.PullStackFromRegistry(registryURL, stackWithVersion, stackDir, options)
+	if err != nil {
+		return fmt.Errorf("unable to pull stack %s: %w", stackWithVersion, err)
+	}
+
+	devfileName := location.DevfileFilenamesProvider(stackDir)
+	err = o.mirrorParent(registryURL, stackDir, devfileName, options, 1)
+	if err != nil {
+		return fmt.Errorf("unable to mirror the parent of stack %s: %w", stackWithVersion, err)
+	}
+
+	content, err := o.fsys.ReadFile(filepath.Join(stackDir, devfileName))
+	if err != nil {
+		return err
+	}
+	var devfileContent struct {
+		StarterProjects []devfilev1.StarterProject `json:"starterProjects"`
+	}
+	err = yaml.Unmarshal(content, &devfileContent)
+	if err != nil {
+		return err
+	}
+	for i := range devfileContent.StarterProjects {
+		starterProject := &devfileContent.StarterProjects[i]
+		key, err := getStarterProjectKey(starterProject)
+		if err != nil {
+			log.Warningf("Starter project %s of stack %s is not mirrored: %v", starterProject.Name, stackWithVersion, err)
+			continue
+		}
+		if _, found := starterProjects[key]; found {
+			continue
+		}
+		if version == "" {
+			version = defaultStackVersionDir
+		}
+		archive := filepath.ToSlash(filepath.Join(fileRegistryStarterDir, stack, version, starterProject.Name+".zip"))
+		err = o.archiveStarterProject(starterProject, filepath.Join(destDir, filepath.FromSlash(archive)))
+		if err != nil {
+			log.Warningf("Starter project %s of stack %s is not mirrored: %v", starterProject.Name, stackWithVersion, err)
+			continue
+		}
+		starterProjects[key] = archive
+	}
+
+	spinner.End(true)
+	return nil
+}
+
+// mirrorParent downloads the parent Devfile of the Devfile, if it is referenced by a URL or a registry ID,
+// next to the Devfile, and references it from the Devfile by its relative path
+func (o RegistryClient) mirrorParent(registryURL string, dir string, devfileName string, options library.RegistryOptions, depth int) error {
+	if depth > maxParentDepth {
+		return fmt.Errorf("more than %d levels of parent Devfiles", maxParentDepth)
+	}
+	devfilePath := filepath.Join(dir, devfileName)
+	content, err := o.fsys.ReadFile(devfilePath)
+	if err != nil {
+		return err
+	}
+	var devfileContent map[string]interface{}
+	err = yaml.Unmarshal(content, &devfileContent)
+	if err != nil {
+		return err
+	}
+	parent, ok := devfileContent["parent"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var parentContent []byte
+	uri, _ := parent["uri"].(string)
+	id, _ := parent["id"].(string)
+	switch {
+	case strings.HasPrefix(uri, "http://") || strings.HasPrefix(uri, "https://"):
+		parentContent, err = util.DownloadFileInMemory(dfutil.HTTPRequestParams{URL: uri})
+	case id != "":
+		parentContent, err = pullParentFromRegistry(registryURL, parent, options)
+	default:
+		// The parent is already a local file, or references a Kubernetes resource
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	parentName := "parent." + devfileName
+	err = o.fsys.WriteFile(filepath.Join(dir, parentName), parentContent, 0644)
+	if err != nil {
+		return err
+	}
+	for _, key := range []string{"uri", "id", "registryUrl", "version"} {
+		delete(parent, key)
+	}
+	parent["uri"] = parentName
+	content, err = yaml.Marshal(devfileContent)
+	if err != nil {
+		return err
+	}
+	err = o.fsys.WriteFile(devfilePath, content, 0644)
+	if err != nil {
+		return err
+	}
+	return o.mirrorParent(registryURL, dir, parentName, options, depth+1)
+}
+
+// pullParentFromRegistry returns the content of the parent Devfile referenced by its registry ID
+func pullParentFromRegistry(registryURL string, parent map[string]interface{}, options library.RegistryOptions) ([]byte, error) {
+	stack, _ := parent["id"].(string)
+	if version, ok := parent["version"].(string); ok && version != "" {
+		stack += ":" + version
+	}
+	if url, ok := parent["registryUrl"].(string); ok && url != "" {
+		registryURL = url
+	}
+	tmpDir, err := ioutil.TempDir("", "odo-parent")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+	err = library.PullStackFromRegistry(registryURL, stack, tmpDir, options)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(filepath.Join(tmpDir, location.DevfileFilenamesProvider(tmpDir)))
+}
+
+// archiveStarterProject downloads the starter project into the archive
+func (o RegistryClient) archiveStarterProject(starterProject *devfilev1.StarterProject, archive string) error {
+	err := o.fsys.MkdirAll(filepath.Dir(archive), 0755)
+	if err != nil {
+		return err
+	}
+	if starterProject.Zip != nil {
+		return dfutil.DownloadFile(dfutil.DownloadParams{
+			Request:  dfutil.HTTPRequestParams{URL: starterProject.Zip.Location},
+			Filepath: archive,
+		})
+	}
+
+	tmpDir, err := ioutil.TempDir("", "odo-starter")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	// The whole repository is archived, the sub-directory is extracted when the starter project is downloaded
+	whole := starterProject.DeepCopy()
+	whole.SubDir = ""
+	err = downloadGitProject(whole, "", tmpDir, false)
+	if err != nil {
+		return err
+	}
+	return zipDir(tmpDir, starterProject.Name, archive)
+}
+
+// zipDir archives the content of the directory into the dest zip file, under the root directory.
+// As in the archives of GitHub repositories, the files are in a root directory, which is skipped when the archive is extracted
+func zipDir(dir string, root string, dest string) error {
+	file, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	w := zip.NewWriter(file)
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || path == dir {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = root + "/" + filepath.ToSlash(rel)
+		if info.IsDir() {
+			header.Name += "/"
+			_, err = w.CreateHeader(header)
+			return err
+		}
+		header.Method = zip.Deflate
+		writer, err := w.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		src, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+		_, err = io.Copy(writer, src)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// writeJSON writes the value as JSON into the file
+func (o RegistryClient) writeJSON(path string, value interface{}) error {
+	content, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = o.fsys.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("writing %s", path)
+	return o.fsys.WriteFile(path, content, 0644)
+}
@@ -5,8 +5,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
 	"sort"
-	"strings"
 	"sync"
 
 	"github.com/blang/semver"
@@ -41,7 +41,11 @@ func NewRegistryClient(fsys filesystem.Filesystem, preferenceClient preference.C
 }
 
 // PullStackFromRegistry pulls stack from registry with all stack resources (all media types) to the destination directory
+// The registry can be a filesystem-backed registry (file://), from which the resources are copied
 func (o RegistryClient) PullStackFromRegistry(registry string, stack string, destDir string, options library.RegistryOptions) error {
+	if IsFileRegistry(registry) {
+		return pullStackFromFileRegistry(o.fsys, registry, stack, destDir)
+	}
 	klog.V(3).Infof("sending telemetry data: %#v", options.Telemetry)
 	return library.PullStackFromRegistry(registry, stack, destDir, options)
 }
@@ -53,7 +57,19 @@ func (o RegistryClient) DownloadFileInMemory(params dfutil.HTTPRequestParams) ([
 
 // DownloadStarterProject downloads a starter project referenced in devfile
 // This will first remove the content of the contextDir
+// If the starter project is mirrored in a filesystem-backed registry, it is extracted from the archive of the registry
 func (o RegistryClient) DownloadStarterProject(starterProject *devfilev1.StarterProject, decryptedToken string, contextDir string, verbose bool) error {
+	var urls []string
+	for _, reg := range o.preferenceClient.RegistryList() {
+		urls = append(urls, reg.URL)
+	}
+	if archive, found := findStarterProjectArchive(o.fsys, urls, starterProject); found {
+		klog.V(4).Infof("using the archive %s of the starter project %s", archive, starterProject.Name)
+		mirrored := starterProject.DeepCopy()
+		mirrored.Git = nil
+		mirrored.Zip = &devfilev1.ZipProjectSource{Location: "file://" + filepath.ToSlash(archive)}
+		return DownloadStarterProject(mirrored, decryptedToken, contextDir, verbose)
+	}
 	return DownloadStarterProject(starterProject, decryptedToken, contextDir, verbose)
 }
 
@@ -92,8 +108,9 @@ func (o RegistryClient) GetDevfileRegistries(registryName string) ([]api.Registr
 	return devfileRegistries, nil
 }
 
-// ListDevfileStacks lists all the available devfile stacks in devfile registry
-func (o RegistryClient) ListDevfileStacks(ctx context.Context, registryName, devfileFlag, filterFlag string, detailsFlag bool) (DevfileStackList, error) {
+// ListDevfileStacks lists all the available devfile stacks in devfile registry matching the filter,
+// sorted by relevance for the filter
+func (o RegistryClient) ListDevfileStacks(ctx context.Context, registryName, devfileFlag string, filter Filter, detailsFlag bool) (DevfileStackList, error) {
 	catalogDevfileList := &DevfileStackList{}
 	var err error
 
@@ -119,7 +136,7 @@ func (o RegistryClient) ListDevfileStacks(ctx context.Context, registryName, dev
 		registry := reg                 // Needed to prevent the lambda from capturing the value
 		registryPriority := regPriority // Needed to prevent the lambda from capturing the value
 		retrieveRegistryIndices.Add(util.ConcurrentTask{ToRun: func(errChannel chan error) {
-			registryDevfiles, err := getRegistryStacks(ctx, o.preferenceClient, registry)
+			registryDevfiles, err := getRegistryStacks(ctx, o.fsys, registry)
 			if err != nil {
 				log.Warningf("Registry %s is not set up properly with error: %v, please check the registry URL, and credential and remove add the registry again (refer to `odo preference add registry --help`)\n", registry.Name, err)
 				return
@@ -135,7 +152,7 @@ func (o RegistryClient) ListDevfileStacks(ctx context.Context, registryName, dev
 	}
 
 	// Go through all the devfiles and filter based on:
-	// What's in the name or description
+	// The criteria of the filter
 	// The exact name of the devfile
 	//
 	// We also add additional details such as supported odo features (which we
@@ -149,19 +166,26 @@ func (o RegistryClient) ListDevfileStacks(ctx context.Context, registryName, dev
 			// Add the "priority" of the registry to the devfile
 			devfile.Registry.Priority = priorityNumber
 
-			if filterFlag != "" {
-				if !strings.Contains(devfile.Name, filterFlag) && !strings.Contains(devfile.Description, filterFlag) {
+			if devfileFlag != "" {
+				if devfileFlag != devfile.Name {
 					continue
 				}
 			}
 
-			if devfileFlag != "" {
-				if devfileFlag != devfile.Name {
-					continue
+			// The details are retrieved before filtering when the schema version of the Devfile is needed by the filter
+			if detailsFlag && filter.needsDevfileData(devfile) {
+				devfileData, err := o.retrieveDevfileDataFromRegistry(ctx, devfile.Registry.Name, devfile.Name)
+				if err != nil {
+					return *catalogDevfileList, err
 				}
+				devfile.DevfileData = &devfileData
 			}
 
-			if detailsFlag {
+			if !filter.Matches(devfile) {
+				continue
+			}
+
+			if detailsFlag && devfile.DevfileData == nil {
 				devfileData, err := o.retrieveDevfileDataFromRegistry(ctx, devfile.Registry.Name, devfile.Name)
 				if err != nil {
 					return *catalogDevfileList, err
@@ -176,20 +200,23 @@ func (o RegistryClient) ListDevfileStacks(ctx context.Context, registryName, dev
 	}
 
 	// Sort catalogDevfileList.Items by:
-	// 1. Priority of the registry (highest priority has highest index)
+	// 1. Relevance for the filter
 	// 2. Name of the devfile
-	sort.Slice(catalogDevfileList.Items[:], func(i, j int) bool {
-		if catalogDevfileList.Items[i].Name == catalogDevfileList.Items[j].Name {
-			return catalogDevfileList.Items[i].Registry.Priority < catalogDevfileList.Items[j].Registry.Priority
-		}
-		return catalogDevfileList.Items[i].Name < catalogDevfileList.Items[j].Name
-	})
+	// 3. Priority of the registry (highest priority has highest index)
+	filter.Sort(catalogDevfileList.Items)
 
 	return *catalogDevfileList, nil
 }
 
 // getRegistryStacks retrieves the registry's index devfile stack entries
-func getRegistryStacks(ctx context.Context, preferenceClient preference.Client, registry api.Registry) ([]api.DevfileStack, error) {
+func getRegistryStacks(ctx context.Context, fsys filesystem.Filesystem, registry api.Registry) ([]api.DevfileStack, error) {
+	if IsFileRegistry(registry.URL) {
+		devfileIndex, err := getFileRegistryIndex(fsys, registry.URL)
+		if err != nil {
+			return nil, err
+		}
+		return createRegistryDevfiles(registry, devfileIndex)
+	}
 	isGithubregistry, err := IsGithubBasedRegistry(registry.URL)
 	if err != nil {
 		return nil, err
@@ -225,6 +252,7 @@ func createRegistryDevfiles(registry api.Registry, devfileIndex []indexSchema.Sc
 			Language:               devfileIndexEntry.Language,
 			Tags:                   devfileIndexEntry.Tags,
 			ProjectType:            devfileIndexEntry.ProjectType,
+			Provider:               devfileIndexEntry.Provider,
 			DefaultStarterProjects: devfileIndexEntry.StarterProjects,
 			DefaultVersion:         devfileIndexEntry.Version,
 		}
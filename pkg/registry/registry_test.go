@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -149,7 +150,7 @@ func TestListDevfileStacks(t *testing.T) {
 		name         string
 		registryName string
 		devfileName  string
-		filter       string
+		filter       Filter
 		want         DevfileStackList
 	}{
 		{
@@ -219,7 +220,7 @@ func TestListDevfileStacks(t *testing.T) {
 		{
 			name:         "Case 3: Test getting a devfile using a filter from the description",
 			registryName: "TestRegistry",
-			filter:       "Python Stack",
+			filter:       Filter{Text: "Python Stack"},
 			want: DevfileStackList{
 				DevfileRegistries: []api.Registry{
 					{
@@ -244,7 +245,70 @@ func TestListDevfileStacks(t *testing.T) {
 			},
 		},
 		{
-			name:         "Case 4: Expect nothing back if registry is not found",
+			name:   "Case 4: Test getting devfiles by tag, the preferred languages first",
+			filter: Filter{Tags: []string{"alpine"}, PreferredLanguages: []string{"python"}},
+			want: DevfileStackList{
+				DevfileRegistries: []api.Registry{
+					{
+						Name:   "TestRegistry",
+						URL:    server.URL,
+						Secure: false,
+					},
+				},
+				Items: []api.DevfileStack{
+					{
+						Name:        "nodejs",
+						DisplayName: "NodeJS Angular Web Application",
+						Description: "Stack for developing NodeJS Angular Web Application",
+						Registry: api.Registry{
+							Name: registryName,
+							URL:  server.URL,
+						},
+						Language: "nodejs",
+						Tags:     []string{"NodeJS", "Angular", "Alpine"},
+					},
+				},
+			},
+		},
+		{
+			name:   "Case 5: Test the devfiles of the preferred languages are listed first",
+			filter: Filter{PreferredLanguages: []string{"Python"}},
+			want: DevfileStackList{
+				DevfileRegistries: []api.Registry{
+					{
+						Name:   "TestRegistry",
+						URL:    server.URL,
+						Secure: false,
+					},
+				},
+				Items: []api.DevfileStack{
+					{
+						Name:        "python",
+						DisplayName: "Python",
+						Description: "Python Stack with Python 3.7",
+						Registry: api.Registry{
+							Name: registryName,
+							URL:  server.URL,
+						},
+						Language: "python",
+						Tags:     []string{"Python", "pip"},
+					},
+					{
+						Name:        "nodejs",
+						DisplayName: "NodeJS Angular Web Application",
+						Description: "Stack for developing NodeJS Angular Web Application",
+						Registry: api.Registry{
+							Name: registryName,
+							URL:  server.URL,
+						},
+						Language: "nodejs",
+						Tags:     []string{"NodeJS", "Angular", "Alpine"},
+					},
+				},
+			},
+		},
+		{
+			name:         "Case 6: Expect nothing back if registry is not found",
 			registryName: "Foobar",
 			want:         DevfileStackList{},
 		},
@@ -465,6 +529,42 @@ func TestGetRegistryDevfiles(t *testing.T) {
 						Registry:               api.Registry{Name: registryName, URL: registryUrl},
 						Language:               "Go",
 						ProjectType:            "Go",
+						Provider:               "Red Hat",
+						Tags:                   []string{"Go"},
+						DefaultVersion:         "1.0.2",
+						DefaultStarterProjects: []string{"go-starter"},
+						Versions: []api.DevfileStackVersion{
+							{Version: "1.0.2", IsDefault: true, SchemaVersion: "2.1.0", StarterProjects: []string{"go-starter"}},
+							{Version: "2.0.0", IsDefault: false, SchemaVersion: "2.2.0", StarterProjects: []string{"go-starter"}},
+						},
+					},
+				}
+			},
+		},
+		{
+			name: "Filesystem registry",
+			registryServerProvider: func(t *testing.T) (*httptest.Server, string) {
+				dir := t.TempDir()
+				err := os.WriteFile(filepath.Join(dir, "index.json"), []byte(v2IndexResponse), 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+				url, err := GetFileRegistryURL(dir)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return nil, url
+			},
+			wantProvider: func(registryUrl string) []api.DevfileStack {
+				return []api.DevfileStack{
+					{
+						Name:                   "go",
+						DisplayName:            "Go Runtime",
+						Description:            "Go is an open source programming language that makes it easy to build simple, reliable, and efficient software.",
+						Registry:               api.Registry{Name: registryName, URL: registryUrl},
+						Language:               "Go",
+						ProjectType:            "Go",
+						Provider:               "Red Hat",
 						Tags:                   []string{"Go"},
 						DefaultVersion:         "1.0.2",
 						DefaultStarterProjects: []string{"go-starter"},
@@ -507,6 +607,7 @@ func TestGetRegistryDevfiles(t *testing.T) {
 						Registry:               api.Registry{Name: registryName, URL: registryUrl},
 						Language:               "Go",
 						ProjectType:            "Go",
+						Provider:               "Red Hat",
 						Tags:                   []string{"Go"},
 						DefaultVersion:         "1.0.2",
 						DefaultStarterProjects: []string{"go-starter"},
@@ -522,15 +623,13 @@ func TestGetRegistryDevfiles(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			prefClient := preference.NewMockClient(ctrl)
 			ctx := envcontext.WithEnvConfig(context.Background(), config.Configuration{})
 			server, url := tt.registryServerProvider(t)
 			if server != nil {
 				defer server.Close()
 			}
 
-			got, err := getRegistryStacks(ctx, prefClient, api.Registry{Name: registryName, URL: url})
+			got, err := getRegistryStacks(ctx, filesystem.DefaultFs{}, api.Registry{Name: registryName, URL: url})
 
 			if tt.wantErr != (err != nil) {
 				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
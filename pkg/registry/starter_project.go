@@ -4,14 +4,19 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/url"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"k8s.io/klog"
 
 	"github.com/redhat-developer/odo/pkg/devfile/location"
 	"github.com/redhat-developer/odo/pkg/log"
@@ -90,6 +95,51 @@ func DownloadStarterProject(starterProject *devfilev1.StarterProject, decryptedT
 	return nil
 }
 
+// gitCredentials returns the credentials to access the repository at remoteURL, given by the git credential helpers
+// configured by the user (see gitcredentials(7)), or nil if git is not installed or no credentials are found
+func gitCredentials(remoteURL string) *http.BasicAuth {
+	u, err := url.Parse(remoteURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil
+	}
+	input := fmt.Sprintf("protocol=%s\nhost=%s\npath=%s\n", u.Scheme, u.Host, strings.TrimPrefix(u.Path, "/"))
+	if u.User != nil {
+		input += fmt.Sprintf("username=%s\n", u.User.Username())
+	}
+
+	cmd := exec.Command("git", "credential", "fill")
+	cmd.Stdin = strings.NewReader(input + "\n")
+	// never prompt the user, only the credentials stored by the helpers are used
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0", "GIT_ASKPASS=")
+	out, err := cmd.Output()
+	if err != nil {
+		klog.V(4).Infof("no credentials found for %s with the git credential helpers: %v", u.Host, err)
+		return nil
+	}
+	return parseGitCredentials(string(out))
+}
+
+// parseGitCredentials parses the output of "git credential fill"
+func parseGitCredentials(output string) *http.BasicAuth {
+	auth := &http.BasicAuth{}
+	for _, line := range strings.Split(output, "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if !found {
+			continue
+		}
+		switch key {
+		case "username":
+			auth.Username = value
+		case "password":
+			auth.Password = value
+		}
+	}
+	if auth.Password == "" {
+		return nil
+	}
+	return auth
+}
+
 // downloadGitProject downloads the git starter projects from devfile.yaml
 func downloadGitProject(starterProject *devfilev1.StarterProject, starterToken, path string, verbose bool) error {
 	remoteName, remoteUrl, revision, err := parsercommon.GetDefaultSource(starterProject.Git.GitLikeProjectSource)
@@ -151,6 +201,15 @@ func downloadGitProject(starterProject *devfilev1.StarterProject, starterToken,
 
 	_, err = git.PlainClone(path, false, cloneOptions)
 
+	if errors.Is(err, transport.ErrAuthenticationRequired) && cloneOptions.Auth == nil {
+		// the repository is private, try again with the credentials given by the git credential helpers of the user
+		if auth := gitCredentials(remoteUrl); auth != nil {
+			_ = os.RemoveAll(filepath.Join(path, ".git"))
+			cloneOptions.Auth = auth
+			_, err = git.PlainClone(path, false, cloneOptions)
+		}
+	}
+
 	if err != nil {
 
 		// it returns the following error if no matching ref found
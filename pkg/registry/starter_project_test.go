@@ -0,0 +1,40 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_parseGitCredentials(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   *http.BasicAuth
+	}{
+		{
+			name:   "credentials found",
+			output: "protocol=https\nhost=github.com\nusername=user\npassword=secret\n",
+			want:   &http.BasicAuth{Username: "user", Password: "secret"},
+		},
+		{
+			name:   "password containing an equal sign",
+			output: "username=user\npassword=se=cret\n",
+			want:   &http.BasicAuth{Username: "user", Password: "se=cret"},
+		},
+		{
+			name:   "no password",
+			output: "protocol=https\nhost=github.com\nusername=user\n",
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseGitCredentials(tt.output)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("parseGitCredentials() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package asker
+
+type CreationOption int
+
+const (
+	AddToDevfile CreationOption = iota
+	CreateOnCluster
+)
+
+// Parameter describes a parameter of a service instance, as defined by the OpenAPI schema of its custom resource
+type Parameter struct {
+	// Path is the path of the parameter in the spec of the custom resource, with fields separated by dots
+	Path string
+	// Description is the description of the parameter, if any
+	Description string
+	// Type is the type of the parameter: string, integer, number or boolean
+	Type string
+	// Enum contains the values accepted for the parameter, if restricted
+	Enum []string
+	// Default is the default value of the parameter, if any
+	Default string
+	// Required indicates the parameter must be set
+	Required bool
+}
+
+type Asker interface {
+	// SelectServiceKind takes a list of kinds of services provided by the Operators and asks user to select one
+	SelectServiceKind(options []string) (int, error)
+	// AskServiceName asks for the name of the service instance
+	AskServiceName(defaultName string) (string, error)
+	// AskParameter asks for the value of a parameter of the service instance.
+	// An empty value means the parameter is not set
+	AskParameter(param Parameter) (string, error)
+	// AskConfigureObject asks if the optional object parameter with the given path should be configured
+	AskConfigureObject(path string, description string) (bool, error)
+	// SelectCreationOption asks if the service instance should be added to the Devfile or created on the cluster
+	SelectCreationOption() (CreationOption, error)
+}
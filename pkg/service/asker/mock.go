@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/service/asker/interface.go
+
+// Package asker is a generated GoMock package.
+package asker
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAsker is a mock of Asker interface.
+type MockAsker struct {
+	ctrl     *gomock.Controller
+	recorder *MockAskerMockRecorder
+}
+
+// MockAskerMockRecorder is the mock recorder for MockAsker.
+type MockAskerMockRecorder struct {
+	mock *MockAsker
+}
+
+// NewMockAsker creates a new mock instance.
+func NewMockAsker(ctrl *gomock.Controller) *MockAsker {
+	mock := &MockAsker{ctrl: ctrl}
+	mock.recorder = &MockAskerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAsker) EXPECT() *MockAskerMockRecorder {
+	return m.recorder
+}
+
+// AskConfigureObject mocks base method.
+func (m *MockAsker) AskConfigureObject(path, description string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AskConfigureObject", path, description)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AskConfigureObject indicates an expected call of AskConfigureObject.
+func (mr *MockAskerMockRecorder) AskConfigureObject(path, description interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskConfigureObject", reflect.TypeOf((*MockAsker)(nil).AskConfigureObject), path, description)
+}
+
+// AskParameter mocks base method.
+func (m *MockAsker) AskParameter(param Parameter) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AskParameter", param)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AskParameter indicates an expected call of AskParameter.
+func (mr *MockAskerMockRecorder) AskParameter(param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskParameter", reflect.TypeOf((*MockAsker)(nil).AskParameter), param)
+}
+
+// AskServiceName mocks base method.
+func (m *MockAsker) AskServiceName(defaultName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AskServiceName", defaultName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AskServiceName indicates an expected call of AskServiceName.
+func (mr *MockAskerMockRecorder) AskServiceName(defaultName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskServiceName", reflect.TypeOf((*MockAsker)(nil).AskServiceName), defaultName)
+}
+
+// SelectCreationOption mocks base method.
+func (m *MockAsker) SelectCreationOption() (CreationOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectCreationOption")
+	ret0, _ := ret[0].(CreationOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectCreationOption indicates an expected call of SelectCreationOption.
+func (mr *MockAskerMockRecorder) SelectCreationOption() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectCreationOption", reflect.TypeOf((*MockAsker)(nil).SelectCreationOption))
+}
+
+// SelectServiceKind mocks base method.
+func (m *MockAsker) SelectServiceKind(options []string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectServiceKind", options)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectServiceKind indicates an expected call of SelectServiceKind.
+func (mr *MockAskerMockRecorder) SelectServiceKind(options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectServiceKind", reflect.TypeOf((*MockAsker)(nil).SelectServiceKind), options)
+}
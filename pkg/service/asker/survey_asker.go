@@ -0,0 +1,142 @@
+package asker
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/AlecAivazis/survey/v2"
+	dfutil "github.com/devfile/library/pkg/util"
+)
+
+// notSetOption is the option to leave an optional parameter unset, when selecting its value
+const notSetOption = "(not set)"
+
+type Survey struct{}
+
+var _ Asker = (*Survey)(nil)
+
+func NewSurveyAsker() *Survey {
+	return &Survey{}
+}
+
+func (s *Survey) SelectServiceKind(options []string) (int, error) {
+	question := &survey.Select{
+		Message: "Select the kind of service you want to create:",
+		Options: options,
+	}
+	var answer int
+	err := survey.AskOne(question, &answer)
+	if err != nil {
+		return 0, err
+	}
+	return answer, nil
+}
+
+func (s *Survey) AskServiceName(defaultName string) (string, error) {
+	question := &survey.Input{
+		Message: "Enter the service's name:",
+		Default: defaultName,
+	}
+	var answer string
+	err := survey.AskOne(question, &answer, survey.WithValidator(func(ans interface{}) error {
+		return dfutil.ValidateK8sResourceName("service name", ans.(string))
+	}))
+	if err != nil {
+		return "", err
+	}
+	return answer, nil
+}
+
+func (s *Survey) AskParameter(param Parameter) (string, error) {
+	message := fmt.Sprintf("%s (%s):", param.Path, param.Type)
+	if param.Required {
+		message = fmt.Sprintf("%s (%s, required):", param.Path, param.Type)
+	}
+
+	var question survey.Prompt
+	switch {
+	case len(param.Enum) > 0 || param.Type == "boolean":
+		options := param.Enum
+		if len(options) == 0 {
+			options = []string{"true", "false"}
+		}
+		if !param.Required {
+			options = append([]string{notSetOption}, options...)
+		}
+		selectQuestion := &survey.Select{
+			Message: message,
+			Options: options,
+			Help:    param.Description,
+		}
+		if param.Default != "" {
+			selectQuestion.Default = param.Default
+		}
+		question = selectQuestion
+	default:
+		question = &survey.Input{
+			Message: message,
+			Default: param.Default,
+			Help:    param.Description,
+		}
+	}
+
+	var answer string
+	err := survey.AskOne(question, &answer, survey.WithValidator(func(ans interface{}) error {
+		return validateParameter(param, fmt.Sprint(ans))
+	}))
+	if err != nil {
+		return "", err
+	}
+	if answer == notSetOption {
+		return "", nil
+	}
+	return answer, nil
+}
+
+func (s *Survey) AskConfigureObject(path string, description string) (bool, error) {
+	question := &survey.Confirm{
+		Message: fmt.Sprintf("Do you want to configure %s?", path),
+		Help:    description,
+	}
+	var answer bool
+	err := survey.AskOne(question, &answer)
+	if err != nil {
+		return false, err
+	}
+	return answer, nil
+}
+
+func (s *Survey) SelectCreationOption() (CreationOption, error) {
+	question := &survey.Select{
+		Message: "How do you want to create the service?",
+		Options: []string{"add it to the Devfile", "create it on the cluster"}, // respect order of CreationOption constants
+	}
+	var answer int
+	err := survey.AskOne(question, &answer)
+	if err != nil {
+		return 0, err
+	}
+	return CreationOption(answer), nil
+}
+
+// validateParameter checks that the value entered by the user matches the type of the parameter
+func validateParameter(param Parameter, value string) error {
+	if value == "" || value == notSetOption {
+		if param.Required {
+			return errors.New("a value is required")
+		}
+		return nil
+	}
+	switch param.Type {
+	case "integer":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,340 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/service/asker"
+)
+
+// OperatorCRD is a kind of service provided by an Operator installed in the namespace
+type OperatorCRD struct {
+	// Operator is the name of the ClusterServiceVersion owning the CRD
+	Operator string
+	CRD      olm.CRDDescription
+}
+
+// GroupVersionKind returns the GroupVersionKind of the service instances
+func (o OperatorCRD) GroupVersionKind() schema.GroupVersionKind {
+	gvr := kclient.GetGVRFromCR(&o.CRD)
+	return gvr.GroupVersion().WithKind(o.CRD.Kind)
+}
+
+// KindGroup returns the kind and group of the service instances, in the format accepted by the --kind flag
+func (o OperatorCRD) KindGroup() string {
+	return o.CRD.Kind + "." + o.GroupVersionKind().Group
+}
+
+// String returns the description of the CRD displayed to the user
+func (o OperatorCRD) String() string {
+	desc := fmt.Sprintf("%s/%s (%s)", o.KindGroup(), o.CRD.Version, o.Operator)
+	if o.CRD.DisplayName != "" {
+		desc = fmt.Sprintf("%s - %s", desc, o.CRD.DisplayName)
+	}
+	return desc
+}
+
+// ListOperatorCRDs returns the kinds of services provided by the Operators installed in the namespace,
+// sorted by kind and group
+func ListOperatorCRDs(client kclient.ClientInterface) ([]OperatorCRD, error) {
+	supported, err := client.IsCSVSupported()
+	if err != nil {
+		return nil, err
+	}
+	if !supported {
+		return nil, errors.New("the Operator Lifecycle Manager is not installed on the cluster, services cannot be created")
+	}
+
+	csvs, err := client.ListClusterServiceVersions()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the Operators: %w", err)
+	}
+
+	var result []OperatorCRD
+	for i := range csvs.Items {
+		for _, crd := range *client.GetCustomResourcesFromCSV(&csvs.Items[i]) {
+			if !strings.Contains(crd.Name, ".") {
+				klog.V(4).Infof("ignoring the CRD %q of the Operator %q, its name is not in the format <plural>.<group>", crd.Name, csvs.Items[i].Name)
+				continue
+			}
+			result = append(result, OperatorCRD{
+				Operator: csvs.Items[i].Name,
+				CRD:      crd,
+			})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].KindGroup() != result[j].KindGroup() {
+			return result[i].KindGroup() < result[j].KindGroup()
+		}
+		return result[i].CRD.Version < result[j].CRD.Version
+	})
+	return result, nil
+}
+
+// FindOperatorCRD returns the CRD matching kind, in the format <kind>[.<group>][/<version>]
+func FindOperatorCRD(crds []OperatorCRD, kind string) (OperatorCRD, error) {
+	var version string
+	if parts := strings.SplitN(kind, "/", 2); len(parts) == 2 {
+		kind, version = parts[0], parts[1]
+	}
+	var group string
+	if parts := strings.SplitN(kind, ".", 2); len(parts) == 2 {
+		kind, group = parts[0], parts[1]
+	}
+
+	var found []OperatorCRD
+	for _, crd := range crds {
+		gvk := crd.GroupVersionKind()
+		if gvk.Kind != kind || (group != "" && gvk.Group != group) || (version != "" && gvk.Version != version) {
+			continue
+		}
+		found = append(found, crd)
+	}
+
+	switch len(found) {
+	case 0:
+		available := make([]string, 0, len(crds))
+		for _, crd := range crds {
+			available = append(available, crd.KindGroup()+"/"+crd.CRD.Version)
+		}
+		return OperatorCRD{}, fmt.Errorf("no Operator provides the service kind %q, the available kinds are: %s", kind, strings.Join(available, ", "))
+	case 1:
+		return found[0], nil
+	default:
+		matching := make([]string, 0, len(found))
+		for _, crd := range found {
+			matching = append(matching, crd.KindGroup()+"/"+crd.CRD.Version)
+		}
+		return OperatorCRD{}, fmt.Errorf("several Operators provide the service kind %q, please specify one of: %s", kind, strings.Join(matching, ", "))
+	}
+}
+
+// GetCRDSchema returns the OpenAPI schema of the spec of the service instances,
+// from the cluster or, if not available, from the spec descriptors of the Operator.
+// The returned schema is nil if no schema is available
+func GetCRDSchema(client kclient.ClientInterface, crd OperatorCRD) *spec.Schema {
+	gvk := crd.GroupVersionKind()
+	crdSchema, err := client.GetResourceSpecDefinition(gvk.Group, gvk.Version, gvk.Kind)
+	if err != nil {
+		klog.V(4).Infof("unable to get the definition of %s from the cluster, using the spec descriptors of the Operator: %s", gvk, err)
+	}
+	if crdSchema == nil {
+		crdSchema = kclient.ToOpenAPISpec(&crd.CRD)
+	}
+	return crdSchema
+}
+
+// AskParams asks the user the values of the parameters defined in the schema,
+// and returns them in the format accepted by BuildServiceFromParams.
+// The required parameters are asked first, and the optional objects are configured only if the user agrees
+func AskParams(a asker.Asker, crdSchema *spec.Schema) (map[string]string, error) {
+	params := map[string]string{}
+	if crdSchema == nil {
+		return params, nil
+	}
+	err := askObjectParams(a, crdSchema, "", params)
+	return params, err
+}
+
+func askObjectParams(a asker.Asker, crdSchema *spec.Schema, prefix string, params map[string]string) error {
+	for _, name := range getSortedProperties(crdSchema) {
+		property := crdSchema.Properties[name]
+		path := prefix + name
+		required := contains(crdSchema.Required, name)
+
+		if property.Type.Contains("object") {
+			if len(property.Properties) == 0 {
+				klog.V(4).Infof("not asking for %s, an object without defined properties", path)
+				continue
+			}
+			if !required {
+				configure, err := a.AskConfigureObject(path, getDescription(property))
+				if err != nil {
+					return err
+				}
+				if !configure {
+					continue
+				}
+			}
+			if err := askObjectParams(a, &property, path+".", params); err != nil {
+				return err
+			}
+			continue
+		}
+
+		paramType := getParamType(property)
+		if paramType == "" {
+			klog.V(4).Infof("not asking for %s, its type %v is not supported", path, property.Type)
+			continue
+		}
+		param := asker.Parameter{
+			Path:        path,
+			Description: getDescription(property),
+			Type:        paramType,
+			Required:    required,
+		}
+		for _, value := range property.Enum {
+			param.Enum = append(param.Enum, fmt.Sprint(value))
+		}
+		if property.Default != nil {
+			param.Default = fmt.Sprint(property.Default)
+		}
+		value, err := a.AskParameter(param)
+		if err != nil {
+			return err
+		}
+		if value != "" {
+			params[path] = value
+		}
+	}
+	return nil
+}
+
+// ParseParams parses the parameters of a service instance defined as KEY=VALUE pairs
+func ParseParams(pairs []string) (map[string]string, error) {
+	params := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) < 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid parameter %q, please specify a KEY=VALUE pair", pair)
+		}
+		if _, found := params[parts[0]]; found {
+			return nil, fmt.Errorf("multiple values found for the parameter %q", parts[0])
+		}
+		params[parts[0]] = parts[1]
+	}
+	return params, nil
+}
+
+// BuildServiceFromParams returns the service instance named name, of the kind defined by crd,
+// with a spec built from the parameters, after validating them against the schema
+func BuildServiceFromParams(name string, crd OperatorCRD, crdSchema *spec.Schema, params map[string]string) (unstructured.Unstructured, error) {
+	if crdSchema != nil {
+		err := validateParams(crdSchema, "", params)
+		if err != nil {
+			return unstructured.Unstructured{}, err
+		}
+	}
+	gvk := crd.GroupVersionKind()
+	content, err := BuildCRDFromParams(params, crdSchema, gvk.Group, gvk.Version, gvk.Kind)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	u := unstructured.Unstructured{Object: content}
+	u.SetName(name)
+	return u, nil
+}
+
+// validateParams checks that the parameters under prefix are defined by the schema, with a valid value,
+// and that the required parameters are set
+func validateParams(crdSchema *spec.Schema, prefix string, params map[string]string) error {
+	// the parameters can be unknown when the schema does not define properties, or accepts additional properties
+	strict := len(crdSchema.Properties) > 0 &&
+		(crdSchema.AdditionalProperties == nil || (!crdSchema.AdditionalProperties.Allows && crdSchema.AdditionalProperties.Schema == nil))
+
+	for key, value := range params {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		name := strings.SplitN(strings.TrimPrefix(key, prefix), ".", 2)[0]
+		property, found := crdSchema.Properties[name]
+		if !found {
+			if strict {
+				return fmt.Errorf("unknown parameter %q", prefix+name)
+			}
+			continue
+		}
+		if key != prefix+name || len(property.Enum) == 0 {
+			continue
+		}
+		var valid bool
+		values := make([]string, 0, len(property.Enum))
+		for _, enumValue := range property.Enum {
+			values = append(values, fmt.Sprint(enumValue))
+			valid = valid || fmt.Sprint(enumValue) == value
+		}
+		if !valid {
+			return fmt.Errorf("invalid value %q for the parameter %q, accepted values are: %s", value, key, strings.Join(values, ", "))
+		}
+	}
+
+	for name, property := range crdSchema.Properties {
+		property := property
+		path := prefix + name
+		isSet := hasParam(params, path)
+		required := contains(crdSchema.Required, name)
+		// the required sub-parameters of an object are reported before the object itself
+		if property.Type.Contains("object") && (isSet || required) {
+			if err := validateParams(&property, path+".", params); err != nil {
+				return err
+			}
+		}
+		if !isSet && required && property.Default == nil {
+			return fmt.Errorf("the parameter %q is required", path)
+		}
+	}
+	return nil
+}
+
+// hasParam returns true if the parameter path, or one of its sub-parameters, is set
+func hasParam(params map[string]string, path string) bool {
+	for key := range params {
+		if key == path || strings.HasPrefix(key, path+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// getSortedProperties returns the names of the properties of the schema, the required ones first,
+// then sorted alphabetically
+func getSortedProperties(crdSchema *spec.Schema) []string {
+	names := make([]string, 0, len(crdSchema.Properties))
+	for name := range crdSchema.Properties {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		ri, rj := contains(crdSchema.Required, names[i]), contains(crdSchema.Required, names[j])
+		if ri != rj {
+			return ri
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// getParamType returns the first scalar type accepted by the property, or an empty string if none
+func getParamType(property spec.Schema) string {
+	for _, t := range []string{"string", "integer", "number", "boolean"} {
+		if property.Type.Contains(t) {
+			return t
+		}
+	}
+	return ""
+}
+
+func getDescription(property spec.Schema) string {
+	if property.Description != "" {
+		return property.Description
+	}
+	return property.Title
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/service/asker"
+)
+
+var (
+	redisCRD = OperatorCRD{
+		Operator: "redis-operator.v0.8.0",
+		CRD:      olm.CRDDescription{Name: "redis.redis.redis.opstreelabs.in", Version: "v1beta1", Kind: "Redis", DisplayName: "Redis"},
+	}
+	clusterCRD = OperatorCRD{
+		Operator: "cloud-native-postgresql.v1.15.0",
+		CRD:      olm.CRDDescription{Name: "clusters.postgresql.k8s.enterprisedb.io", Version: "v1", Kind: "Cluster"},
+	}
+	otherClusterCRD = OperatorCRD{
+		Operator: "other-operator.v1.0.0",
+		CRD:      olm.CRDDescription{Name: "clusters.other.example.com", Version: "v1alpha1", Kind: "Cluster"},
+	}
+
+	// clusterSchema is the schema of the spec of clusterCRD used by the tests
+	clusterSchema = func() *spec.Schema {
+		storage := new(spec.Schema).Typed("object", "")
+		storage.SetProperty("size", *spec.StringProperty().WithDescription("Size of the storage"))
+		storage.SetProperty("storageClass", *spec.StringProperty())
+		storage.WithRequired("size")
+
+		bootstrap := new(spec.Schema).Typed("object", "")
+		bootstrap.SetProperty("database", *spec.StringProperty())
+
+		result := new(spec.Schema).Typed("object", "")
+		result.SetProperty("instances", *spec.Int64Property().WithDefault(1))
+		result.SetProperty("logLevel", *spec.StringProperty().WithEnum("info", "debug"))
+		result.SetProperty("monitoring", *spec.BoolProperty())
+		result.SetProperty("storage", *storage)
+		result.SetProperty("bootstrap", *bootstrap)
+		result.SetProperty("labels", *spec.MapProperty(spec.StringProperty()))
+		result.WithRequired("storage", "instances")
+		return result
+	}()
+)
+
+func TestListOperatorCRDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := kclient.NewMockClientInterface(ctrl)
+	client.EXPECT().IsCSVSupported().Return(true, nil)
+	csvs := &olm.ClusterServiceVersionList{Items: []olm.ClusterServiceVersion{
+		{ObjectMeta: metav1.ObjectMeta{Name: redisCRD.Operator}},
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterCRD.Operator}},
+	}}
+	client.EXPECT().ListClusterServiceVersions().Return(csvs, nil)
+	client.EXPECT().GetCustomResourcesFromCSV(&csvs.Items[0]).Return(&[]olm.CRDDescription{redisCRD.CRD, {Name: "invalid", Kind: "Invalid"}})
+	client.EXPECT().GetCustomResourcesFromCSV(&csvs.Items[1]).Return(&[]olm.CRDDescription{clusterCRD.CRD})
+
+	got, err := ListOperatorCRDs(client)
+	if err != nil {
+		t.Fatalf("ListOperatorCRDs() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]OperatorCRD{clusterCRD, redisCRD}, got); diff != "" {
+		t.Errorf("ListOperatorCRDs() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFindOperatorCRD(t *testing.T) {
+	crds := []OperatorCRD{clusterCRD, otherClusterCRD, redisCRD}
+	tests := []struct {
+		name    string
+		kind    string
+		want    OperatorCRD
+		wantErr string
+	}{
+		{
+			name: "kind",
+			kind: "Redis",
+			want: redisCRD,
+		},
+		{
+			name: "kind and group",
+			kind: "Cluster.postgresql.k8s.enterprisedb.io",
+			want: clusterCRD,
+		},
+		{
+			name: "kind and version",
+			kind: "Cluster/v1alpha1",
+			want: otherClusterCRD,
+		},
+		{
+			name:    "ambiguous kind",
+			kind:    "Cluster",
+			wantErr: `several Operators provide the service kind "Cluster"`,
+		},
+		{
+			name:    "unknown kind",
+			kind:    "Kafka",
+			wantErr: `no Operator provides the service kind "Kafka", the available kinds are: Cluster.postgresql.k8s.enterprisedb.io/v1`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FindOperatorCRD(crds, tt.kind)
+			if tt.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+					t.Errorf("FindOperatorCRD() error = %v, want an error starting with %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FindOperatorCRD() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FindOperatorCRD() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildServiceFromParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  *spec.Schema
+		params  map[string]string
+		want    map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:   "valid parameters",
+			schema: clusterSchema,
+			params: map[string]string{"instances": "3", "storage.size": "1Gi", "logLevel": "debug", "labels.team": "a"},
+			want: map[string]interface{}{
+				"apiVersion": "postgresql.k8s.enterprisedb.io/v1",
+				"kind":       "Cluster",
+				"metadata":   map[string]interface{}{"name": "my-db"},
+				"spec": map[string]interface{}{
+					"instances": int64(3),
+					"storage":   map[string]interface{}{"size": "1Gi"},
+					"logLevel":  "debug",
+					"labels":    map[string]interface{}{"team": "a"},
+				},
+			},
+		},
+		{
+			name:   "required parameter with a default value",
+			schema: clusterSchema,
+			params: map[string]string{"storage.size": "1Gi"},
+			want: map[string]interface{}{
+				"apiVersion": "postgresql.k8s.enterprisedb.io/v1",
+				"kind":       "Cluster",
+				"metadata":   map[string]interface{}{"name": "my-db"},
+				"spec": map[string]interface{}{
+					"storage": map[string]interface{}{"size": "1Gi"},
+				},
+			},
+		},
+		{
+			name: "without schema",
+			params: map[string]string{
+				"instances": "3",
+			},
+			want: map[string]interface{}{
+				"apiVersion": "postgresql.k8s.enterprisedb.io/v1",
+				"kind":       "Cluster",
+				"metadata":   map[string]interface{}{"name": "my-db"},
+				"spec": map[string]interface{}{
+					"instances": int64(3),
+				},
+			},
+		},
+		{
+			name:    "missing required parameter",
+			schema:  clusterSchema,
+			params:  map[string]string{"instances": "3"},
+			wantErr: `the parameter "storage.size" is required`,
+		},
+		{
+			name:    "unknown parameter",
+			schema:  clusterSchema,
+			params:  map[string]string{"storage.size": "1Gi", "storage.unknown": "value"},
+			wantErr: `unknown parameter "storage.unknown"`,
+		},
+		{
+			name:    "invalid enum value",
+			schema:  clusterSchema,
+			params:  map[string]string{"storage.size": "1Gi", "logLevel": "trace"},
+			wantErr: `invalid value "trace" for the parameter "logLevel", accepted values are: info, debug`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildServiceFromParams("my-db", clusterCRD, tt.schema, tt.params)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("BuildServiceFromParams() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("BuildServiceFromParams() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got.Object); diff != "" {
+				t.Errorf("BuildServiceFromParams() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAskParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	a := asker.NewMockAsker(ctrl)
+	gomock.InOrder(
+		a.EXPECT().AskParameter(asker.Parameter{Path: "instances", Type: "integer", Default: "1", Required: true}).Return("2", nil),
+		a.EXPECT().AskParameter(asker.Parameter{Path: "storage.size", Description: "Size of the storage", Type: "string", Required: true}).Return("1Gi", nil),
+		a.EXPECT().AskParameter(asker.Parameter{Path: "storage.storageClass", Type: "string"}).Return("", nil),
+		a.EXPECT().AskConfigureObject("bootstrap", "").Return(false, nil),
+		a.EXPECT().AskParameter(asker.Parameter{Path: "logLevel", Type: "string", Enum: []string{"info", "debug"}}).Return("info", nil),
+		a.EXPECT().AskParameter(asker.Parameter{Path: "monitoring", Type: "boolean"}).Return("true", nil),
+	)
+
+	got, err := AskParams(a, clusterSchema)
+	if err != nil {
+		t.Fatalf("AskParams() unexpected error: %v", err)
+	}
+	want := map[string]string{"instances": "2", "storage.size": "1Gi", "logLevel": "info", "monitoring": "true"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("AskParams() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -583,6 +583,11 @@ func copyFileWithFs(src, dst string, fs filesystem.Filesystem) error {
 	return fs.Chmod(dst, srcinfo.Mode())
 }
 
+// CopyDirWithFS copies a whole directory recursively
+func CopyDirWithFS(src string, dst string, fs filesystem.Filesystem) error {
+	return copyDirWithFS(src, dst, fs)
+}
+
 // copyDirWithFS copies a whole directory recursively
 func copyDirWithFS(src string, dst string, fs filesystem.Filesystem) error {
 	var err error
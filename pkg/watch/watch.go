@@ -42,6 +42,7 @@ type WatchClient struct {
 	devfileWatcher    *fsnotify.Watcher
 	podWatcher        watch.Interface
 	warningsWatcher   watch.Interface
+	bindingsWatcher   watch.Interface
 	keyWatcher        <-chan byte
 
 	// true to force sync, used when manual sync
@@ -94,6 +95,10 @@ type WatchParameters struct {
 	WatchFiles bool
 	// WatchCluster indicates to watch Cluster-related objects (Deployment, Pod, etc)
 	WatchCluster bool
+	// NewBindingsWatcher, if not nil, returns a watcher sending an event when the endpoints of the components bound
+	// to the component change, so the bindings are projected again into the component.
+	// It is called again to re-create the watcher when it is closed
+	NewBindingsWatcher func(ctx context.Context) (watch.Interface, error)
 	// ErrOut is a Writer to output forwarded port information
 	Out io.Writer
 	// ErrOut is a Writer to output forwarded port information
@@ -176,6 +181,18 @@ func (o *WatchClient) WatchAndPush(out io.Writer, parameters WatchParameters, ct
 		o.warningsWatcher = NewNoOpWatcher()
 	}
 
+	if parameters.NewBindingsWatcher != nil {
+		o.bindingsWatcher, err = parameters.NewBindingsWatcher(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to watch the components bound: %w", err)
+		}
+	} else {
+		o.bindingsWatcher = NewNoOpWatcher()
+	}
+	defer func() {
+		o.bindingsWatcher.Stop()
+	}()
+
 	o.keyWatcher = getKeyWatcher(ctx, out)
 	return o.eventWatcher(ctx, parameters, out, evaluateFileChanges, o.processEvents, componentStatus)
 }
@@ -282,6 +299,18 @@ func (o *WatchClient) eventWatcher(
 				klog.V(4).Infof("Status: %+v\n", obj)
 			}
 
+		case ev, ok := <-o.bindingsWatcher.ResultChan():
+			if !ok {
+				// the watcher has been closed, for example by the API server after a timeout
+				o.restartBindingsWatcher(ctx, parameters, out)
+				break
+			}
+			klog.V(4).Infof("bindings watcher Event: Type: %s\n", ev.Type)
+			if ev.Type == watch.Error {
+				break
+			}
+			deployTimer.Reset(300 * time.Millisecond)
+
 		case <-deployTimer.C:
 			retry, err := processEventsHandler(ctx, nil, nil, parameters, out, &componentStatus, expBackoff)
 			if err != nil {
@@ -361,6 +390,22 @@ func (o *WatchClient) eventWatcher(
 	}
 }
 
+// restartBindingsWatcher re-creates the watcher on the components bound, after it has been closed.
+// If it cannot be re-created, the components bound are not watched anymore
+func (o *WatchClient) restartBindingsWatcher(ctx context.Context, parameters WatchParameters, out io.Writer) {
+	o.bindingsWatcher = NewNoOpWatcher()
+	if parameters.NewBindingsWatcher == nil || ctx.Err() != nil {
+		return
+	}
+	klog.V(4).Infof("restarting the bindings watcher")
+	w, err := parameters.NewBindingsWatcher(ctx)
+	if err != nil {
+		log.Fwarning(out, fmt.Sprintf("Unable to watch the components bound, the bindings won't be updated when they change: %v", err))
+		return
+	}
+	o.bindingsWatcher = w
+}
+
 // evaluateFileChanges evaluates any file changes for the events. It ignores the files in fileIgnores slice related to path, and removes
 // any deleted paths from the watcher
 func evaluateFileChanges(events []fsnotify.Event, path string, fileIgnores []string, watcher *fsnotify.Watcher) ([]string, []string) {
@@ -136,6 +136,7 @@ func Test_eventWatcher(t *testing.T) {
 				deploymentWatcher: fakeWatcher{},
 				podWatcher:        fakeWatcher{},
 				warningsWatcher:   fakeWatcher{},
+				bindingsWatcher:   fakeWatcher{},
 				devfileWatcher:    fileWatcher,
 				keyWatcher:        make(chan byte),
 			}
@@ -90,3 +90,7 @@ mockgen -source=pkg/exec/interface.go \
 mockgen -source=pkg/podman/interface.go \
     -package podman \
     -destination pkg/podman/mock.go
+
+mockgen -source=pkg/service/asker/interface.go \
+    -package asker \
+    -destination pkg/service/asker/mock.go